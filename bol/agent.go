@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/kardianos/osext"
+	"github.com/schollz/bol/ssed"
+	"github.com/urfave/cli"
+)
+
+var agentCommand = cli.Command{
+	Name:  "agent",
+	Usage: "keep the keys of the password while you work, so bol asks for it once (like ssh-agent)",
+	Flags: []cli.Flag{
+		cli.DurationFlag{
+			Name:  "timeout",
+			Value: 30 * time.Minute,
+			Usage: "forget the keys when it is not used for `duration`",
+		},
+		cli.BoolFlag{
+			Name:  "stop",
+			Usage: "forget the keys and stop the agent",
+		},
+		cli.BoolFlag{
+			Name:  "foreground",
+			Usage: "run the agent without going to the background",
+		},
+	},
+	Action: agentAction,
+}
+
+func agentAction(c *cli.Context) error {
+	if c.Bool("stop") {
+		if err := ssed.StopAgent(); err != nil {
+			return cli.NewExitError(err.Error(), exitFailed)
+		}
+		fmt.Println("Agent stopped")
+		return nil
+	}
+	if ssed.AgentRunning() {
+		fmt.Printf("Agent is already running at %s\n", ssed.AgentSocket())
+		return nil
+	}
+
+	timeout := c.Duration("timeout")
+	if c.Bool("foreground") {
+		// keep running when the terminal that started it is closed
+		signal.Ignore(syscall.SIGHUP)
+		if err := ssed.RunAgent(timeout); err != nil {
+			return cli.NewExitError(err.Error(), exitFailed)
+		}
+		return nil
+	}
+
+	// start the agent in the background and wait until it answers
+	executable, err := osext.Executable()
+	if err != nil {
+		return cli.NewExitError(err.Error(), exitFailed)
+	}
+	cmd := exec.Command(executable, "agent", "--foreground", "--timeout", timeout.String())
+	if err = cmd.Start(); err != nil {
+		return cli.NewExitError(err.Error(), exitFailed)
+	}
+	for i := 0; i < 50; i++ {
+		if ssed.AgentRunning() {
+			fmt.Printf("Agent started, the keys are forgotten after %s without use\n", timeout.String())
+			return nil
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return cli.NewExitError("The agent did not start, try bol agent --foreground", exitFailed)
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/schollz/bol/ssed"
+	"github.com/urfave/cli"
+)
+
+var apiCommand = cli.Command{
+	Name:  "api",
+	Usage: "serve the documents as JSON for other programs, see /api/v1/openapi.json",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "listen",
+			Value: "localhost:9097",
+			Usage: "serve the API at `address`",
+		},
+		cli.StringFlag{
+			Name:   "token",
+			Usage:  "token for the Authorization header (default: a new one)",
+			EnvVar: "BOL_API_TOKEN",
+		},
+	},
+	Action: apiAction,
+}
+
+func apiAction(c *cli.Context) error {
+	token := c.String("token")
+	if len(token) == 0 {
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			return cli.NewExitError(err.Error(), exitFailed)
+		}
+		token = hex.EncodeToString(b)
+	}
+	fs, err := openForScript()
+	if err != nil {
+		return err
+	}
+	// nobody is there to type the password of a vault
+	fs.SetVaultPrompt(nil)
+	fmt.Fprintf(os.Stderr, "Serving the documents of %s at http://%s/api/v1\n", fs.ReturnUser(), c.String("listen"))
+	if len(c.String("token")) == 0 {
+		fmt.Fprintf(os.Stderr, "Send the header 'Authorization: Bearer %s'\n", token)
+	}
+	if err = http.ListenAndServe(c.String("listen"), ssed.NewAPI(fs, token)); err != nil {
+		return cli.NewExitError(err.Error(), exitFailed)
+	}
+	return nil
+}
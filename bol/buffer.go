@@ -0,0 +1,273 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	ssed "github.com/schollz/bol/ssed"
+	"github.com/schollz/bol/utils"
+)
+
+// The editor buffer is a list of entries, each starting with a header of
+// lines that begin with JOURNAL_DELIMITER:
+//
+//	/// entry: 01BX5ZZKBKACTAV9WEVGEMMVRZ
+//	/// document: notes
+//	/// timestamp: 2016-11-20 13:00:00
+//	/// tags: project, ideas
+//
+//	Text of the entry
+//
+// Lines of text that start with the delimiter are escaped with a
+// backslash, so any text can be written and read back unchanged.
+// A header starting with "referenced by" lists the backlinks of an entry
+// and is not saved. It ends at a blank line or at the next header that
+// is not a backlink.
+
+const referencesHeader = "referenced by (not saved):"
+
+// escapedLine matches lines that need one more backslash when written to
+// the buffer, and one less when read back
+var escapedLine = regexp.MustCompile(`^\\*` + regexp.QuoteMeta(JOURNAL_DELIMITER))
+
+// bufferEntry is an entry as it was read from the editor buffer
+type bufferEntry struct {
+	Document  string
+	Entry     string
+	Timestamp string
+	Tags      []string
+	Text      string
+	Line      int // line of the header, for errors
+}
+
+// BufferError is a problem with the edited buffer, at a line of it
+type BufferError struct {
+	Line    int
+	Message string
+}
+
+func (e BufferError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// formatEntry writes the header and escaped text of an entry
+func formatEntry(e ssed.Entry) string {
+	header := fmt.Sprintf("%s entry: %s\n%s document: %s\n%s timestamp: %s\n", JOURNAL_DELIMITER, e.Entry, JOURNAL_DELIMITER, e.Document, JOURNAL_DELIMITER, e.Timestamp)
+	if len(e.Tags) > 0 {
+		header += fmt.Sprintf("%s tags: %s\n", JOURNAL_DELIMITER, strings.Join(e.Tags, ", "))
+	}
+	text := strings.TrimSpace(e.Text)
+	if len(text) == 0 {
+		return header + "\n"
+	}
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if escapedLine.MatchString(line) {
+			lines[i] = `\` + line
+		}
+	}
+	return header + "\n" + strings.Join(lines, "\n") + "\n"
+}
+
+// formatReferences writes the backlinks of an entry as a header that
+// parseBuffer skips
+func formatReferences(backlinks []ssed.Entry) string {
+	references := fmt.Sprintf("%s %s\n", JOURNAL_DELIMITER, referencesHeader)
+	for _, backlink := range backlinks {
+		references += fmt.Sprintf("%s [[%s/%s]] %s\n", JOURNAL_DELIMITER, backlink.Document, backlink.Entry, backlink.Timestamp)
+	}
+	return references
+}
+
+// parseBuffer reads the entries of an edited buffer. Entries without a
+// document are put in defaultDocument, if there is one, and entries
+// without a timestamp are left for ssed to fill in.
+func parseBuffer(buffer, defaultDocument string) ([]bufferEntry, error) {
+	entries := []bufferEntry{}
+	seen := make(map[string]int)
+	var current *bufferEntry
+	var text []string
+	inHeader, inReferences := false, false
+	keys := make(map[string]bool)
+
+	finish := func() error {
+		if current == nil {
+			return nil
+		}
+		current.Text = strings.TrimSpace(strings.Join(text, "\n"))
+		if len(current.Document) == 0 {
+			if len(defaultDocument) == 0 {
+				return BufferError{current.Line, fmt.Sprintf("add a '%s document:' header to choose where this entry is saved", JOURNAL_DELIMITER)}
+			}
+			current.Document = defaultDocument
+		}
+		if len(current.Entry) > 0 {
+			path := current.Document + "/" + current.Entry
+			if line, ok := seen[path]; ok {
+				return BufferError{current.Line, fmt.Sprintf("%s is already written at line %d", path, line)}
+			}
+			seen[path] = current.Line
+		}
+		entries = append(entries, *current)
+		current = nil
+		text = []string{}
+		return nil
+	}
+
+	for i, line := range strings.Split(buffer, "\n") {
+		lineNumber := i + 1
+		if !strings.HasPrefix(line, JOURNAL_DELIMITER) {
+			inHeader = false
+			if escapedLine.MatchString(line) {
+				line = line[1:]
+			}
+			if current != nil {
+				text = append(text, line)
+			} else if len(strings.TrimSpace(line)) > 0 {
+				if inReferences {
+					return nil, BufferError{lineNumber, "text after the references is not saved, move it into an entry"}
+				}
+				return nil, BufferError{lineNumber, fmt.Sprintf("text before the first entry, start an entry with '%s entry:'", JOURNAL_DELIMITER)}
+			}
+			continue
+		}
+
+		header := strings.TrimSpace(strings.TrimPrefix(line, JOURNAL_DELIMITER))
+		if inReferences && inHeader {
+			if strings.HasPrefix(header, "[[") {
+				continue
+			}
+			// any other header ends the references and starts an entry
+			inHeader = false
+		}
+		if !inHeader {
+			// a new header starts a new entry
+			if err := finish(); err != nil {
+				return nil, err
+			}
+			inHeader, inReferences = true, false
+			if strings.ToLower(header) == referencesHeader {
+				inReferences = true
+				continue
+			}
+			current = &bufferEntry{Line: lineNumber}
+			keys = make(map[string]bool)
+		}
+
+		colon := strings.Index(header, ":")
+		if colon < 0 {
+			return nil, BufferError{lineNumber, fmt.Sprintf("expected 'key: value' after '%s', or escape the line as '\\%s'", JOURNAL_DELIMITER, line)}
+		}
+		key := strings.ToLower(strings.TrimSpace(header[:colon]))
+		value := strings.TrimSpace(header[colon+1:])
+		if keys[key] {
+			return nil, BufferError{lineNumber, fmt.Sprintf("'%s' is written twice for this entry", key)}
+		}
+		keys[key] = true
+		switch key {
+		case "entry":
+			if strings.Contains(value, "/") {
+				return nil, BufferError{lineNumber, "entry names can not contain '/', use the document header"}
+			}
+			current.Entry = value
+		case "document":
+			// documents can have a '/', as paths are split at the last one
+			// (see ssed.SplitEntryPath)
+			current.Document = value
+		case "timestamp":
+			if len(value) > 0 {
+				if _, err := utils.ParseDate(value); err != nil {
+					return nil, BufferError{lineNumber, fmt.Sprintf("could not understand the timestamp '%s', use the format %s", value, utils.GetCurrentDate())}
+				}
+			}
+			current.Timestamp = value
+		case "tags":
+			for _, tag := range strings.Split(value, ",") {
+				tag = strings.TrimPrefix(strings.TrimSpace(tag), "#")
+				if len(tag) == 0 {
+					continue
+				}
+				if strings.ContainsAny(tag, " \t") {
+					return nil, BufferError{lineNumber, fmt.Sprintf("tag '%s' contains a space, separate tags with commas", tag)}
+				}
+				current.Tags = append(current.Tags, tag)
+			}
+		default:
+			return nil, BufferError{lineNumber, fmt.Sprintf("unknown header '%s', expected entry, document, timestamp or tags", key)}
+		}
+	}
+	if err := finish(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// bufferChanges is what saving the edited buffer does to the entries that
+// were loaded into it
+type bufferChanges struct {
+	Changed []ssed.Entry // new versions of loaded entries
+	Added   []ssed.Entry // entries that were not loaded
+	Deleted []ssed.Entry // loaded entries whose block was removed or emptied
+}
+
+// diffBuffer compares the edited blocks with the entries that were loaded,
+// so only the entries that changed are written
+func diffBuffer(loaded []ssed.Entry, edited []bufferEntry) bufferChanges {
+	var changes bufferChanges
+	byPath := make(map[string]ssed.Entry)
+	for _, entry := range loaded {
+		byPath[entry.Document+"/"+entry.Entry] = entry
+	}
+	kept := make(map[string]bool)
+	for _, e := range edited {
+		entryPath := e.Document + "/" + e.Entry
+		if len(e.Text) == 0 {
+			continue
+		}
+		kept[entryPath] = true
+		original, exists := byPath[entryPath]
+		if exists && !entryChanged(original, e) {
+			continue
+		}
+		entry := ssed.Entry{Text: e.Text, Document: e.Document, Entry: e.Entry, Timestamp: e.Timestamp, Tags: e.Tags}
+		if exists {
+			changes.Changed = append(changes.Changed, entry)
+		} else {
+			changes.Added = append(changes.Added, entry)
+		}
+	}
+	for _, entry := range loaded {
+		if !kept[entry.Document+"/"+entry.Entry] {
+			changes.Deleted = append(changes.Deleted, entry)
+		}
+	}
+	return changes
+}
+
+func (changes bufferChanges) String() string {
+	changed, added, deleted := len(changes.Changed), len(changes.Added), len(changes.Deleted)
+	if changed+added+deleted == 0 {
+		return "No changes"
+	}
+	return fmt.Sprintf("%d %s changed, %d added, %d deleted", changed, pluralize(changed, "entry", "entries"), added, deleted)
+}
+
+// entryChanged returns whether the edited entry differs from the loaded
+// one in its text, tags or timestamp
+func entryChanged(original ssed.Entry, edited bufferEntry) bool {
+	if strings.TrimSpace(original.Text) != edited.Text {
+		return true
+	}
+	if len(edited.Timestamp) > 0 && utils.ReFormatDate(edited.Timestamp) != original.Timestamp {
+		return true
+	}
+	tags := func(tags []string) string {
+		normalized := make([]string, len(tags))
+		for i, tag := range tags {
+			normalized[i] = strings.ToLower(strings.TrimPrefix(tag, "#"))
+		}
+		return strings.Join(normalized, ",")
+	}
+	return tags(original.Tags) != tags(edited.Tags)
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/schollz/bol/ssed"
+)
+
+func TestFormatAndParseBuffer(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry ssed.Entry
+	}{
+		{"plain", ssed.Entry{Document: "notes", Entry: "a", Timestamp: "2016-11-20 13:00:00", Text: "some text"}},
+		{"tags", ssed.Entry{Document: "notes", Entry: "a", Timestamp: "2016-11-20 13:00:00", Tags: []string{"project", "ideas"}, Text: "some text"}},
+		{"empty", ssed.Entry{Document: "notes", Entry: "a", Timestamp: "2016-11-20 13:00:00"}},
+		{"delimiter", ssed.Entry{Document: "notes", Entry: "a", Timestamp: "2016-11-20 13:00:00", Text: "/// entry: b\nsome text"}},
+		{"escaped delimiter", ssed.Entry{Document: "notes", Entry: "a", Timestamp: "2016-11-20 13:00:00", Text: "\\/// entry: b\n\\\\///\nsome text"}},
+		{"document with a slash", ssed.Entry{Document: "work/2017", Entry: "a", Timestamp: "2016-11-20 13:00:00", Text: "some text"}},
+	}
+	for _, test := range tests {
+		entries, err := parseBuffer(formatEntry(test.entry), "")
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		want := bufferEntry{Document: test.entry.Document, Entry: test.entry.Entry, Timestamp: test.entry.Timestamp, Tags: test.entry.Tags, Text: test.entry.Text, Line: 1}
+		if len(entries) != 1 || !reflect.DeepEqual(entries[0], want) {
+			t.Errorf("%s: expected %+v, got %+v", test.name, want, entries)
+		}
+	}
+}
+
+func TestParseBufferEscaping(t *testing.T) {
+	tests := []struct {
+		line, formatted string
+	}{
+		{"///", "\\///"},
+		{"\\///", "\\\\///"},
+		{"\\\\/// entry: a", "\\\\\\/// entry: a"},
+		{"// not the delimiter", "// not the delimiter"},
+		{"\\// not the delimiter", "\\// not the delimiter"},
+	}
+	for _, test := range tests {
+		formatted := formatEntry(ssed.Entry{Document: "notes", Entry: "a", Text: "text\n" + test.line})
+		if !strings.HasSuffix(formatted, "\n"+test.formatted+"\n") {
+			t.Errorf("Expected '%s' to be written as '%s':\n%s", test.line, test.formatted, formatted)
+		}
+		entries, err := parseBuffer(formatted, "")
+		if err != nil || len(entries) != 1 || entries[0].Text != "text\n"+test.line {
+			t.Errorf("Expected '%s' to be read back: %+v %v", test.line, entries, err)
+		}
+	}
+}
+
+func TestParseBufferErrors(t *testing.T) {
+	tests := []struct {
+		name, buffer, defaultDocument string
+		line                          int
+		message                       string
+	}{
+		{"no document", "/// entry: a\n\ntext", "", 1, "add a '/// document:' header to choose where this entry is saved"},
+		{"written twice", "/// entry: a\n/// document: notes\n\none\n/// entry: a\n/// document: notes\n\ntwo", "", 5, "notes/a is already written at line 1"},
+		{"text after the references", "/// entry: a\n\ntext\n/// referenced by (not saved):\n/// [[notes/b]] 2016-11-20 13:00:00\n\nmore text", "notes", 7, "text after the references is not saved, move it into an entry"},
+		{"text before the first entry", "text\n/// entry: a", "notes", 1, "text before the first entry, start an entry with '/// entry:'"},
+		{"no colon", "/// entry: a\n/// just a line", "notes", 2, "expected 'key: value' after '///', or escape the line as '\\/// just a line'"},
+		{"header twice", "/// entry: a\n/// entry: b", "notes", 2, "'entry' is written twice for this entry"},
+		{"entry with a slash", "/// entry: notes/a", "notes", 1, "entry names can not contain '/', use the document header"},
+		{"timestamp", "/// entry: a\n/// timestamp: yesterday", "notes", 2, "could not understand the timestamp 'yesterday', use the format "},
+		{"tag with a space", "/// entry: a\n/// tags: one, two three", "notes", 2, "tag 'two three' contains a space, separate tags with commas"},
+		{"unknown header", "/// entry: a\n/// title: b", "notes", 2, "unknown header 'title', expected entry, document, timestamp or tags"},
+	}
+	for _, test := range tests {
+		_, err := parseBuffer(test.buffer, test.defaultDocument)
+		bufferError, ok := err.(BufferError)
+		if !ok {
+			t.Errorf("%s: expected a BufferError, got %v", test.name, err)
+			continue
+		}
+		if bufferError.Line != test.line || !strings.HasPrefix(bufferError.Message, test.message) {
+			t.Errorf("%s: expected line %d: %s, got %v", test.name, test.line, test.message, err)
+		}
+	}
+}
+
+func TestParseBufferReferences(t *testing.T) {
+	buffer := formatEntry(ssed.Entry{Document: "notes", Entry: "a", Timestamp: "2016-11-20 13:00:00", Text: "see [[notes/b]]"}) +
+		formatReferences([]ssed.Entry{{Document: "notes", Entry: "b", Timestamp: "2016-11-21 13:00:00"}}) + "\n" +
+		formatEntry(ssed.Entry{Document: "notes", Entry: "b", Timestamp: "2016-11-21 13:00:00", Text: "back to [[notes/a]]"})
+	entries, err := parseBuffer(buffer, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].Text != "see [[notes/b]]" || entries[1].Entry != "b" || entries[1].Text != "back to [[notes/a]]" {
+		t.Errorf("The references should be skipped: %+v", entries)
+	}
+
+	// an entry right after the references, without a blank line
+	buffer = formatEntry(ssed.Entry{Document: "notes", Entry: "a", Timestamp: "2016-11-20 13:00:00", Text: "see [[notes/b]]"}) +
+		formatReferences([]ssed.Entry{{Document: "notes", Entry: "b", Timestamp: "2016-11-21 13:00:00"}}) +
+		formatEntry(ssed.Entry{Document: "notes", Entry: "c", Timestamp: "2016-11-22 13:00:00", Text: "third"})
+	entries, err = parseBuffer(buffer, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[1].Entry != "c" || entries[1].Timestamp != "2016-11-22 13:00:00" || entries[1].Text != "third" {
+		t.Errorf("A header after the references should start an entry: %+v", entries)
+	}
+}
+
+func TestDiffBuffer(t *testing.T) {
+	loaded := []ssed.Entry{
+		{Document: "notes", Entry: "a", Timestamp: "2016-11-20 13:00:00", Tags: []string{"project"}, Text: "first\n"},
+		{Document: "notes", Entry: "b", Timestamp: "2016-11-21 13:00:00", Text: "second"},
+	}
+	a := bufferEntry{Document: "notes", Entry: "a", Timestamp: "2016-11-20 13:00:00", Tags: []string{"#Project"}, Text: "first"}
+	b := bufferEntry{Document: "notes", Entry: "b", Timestamp: "2016-11-21 13:00:00", Text: "second"}
+	changed := func(e bufferEntry, change func(e *bufferEntry)) bufferEntry {
+		change(&e)
+		return e
+	}
+	tests := []struct {
+		name                    string
+		edited                  []bufferEntry
+		changed, added, deleted []string
+		summary                 string
+	}{
+		{"nothing", []bufferEntry{a, b}, nil, nil, nil, "No changes"},
+		{"text", []bufferEntry{changed(a, func(e *bufferEntry) { e.Text = "first, edited" }), b}, []string{"notes/a"}, nil, nil, "1 entry changed, 0 added, 0 deleted"},
+		{"tags", []bufferEntry{changed(a, func(e *bufferEntry) { e.Tags = nil }), b}, []string{"notes/a"}, nil, nil, "1 entry changed, 0 added, 0 deleted"},
+		{"timestamp", []bufferEntry{a, changed(b, func(e *bufferEntry) { e.Timestamp = "2016-11-22 13:00:00" })}, []string{"notes/b"}, nil, nil, "1 entry changed, 0 added, 0 deleted"},
+		{"no timestamp", []bufferEntry{a, changed(b, func(e *bufferEntry) { e.Timestamp = "" })}, nil, nil, nil, "No changes"},
+		{"added", []bufferEntry{a, b, {Document: "notes", Entry: "c", Text: "third"}}, nil, []string{"notes/c"}, nil, "0 entries changed, 1 added, 0 deleted"},
+		{"added empty", []bufferEntry{a, b, {Document: "notes", Entry: "c"}}, nil, nil, nil, "No changes"},
+		{"block removed", []bufferEntry{a}, nil, nil, []string{"notes/b"}, "0 entries changed, 0 added, 1 deleted"},
+		{"block emptied", []bufferEntry{a, changed(b, func(e *bufferEntry) { e.Text = "" })}, nil, nil, []string{"notes/b"}, "0 entries changed, 0 added, 1 deleted"},
+		{"moved to another document", []bufferEntry{a, changed(b, func(e *bufferEntry) { e.Document = "work" })}, nil, []string{"work/b"}, []string{"notes/b"}, "0 entries changed, 1 added, 1 deleted"},
+		{"everything", []bufferEntry{changed(a, func(e *bufferEntry) { e.Text = "edited" }), changed(b, func(e *bufferEntry) { e.Text = "edited" })}, []string{"notes/a", "notes/b"}, nil, nil, "2 entries changed, 0 added, 0 deleted"},
+	}
+	paths := func(entries []ssed.Entry) []string {
+		var paths []string
+		for _, entry := range entries {
+			paths = append(paths, entry.Document+"/"+entry.Entry)
+		}
+		return paths
+	}
+	for _, test := range tests {
+		changes := diffBuffer(loaded, test.edited)
+		if !reflect.DeepEqual(paths(changes.Changed), test.changed) || !reflect.DeepEqual(paths(changes.Added), test.added) || !reflect.DeepEqual(paths(changes.Deleted), test.deleted) {
+			t.Errorf("%s: expected %v changed, %v added, %v deleted, got %+v", test.name, test.changed, test.added, test.deleted, changes)
+		}
+		if changes.String() != test.summary {
+			t.Errorf("%s: expected '%s', got '%s'", test.name, test.summary, changes)
+		}
+	}
+}
@@ -0,0 +1,430 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/schollz/bol/ssed"
+	"github.com/urfave/cli"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// Exit codes of the scripting commands
+const (
+	exitFailed    = 1 // anything else, like unreadable input
+	exitNotFound  = 2 // the document or entry does not exist
+	exitAmbiguous = 3 // the entry name is used in more than one document
+	exitNoAccess  = 4 // not configured, or the password is incorrect
+	exitNotSynced = 5 // the change was saved locally, but not uploaded
+)
+
+var jsonFlag = cli.BoolFlag{
+	Name:  "json",
+	Usage: "print the result as JSON",
+}
+
+// commands are used for scripting: they never open an editor or ask
+// questions, except for the password when it is not in $BOL_PASSWORD or
+// in a running agent
+var commands = []cli.Command{
+	{
+		Name:      "add",
+		Usage:     "add an entry with the text from stdin",
+		ArgsUsage: "Document[/Entry]",
+		Flags: []cli.Flag{
+			jsonFlag,
+			cli.StringFlag{
+				Name:  "timestamp",
+				Usage: "creation `time` of the entry (default: now)",
+			},
+			cli.StringFlag{
+				Name:  "tags",
+				Usage: "comma separated `tags` of the entry",
+			},
+		},
+		Action: addCommand,
+	},
+	{
+		Name:      "cat",
+		Usage:     "print a document or entry",
+		ArgsUsage: "Document|Document/Entry|Entry",
+		Flags:     []cli.Flag{jsonFlag},
+		Action:    catCommand,
+	},
+	{
+		Name:      "ls",
+		Usage:     "list the documents, or the entries of a document",
+		ArgsUsage: "[Document]",
+		Flags:     []cli.Flag{jsonFlag},
+		Action:    lsCommand,
+	},
+	{
+		Name:      "rm",
+		Usage:     "delete a document or entry",
+		ArgsUsage: "Document|Document/Entry|Entry",
+		Flags:     []cli.Flag{jsonFlag},
+		Action:    rmCommand,
+	},
+	{
+		Name:  "devices",
+		Usage: "list the devices that can be opened with a PIN",
+		Flags: []cli.Flag{
+			jsonFlag,
+			cli.StringFlag{
+				Name:  "revoke",
+				Usage: "remove the PIN of the device with `ID` and change the password, from $BOL_NEW_PASSWORD or asked",
+			},
+		},
+		Action: devicesCommand,
+	},
+	{
+		Name:   "fingerprint",
+		Usage:  "print the fingerprint of your public key, which others check when sharing with you",
+		Flags:  []cli.Flag{jsonFlag},
+		Action: fingerprintCommand,
+	},
+}
+
+// openForScript opens the repository of the default user without prompts
+func openForScript() (*ssed.Fs, error) {
+	if Debug {
+		ssed.DebugMode()
+		DebugMode()
+	}
+	fs := new(ssed.Fs)
+	if err := fs.Init("", ""); err != nil {
+		return nil, cli.NewExitError("No user is configured, run bol once to set one up", exitNoAccess)
+	}
+	// documents in vaults are left out when there is no one to ask
+	fs.SetVaultPrompt(func(documentName string) string {
+		if !terminal.IsTerminal(int(os.Stdin.Fd())) {
+			return ""
+		}
+		fmt.Fprintf(os.Stderr, "Enter password of the vault '%s': ", documentName)
+		bytePassword, _ := terminal.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Fprintln(os.Stderr, "")
+		return strings.TrimSpace(string(bytePassword))
+	})
+	if len(keyFile) > 0 {
+		if err := fs.UseKeyFile(keyFile); err != nil {
+			return nil, cli.NewExitError(err.Error(), exitNoAccess)
+		}
+	}
+	// the agent has the keys of an earlier command, and a repository opened
+	// with a key file alone needs no password. An empty password is not
+	// tried with a key file otherwise, as it would make a new repository
+	// that is opened with the key file alone.
+	password := os.Getenv("BOL_PASSWORD")
+	tryAgent := ssed.AgentRunning() && len(keyFile) == 0
+	if len(password) == 0 && (tryAgent || !fs.NeedsPassword()) && fs.Open("") == nil {
+		return fs, nil
+	}
+	if len(password) == 0 {
+		if !terminal.IsTerminal(int(os.Stdin.Fd())) {
+			return nil, cli.NewExitError("Set BOL_PASSWORD to use bol without a terminal", exitNoAccess)
+		}
+		fmt.Fprint(os.Stderr, "Enter password: ")
+		bytePassword, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Fprintln(os.Stderr, "")
+		if err != nil {
+			return nil, cli.NewExitError(err.Error(), exitNoAccess)
+		}
+		password = strings.TrimSpace(string(bytePassword))
+	}
+	if err := fs.Open(password); err != nil {
+		if len(keyFile) > 0 || err == ssed.ErrNeedKeyFile {
+			return nil, cli.NewExitError(err.Error(), exitNoAccess)
+		}
+		return nil, cli.NewExitError("Incorrect password", exitNoAccess)
+	}
+	return fs, nil
+}
+
+// closeForScript uploads the changes, which are kept locally if it fails.
+// Commands that changed the repository then get an exit error, and the
+// others only warn.
+func closeForScript(fs *ssed.Fs, changed bool) error {
+	err := fs.Close()
+	if err == nil || err == ssed.ErrNoChanges {
+		return nil
+	}
+	if changed {
+		return cli.NewExitError(err.Error(), exitNotSynced)
+	}
+	fmt.Fprintln(os.Stderr, err.Error())
+	return nil
+}
+
+// findForScript returns the entries of a document or entry, or an exit
+// error when there are none or when the entry name is ambiguous
+func findForScript(fs *ssed.Fs, name string) ([]ssed.Entry, bool, error) {
+	if len(name) == 0 {
+		return nil, false, cli.NewExitError("Specify a document or entry", exitFailed)
+	}
+	entries, isDocument, _, err := fs.GetDocumentOrEntry(name)
+	if err == ssed.ErrAmbiguousEntry {
+		paths := make([]string, len(entries))
+		for i, entry := range entries {
+			paths[i] = entry.Document + "/" + entry.Entry
+		}
+		return nil, false, cli.NewExitError(fmt.Sprintf("'%s' is in more than one document, use one of: %s", name, strings.Join(paths, ", ")), exitAmbiguous)
+	}
+	if err != nil || len(entries) == 0 {
+		return nil, false, cli.NewExitError(fmt.Sprintf("No document or entry named '%s'", name), exitNotFound)
+	}
+	return entries, isDocument, nil
+}
+
+func printJSON(v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return cli.NewExitError(err.Error(), exitFailed)
+	}
+	fmt.Println(string(b))
+	return nil
+}
+
+func addCommand(c *cli.Context) error {
+	name := c.Args().Get(0)
+	if len(name) == 0 {
+		return cli.NewExitError("Specify the document, e.g. echo text | bol add notes", exitFailed)
+	}
+	text, err := ioutil.ReadAll(os.Stdin)
+	if err != nil {
+		return cli.NewExitError(err.Error(), exitFailed)
+	}
+	if len(strings.TrimSpace(string(text))) == 0 {
+		return cli.NewExitError("No text on stdin", exitFailed)
+	}
+	fs, err := openForScript()
+	if err != nil {
+		return err
+	}
+	documentName, entryName := ssed.SplitEntryPath(name)
+	if len(documentName) == 0 {
+		documentName, entryName = name, ""
+	}
+	if len(entryName) > 0 {
+		if _, err = fs.GetEntry(documentName, entryName); err == nil {
+			return cli.NewExitError(fmt.Sprintf("%s/%s already exists", documentName, entryName), exitFailed)
+		}
+	} else if entryName, err = fs.NewEntryName(); err != nil {
+		return cli.NewExitError(err.Error(), exitFailed)
+	}
+	var tags []string
+	if len(c.String("tags")) > 0 {
+		tags = strings.Split(c.String("tags"), ",")
+	}
+	err = fs.UpdateEntry(ssed.Entry{
+		Text:      strings.TrimSpace(string(text)),
+		Document:  documentName,
+		Entry:     entryName,
+		Timestamp: c.String("timestamp"),
+		Tags:      tags,
+	})
+	if err != nil {
+		return cli.NewExitError(err.Error(), exitFailed)
+	}
+	entry, err := fs.GetEntry(documentName, entryName)
+	if err != nil {
+		return cli.NewExitError(err.Error(), exitFailed)
+	}
+	// the entry is saved, so it is printed also when it was not uploaded
+	errClose := closeForScript(fs, true)
+	if c.Bool("json") {
+		if err = printJSON(entry); err != nil {
+			return err
+		}
+	} else {
+		fmt.Printf("%s/%s\n", entry.Document, entry.Entry)
+	}
+	return errClose
+}
+
+func catCommand(c *cli.Context) error {
+	fs, err := openForScript()
+	if err != nil {
+		return err
+	}
+	entries, isDocument, err := findForScript(fs, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	if c.Bool("json") {
+		return printJSON(entries)
+	}
+	if !isDocument {
+		fmt.Println(entries[0].Text)
+		return nil
+	}
+	// documents are printed the way they are edited
+	for i, entry := range entries {
+		if i > 0 {
+			fmt.Println("")
+		}
+		fmt.Print(formatEntry(entry))
+	}
+	return nil
+}
+
+// listedDocument is a document as it is printed by bol ls --json
+type listedDocument struct {
+	Document string `json:"document"`
+	Entries  int    `json:"entries"`
+}
+
+func lsCommand(c *cli.Context) error {
+	fs, err := openForScript()
+	if err != nil {
+		return err
+	}
+	documentName := c.Args().Get(0)
+	if len(documentName) == 0 {
+		documents := []listedDocument{}
+		for _, document := range fs.ListDocuments() {
+			documents = append(documents, listedDocument{document, len(fs.GetDocument(document))})
+		}
+		if c.Bool("json") {
+			return printJSON(documents)
+		}
+		for _, document := range documents {
+			fmt.Printf("%s\t%d\n", document.Document, document.Entries)
+		}
+		return nil
+	}
+
+	entries := fs.GetDocument(documentName)
+	if len(entries) == 0 {
+		return cli.NewExitError(fmt.Sprintf("No document named '%s'", documentName), exitNotFound)
+	}
+	if c.Bool("json") {
+		return printJSON(entries)
+	}
+	for _, entry := range entries {
+		fmt.Printf("%s/%s\t%s\n", entry.Document, entry.Entry, entry.Timestamp)
+	}
+	return nil
+}
+
+func rmCommand(c *cli.Context) error {
+	fs, err := openForScript()
+	if err != nil {
+		return err
+	}
+	name := c.Args().Get(0)
+	entries, isDocument, err := findForScript(fs, name)
+	if err != nil {
+		return err
+	}
+	deleted := name
+	if isDocument {
+		fs.DeleteDocument(name)
+	} else {
+		deleted = entries[0].Document + "/" + entries[0].Entry
+		fs.DeleteEntry(entries[0].Document, entries[0].Entry)
+	}
+	errClose := closeForScript(fs, true)
+	if c.Bool("json") {
+		if err = printJSON(map[string]string{"deleted": deleted}); err != nil {
+			return err
+		}
+	} else {
+		fmt.Printf("Deleted %s\n", deleted)
+	}
+	return errClose
+}
+
+// readNewPassword returns $BOL_NEW_PASSWORD, or asks for a new password
+// twice
+func readNewPassword() (string, error) {
+	if password := os.Getenv("BOL_NEW_PASSWORD"); len(password) > 0 {
+		return password, nil
+	}
+	if !terminal.IsTerminal(int(os.Stdin.Fd())) {
+		return "", errors.New("Set BOL_NEW_PASSWORD to change the password without a terminal")
+	}
+	var passwords [2]string
+	for i, prompt := range []string{"Enter new password: ", "Enter new password again: "} {
+		fmt.Fprint(os.Stderr, prompt)
+		bytePassword, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Fprintln(os.Stderr, "")
+		if err != nil {
+			return "", err
+		}
+		passwords[i] = strings.TrimSpace(string(bytePassword))
+	}
+	if passwords[0] != passwords[1] {
+		return "", errors.New("The passwords do not match")
+	}
+	return passwords[0], nil
+}
+
+func devicesCommand(c *cli.Context) error {
+	fs, err := openForScript()
+	if err != nil {
+		return err
+	}
+	if id := c.String("revoke"); len(id) > 0 {
+		if err = fs.RevokeDevice(id); err != nil {
+			return cli.NewExitError(err.Error(), exitNotFound)
+		}
+		// the PIN file of the device keeps the password, which only stops
+		// working when it is changed
+		newPassword, err := readNewPassword()
+		if err == nil {
+			err = fs.ChangePassword(newPassword)
+		}
+		errClose := closeForScript(fs, true)
+		if err != nil {
+			return cli.NewExitError("Could not change the password, so "+id+" can still open bol if its PIN is guessed: "+err.Error(), exitFailed)
+		}
+		if c.Bool("json") {
+			if err = printJSON(map[string]string{"revoked": id}); err != nil {
+				return err
+			}
+		} else {
+			fmt.Printf("Revoked %s and changed the password. Other devices need the new password, and their PINs were removed.\n", id)
+		}
+		return errClose
+	}
+
+	devices, err := fs.ListDevices()
+	if err != nil {
+		return cli.NewExitError(err.Error(), exitFailed)
+	}
+	if c.Bool("json") {
+		return printJSON(devices)
+	}
+	for _, device := range devices {
+		status := ""
+		if device.Current {
+			status = "this device"
+		} else if device.Revoked {
+			status = "revoked"
+		}
+		fmt.Printf("%s\t%s\t%s\t%s\n", device.ID, device.Name, device.Created, status)
+	}
+	return nil
+}
+
+func fingerprintCommand(c *cli.Context) error {
+	fs, err := openForScript()
+	if err != nil {
+		return err
+	}
+	fingerprint, err := fs.Fingerprint()
+	// the key pair is made the first time, which can be uploaded later
+	closeForScript(fs, false)
+	if err != nil {
+		return cli.NewExitError(err.Error(), exitFailed)
+	}
+	if c.Bool("json") {
+		return printJSON(map[string]string{"username": fs.ReturnUser(), "fingerprint": fingerprint})
+	}
+	fmt.Println(fingerprint)
+	return nil
+}
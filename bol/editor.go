@@ -0,0 +1,117 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/schollz/bol/utils"
+)
+
+// presetEditors have their own settings in WriteEntry
+var presetEditors = []string{"vim", "emacs", "micro", "nano"}
+
+// editorTemplates are used for other well-known editors when they are given
+// without arguments, so that the cursor starts at the new entry.
+// {file} is replaced with the file to edit and {line} with its last line.
+var editorTemplates = map[string]string{
+	"code":  "code --wait --goto {file}:{line}",
+	"gedit": "gedit --wait +{line} {file}",
+	"hx":    "hx {file}:{line}",
+	"kak":   "kak +{line} {file}",
+	"nvim":  "nvim +{line} {file}",
+	"subl":  "subl --wait {file}:{line}",
+	"vi":    "vi +{line} {file}",
+}
+
+// isPresetEditor returns whether the editor has built-in settings
+func isPresetEditor(editor string) bool {
+	for _, preset := range presetEditors {
+		if editor == preset {
+			return true
+		}
+	}
+	return false
+}
+
+// getEditor returns the editor set with "bol -editor", or else $VISUAL or
+// $EDITOR, or else vim
+func getEditor() string {
+	if utils.Exists(path.Join(homePath, ".config", "bol", "editor")) {
+		editorBytes, _ := ioutil.ReadFile(path.Join(homePath, ".config", "bol", "editor"))
+		if editor := strings.TrimSpace(string(editorBytes)); len(editor) > 0 {
+			return editor
+		}
+	}
+	for _, variable := range []string{"VISUAL", "EDITOR"} {
+		if editor := strings.TrimSpace(os.Getenv(variable)); len(editor) > 0 {
+			return editor
+		}
+	}
+	return "vim"
+}
+
+// editorCommand returns the program and arguments to edit fileName with a
+// command template like "code --wait {file}" or "hx {file}:{line}". If the
+// template has no {file} the file name is added as the last argument.
+func editorCommand(template, fileName string, line int) (string, []string) {
+	if !strings.Contains(template, "{") {
+		if t, ok := editorTemplates[path.Base(strings.TrimSpace(template))]; ok {
+			// keep the program as given, which may be a full path
+			template = strings.TrimSpace(template) + t[strings.Index(t, " "):]
+		}
+	}
+	words := splitCommand(template)
+	if len(words) == 0 {
+		return "", nil
+	}
+	hasFile := false
+	args := make([]string, 0, len(words))
+	for _, word := range words[1:] {
+		if strings.Contains(word, "{file}") {
+			hasFile = true
+		}
+		word = strings.Replace(word, "{file}", fileName, -1)
+		word = strings.Replace(word, "{line}", strconv.Itoa(line), -1)
+		args = append(args, word)
+	}
+	if !hasFile {
+		args = append(args, fileName)
+	}
+	return words[0], args
+}
+
+// splitCommand splits a command into words on spaces, keeping text in single
+// or double quotes together
+func splitCommand(command string) []string {
+	words := []string{}
+	var word []rune
+	inWord := false
+	var quote rune
+	for _, r := range command {
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			word = append(word, r)
+		case r == '"' || r == '\'':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, string(word))
+				word = word[:0]
+				inWord = false
+			}
+		default:
+			word = append(word, r)
+			inWord = true
+		}
+	}
+	if inWord {
+		words = append(words, string(word))
+	}
+	return words
+}
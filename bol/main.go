@@ -19,11 +19,17 @@ import (
 
 var (
 	Version, BuildTime, Build, OS, LastCommit, Editor string
-	Debug, Summarize                                  bool
+	Debug, Summarize, Extract                         bool
 	DontEncrypt, Clean                                bool
 	ResetConfig, DumpFile                             bool
 	ImportOldFile, ImportFile                         bool
-	encryptFile, decryptFile, importFile              string
+	encryptFile, decryptFile, importFile, tagName     string
+	cipherName                                        string
+	attachFile, keyFile                               string
+	pinAttempts, recoveryQuorum, recoveryCount        int
+	makeRecovery, recoverPassword                     bool
+	shareDocument, unshareDocument, vaultDocument     string
+	shareWritable, diskTemp                           bool
 )
 
 func main() {
@@ -33,13 +39,21 @@ func main() {
 	// Handle Ctl+C for cleanUp
 	// from http://stackoverflow.com/questions/11268943/golang-is-it-possible-to-capture-a-ctrlc-signal-and-run-a-cleanup-function-in
 	c := make(chan os.Signal, 2)
-	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
 	go func() {
 		<-c
 		ssed.CleanUp()
 		os.Exit(1)
 	}()
 
+	// Ask for the password once per session when an agent is running
+	ssed.UseAgent()
+
+	// Encrypt with the cipher set with "bol -cipher"
+	if b, err := ioutil.ReadFile(path.Join(homePath, ".config", "bol", "cipher")); err == nil {
+		utils.SetCipher(strings.TrimSpace(string(b)))
+	}
+
 	// App information
 	setBuild()
 	app := cli.NewApp()
@@ -53,7 +67,32 @@ func main() {
 
 EXAMPLE USAGE:
    bol new.txt # create new / edit a document, 'new.txt'
-   bol Entry123 # edit a entry, 'Entry123'`
+   bol Entry123 # edit a entry, 'Entry123'
+   bol new.txt/Entry123 # edit the entry 'Entry123' in 'new.txt'
+   bol -tag project # edit all entries tagged #project
+   bol -attach receipt.png Entry123 # attach a file to 'Entry123'
+   bol -extract Entry123 # save the attachments of 'Entry123'
+
+SCRIPTING:
+   echo "text" | bol add new.txt # add an entry to 'new.txt'
+   bol cat new.txt/Entry123 # print an entry (or a document)
+   bol ls --json new.txt # list the entries of 'new.txt' as JSON
+   bol rm new.txt/Entry123 # delete an entry (or a document)
+   bol agent # ask for the password once, for all of the commands
+   bol devices # list the devices that can be opened with a PIN
+   bol api # serve the documents as JSON at localhost:9097/api/v1
+   bol -keyfile ~/.bol.key ls # unlock with a key file (and the password)
+   bol -recovery -recovery-quorum 3 # print 5 codes, any 3 replace the password
+   bol -recover # forgot the password? set a new one with recovery codes
+   bol -share notes.txt -writable alice # alice can read and edit 'notes.txt'
+   bol -unshare notes.txt alice # stop sharing 'notes.txt' with alice
+   bol fingerprint # print the fingerprint others check when sharing with you
+   bol -vault finance # encrypt 'finance' with a second password
+
+   The password is read from $BOL_PASSWORD if it is set. Commands exit
+   with 1 on errors, 2 if the document or entry does not exist, 3 if
+   the entry name is in more than one document, and 4 if bol can not
+   be opened.`
 
 	app.Action = func(c *cli.Context) error {
 
@@ -91,24 +130,55 @@ EXAMPLE USAGE:
 			DebugMode()
 			logger.Debug("Turning on Debug mode")
 		}
+		if diskTemp {
+			ssed.AllowDiskTempFolder()
+		}
 
 		if len(Editor) > 0 {
-			Editor = strings.TrimSpace(strings.ToLower(Editor))
-			if Editor == "vim" || Editor == "emacs" || Editor == "micro" || Editor == "nano" {
-				ioutil.WriteFile(path.Join(homePath, ".config", "bol", "editor"), []byte(Editor), 0644)
-				fmt.Printf("Editor set to ")
-				c := color.New(color.FgHiCyan)
-				c.Println(Editor)
-			} else {
-				c := color.New(color.FgHiRed)
-				c.Print(Editor)
-				fmt.Println(" is not supported, sorry.")
-				fmt.Println("\nSupported editors are:")
-				fmt.Println("- vim:   ftp://ftp.vim.org/pub/vim/pc/vim80-069w32.zip")
-				fmt.Println("- micro: https://github.com/zyedidia/micro/releases/latest")
-				fmt.Println("- emacs")
-				fmt.Println("- nano")
+			Editor = strings.TrimSpace(Editor)
+			if isPresetEditor(strings.ToLower(Editor)) {
+				Editor = strings.ToLower(Editor)
+			}
+			if len(splitCommand(Editor)) == 0 {
+				fmt.Println("Editor command is empty")
+				return nil
+			}
+			ioutil.WriteFile(path.Join(homePath, ".config", "bol", "editor"), []byte(Editor), 0644)
+			fmt.Printf("Editor set to ")
+			c := color.New(color.FgHiCyan)
+			c.Println(Editor)
+			if template, ok := editorTemplates[path.Base(Editor)]; ok {
+				fmt.Printf("Entries will be edited with: %s\n", template)
+			}
+			return nil
+		}
+
+		if len(cipherName) > 0 {
+			cipherName = strings.ToLower(strings.TrimSpace(cipherName))
+			if err := utils.SetCipher(cipherName); err != nil {
+				fmt.Println(err.Error())
+				return nil
 			}
+			ioutil.WriteFile(path.Join(homePath, ".config", "bol", "cipher"), []byte(cipherName), 0644)
+			fmt.Printf("Cipher set to ")
+			c := color.New(color.FgHiCyan)
+			c.Println(cipherName)
+			fmt.Println("New and edited entries are encrypted with it, and the others are still read")
+			return nil
+		}
+
+		if (len(attachFile) > 0 || Extract) && len(c.Args().Get(0)) == 0 {
+			fmt.Println("Specify the entry, e.g. bol -attach receipt.png Document/Entry")
+			return nil
+		}
+
+		if len(shareDocument) > 0 && len(c.Args().Get(0)) == 0 {
+			fmt.Println("Specify the user, e.g. bol -share notes.txt alice")
+			return nil
+		}
+
+		if len(unshareDocument) > 0 && len(c.Args().Get(0)) == 0 {
+			fmt.Println("Specify the user, e.g. bol -unshare notes.txt alice")
 			return nil
 		}
 
@@ -121,6 +191,7 @@ EXAMPLE USAGE:
 		}
 		return nil
 	}
+	app.Commands = append(commands, agentCommand, apiCommand)
 	app.Flags = []cli.Flag{
 		cli.BoolFlag{
 			Name:        "debug",
@@ -144,9 +215,14 @@ EXAMPLE USAGE:
 		},
 		cli.StringFlag{
 			Name:        "editor",
-			Usage:       "select either `vim|nano|emacs|micro`",
+			Usage:       "select `vim|nano|emacs|micro` or any editor command, e.g. \"code --wait {file}\" ({file} and {line} are replaced)",
 			Destination: &Editor,
 		},
+		cli.StringFlag{
+			Name:        "cipher",
+			Usage:       "encrypt with `aes256gcm|xchacha20poly1305` from now on",
+			Destination: &cipherName,
+		},
 		cli.StringFlag{
 			Name:        "decrypt",
 			Usage:       "decrypt `file`",
@@ -182,6 +258,81 @@ EXAMPLE USAGE:
 		// 	Usage:       "Delete `X`, where X is a document or entry",
 		// 	Destination: &bol.DeleteFlag,
 		// },
+		cli.StringFlag{
+			Name:        "attach",
+			Usage:       "attach `file` to the entry",
+			Destination: &attachFile,
+		},
+		cli.BoolFlag{
+			Name:        "extract",
+			Usage:       "extract the attachments of the entry",
+			Destination: &Extract,
+		},
+		cli.StringFlag{
+			Name:        "tag",
+			Usage:       "edit all entries tagged with #`tag`",
+			Destination: &tagName,
+		},
+		cli.StringFlag{
+			Name:        "keyfile",
+			Usage:       "unlock with a key `file`, together with the password or alone",
+			EnvVar:      "BOL_KEYFILE",
+			Destination: &keyFile,
+		},
+		cli.BoolFlag{
+			Name:        "disk-temp",
+			Usage:       "keep decrypted files in the system temp folder, which may be on disk, when there is no in-memory folder",
+			EnvVar:      "BOL_DISK_TEMP",
+			Destination: &diskTemp,
+		},
+		cli.IntFlag{
+			Name:        "pin-attempts",
+			Value:       ssed.DefaultPinAttempts,
+			Usage:       "remove a new PIN after `N` wrong attempts",
+			Destination: &pinAttempts,
+		},
+		cli.BoolFlag{
+			Name:        "recovery",
+			Usage:       "print recovery codes that open bol when the password is lost",
+			Destination: &makeRecovery,
+		},
+		cli.IntFlag{
+			Name:        "recovery-codes",
+			Value:       ssed.DefaultRecoveryCodes,
+			Usage:       "make `N` recovery codes",
+			Destination: &recoveryCount,
+		},
+		cli.IntFlag{
+			Name:        "recovery-quorum",
+			Value:       1,
+			Usage:       "need `N` of the recovery codes to open bol (Shamir secret sharing)",
+			Destination: &recoveryQuorum,
+		},
+		cli.BoolFlag{
+			Name:        "recover",
+			Usage:       "open bol with recovery codes and set a new password",
+			Destination: &recoverPassword,
+		},
+		cli.StringFlag{
+			Name:        "share",
+			Usage:       "share the `document` with the user given as argument",
+			Destination: &shareDocument,
+		},
+		cli.StringFlag{
+			Name:        "unshare",
+			Usage:       "stop sharing the `document` with the user given as argument",
+			Destination: &unshareDocument,
+		},
+		cli.BoolFlag{
+			Name:        "writable",
+			Usage:       "let the user of -share edit the document",
+			Destination: &shareWritable,
+		},
+		cli.StringFlag{
+			Name:        "vault",
+			Usage:       "encrypt the `document` with a password of its own",
+			Destination: &vaultDocument,
+		},
 		cli.BoolFlag{
 			Name:        "summary",
 			Usage:       "Gets summary",
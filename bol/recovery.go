@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/schollz/bol/ssed"
+	"github.com/schollz/bol/utils"
+)
+
+// printRecoveryCodes makes new recovery codes and prints them to be kept on
+// paper
+func printRecoveryCodes(fs *ssed.Fs) {
+	codes, err := fs.NewRecoveryCodes(recoveryQuorum, recoveryCount)
+	if err != nil {
+		c := color.New(color.FgRed)
+		c.Printf("\nProblem making recovery codes: %s\n", err.Error())
+		return
+	}
+	fmt.Printf("\n\nRecovery codes for '%s' on %s, made %s\n\n", fs.ReturnUser(), fs.ReturnMethod(), utils.GetCurrentDate())
+	if recoveryQuorum == 1 {
+		fmt.Println("Each of these codes opens bol with 'bol -recover':")
+	} else {
+		fmt.Printf("Any %d of these codes open bol with 'bol -recover':\n", recoveryQuorum)
+	}
+	fmt.Println("")
+	for i, code := range codes {
+		fmt.Printf("  %2d. %s\n", i+1, code)
+	}
+	fmt.Println("")
+	fmt.Println("Print them or write them down, and keep them away from your devices.")
+	fmt.Println("Recovery codes made before these no longer work.")
+}
+
+// recoverRepository opens the repository with recovery codes and sets a new
+// password
+func recoverRepository(fs *ssed.Fs) {
+	quorum, err := fs.RecoveryQuorum()
+	if err != nil {
+		c := color.New(color.FgRed)
+		c.Printf("\n%s\n", err.Error())
+		exit(1)
+	}
+	reader := bufio.NewReader(os.Stdin)
+	for {
+		codes := make([]string, quorum)
+		for i := range codes {
+			fmt.Printf("Enter recovery code %d of %d: ", i+1, quorum)
+			codes[i], _ = reader.ReadString('\n')
+		}
+		if err = fs.OpenWithRecoveryCodes(codes); err == nil {
+			break
+		}
+		c := color.New(color.FgRed)
+		c.Printf("%s\n\n", err.Error())
+	}
+
+	for {
+		password := utils.GetPassword("new password")
+		fmt.Println("")
+		if len(password) == 0 && len(keyFile) == 0 {
+			fmt.Println("The password can not be empty.")
+			continue
+		}
+		if utils.GetPassword("new password again") != password {
+			fmt.Println("\nThe passwords do not match.")
+			continue
+		}
+		if err = fs.ChangePassword(password); err != nil {
+			c := color.New(color.FgRed)
+			c.Printf("\n%s\n", err.Error())
+			exit(1)
+		}
+		break
+	}
+	if err = fs.Close(); err != nil {
+		c := color.New(color.FgCyan)
+		c.Printf("\n%s\n", err.Error())
+	}
+	c := color.New(color.FgGreen)
+	c.Println("\nPassword changed. Other devices need the new password, and their PINs were removed.")
+	fmt.Println("The recovery codes still work.")
+}
@@ -1,9 +1,10 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
-	"log"
 	"os"
 	"os/exec"
 	"path"
@@ -76,7 +77,25 @@ func Run(workingFile string, changeUser bool, dumpFile bool) {
 		fmt.Print("Server:\t")
 		c.Println(fs.ReturnMethod())
 	}
-	for {
+	if len(keyFile) > 0 {
+		if err = fs.UseKeyFile(keyFile); err != nil {
+			c := color.New(color.FgRed)
+			c.Printf("\n%s\n", err.Error())
+			exit(1)
+		}
+	}
+	if recoverPassword {
+		recoverRepository(&fs)
+		return
+	}
+	// the agent, if one is running, has the keys of an earlier command,
+	// and a repository opened with a key file alone needs no password.
+	// Recovery codes need the password, which the agent does not keep.
+	err = ssed.ErrNoAgent
+	if (ssed.AgentRunning() && !makeRecovery) || !fs.NeedsPassword() {
+		err = fs.Open("")
+	}
+	for err != nil {
 		var password string
 		var passwordEntry string
 		if fs.HasPinFile() {
@@ -87,6 +106,7 @@ func Run(workingFile string, changeUser bool, dumpFile bool) {
 			if pinErr != nil {
 				c := color.New(color.FgRed)
 				c.Printf("\n\n%s\n", pinErr.Error())
+				continue
 			}
 		} else {
 			passwordEntry = "password"
@@ -95,24 +115,40 @@ func Run(workingFile string, changeUser bool, dumpFile bool) {
 		err = fs.Open(password)
 		if err == nil {
 			// Check user status
-			_, err2 := utils.CreateBolUser(fs.ReturnUser(), password, fs.ReturnMethod())
+			_, err2 := fs.CreateUser()
 			if err2 != nil {
 				c := color.New(color.FgCyan)
 				c.Printf("\n\n%s\n", "Cannot connect to server, working locally")
 			}
-			if passwordEntry == "password" {
+			if passwordEntry == "password" && len(password) > 0 {
 				var pin string
 				fmt.Print("\nEnter a pin (press enter to skip): ")
 				fmt.Scanln(&pin)
 				if strings.TrimSpace(pin) != "" {
-					fs.SetPinFromPassword(strings.TrimSpace(pin))
+					fs.SetPinFromPassword(strings.TrimSpace(pin), pinAttempts)
 				}
 			}
 			break
+		} else if err == ssed.ErrNeedKeyFile || err == ssed.ErrUnusedKeyFile {
+			c := color.New(color.FgRed)
+			c.Printf("\n%s\n", err.Error())
+			exit(1)
+		} else if passwordEntry == "pin" {
+			// the password was changed since the PIN was set, so the PIN
+			// would be asked for forever
+			fs.RemovePin()
+			c := color.New(color.FgRed)
+			c.Printf("\n\n%s\n", "The PIN no longer opens bol and was removed, use the password")
+		} else if len(keyFile) > 0 {
+			// says whether the password or the key file is wrong
+			fmt.Println(err.Error())
 		} else {
 			fmt.Println("Incorrect password.")
 		}
 	}
+	// the older archives on the server are only removed when asked for,
+	// after a new vault is uploaded
+	purge := false
 	defer func() {
 		err := fs.Close()
 		if dumpFile {
@@ -121,14 +157,86 @@ func Run(workingFile string, changeUser bool, dumpFile bool) {
 		if err != nil {
 			c := color.New(color.FgCyan)
 			c.Printf("\n%s\n", err.Error())
-		} else {
-			c := color.New(color.FgCyan)
-			c.Printf("\nUploaded changes to '%s'\n", workingFile)
+			if purge {
+				c = color.New(color.FgYellow)
+				c.Println("The vault was not uploaded, so the older archives on the server were kept.")
+			}
+			return
+		}
+		c := color.New(color.FgCyan)
+		c.Printf("\nUploaded changes to '%s'\n", workingFile)
+		if used, quota := fs.Quota(); quota > 0 {
+			c.Printf("Using %d%% of your quota on the server\n", used*100/quota)
+		}
+		if purge {
+			if err = fs.PurgeOlderArchives(); err != nil {
+				c = color.New(color.FgRed)
+				c.Printf("Could not remove the older archives on the server: %s\n", err.Error())
+			} else {
+				c.Println("Removed the older archives on the server")
+			}
 		}
 	}()
+	fs.SetVaultPrompt(func(documentName string) string {
+		password := utils.GetPassword("password of the vault '" + documentName + "' (press enter to skip)")
+		fmt.Println("")
+		return password
+	})
+	if makeRecovery {
+		printRecoveryCodes(&fs)
+		return
+	}
+	if len(vaultDocument) > 0 {
+		purge = makeVault(&fs, vaultDocument)
+		return
+	}
+	fs.SetKeyPrompt(func(username, fingerprint string, changed bool) bool {
+		c := color.New(color.FgYellow)
+		if changed {
+			c.Printf("\nThe public key of %s changed, it may not be theirs. Its fingerprint is now\n\n    %s\n\n", username, fingerprint)
+		} else {
+			c.Printf("\nThe public key of %s has the fingerprint\n\n    %s\n\n", username, fingerprint)
+		}
+		fmt.Printf("Ask %s to run 'bol fingerprint' and check that it is the same. Share with this key? (y/N) ", username)
+		var answer string
+		fmt.Scanln(&answer)
+		return strings.ToLower(strings.TrimSpace(answer)) == "y"
+	})
+	if len(shareDocument) > 0 {
+		if err = fs.Share(shareDocument, workingFile, shareWritable); err != nil {
+			c := color.New(color.FgRed)
+			c.Printf("\nCould not share '%s': %s\n", shareDocument, err.Error())
+			return
+		}
+		access := "read-only"
+		if shareWritable {
+			access = "read-write"
+		}
+		c := color.New(color.FgGreen)
+		c.Printf("\nShared '%s' with %s (%s), they see it as '%s@%s'\n", shareDocument, workingFile, access, shareDocument, fs.ReturnUser())
+		return
+	}
+	if len(unshareDocument) > 0 {
+		if err = fs.Unshare(unshareDocument, workingFile); err != nil {
+			c := color.New(color.FgRed)
+			c.Printf("\nCould not unshare '%s': %s\n", unshareDocument, err.Error())
+			return
+		}
+		c := color.New(color.FgGreen)
+		c.Printf("\nStopped sharing '%s' with %s, the others got a new key for it\n", unshareDocument, workingFile)
+		return
+	}
 	if dumpFile {
-		filename, _ := fs.DumpAll()
-		fmt.Printf("\nContents written to %s\nRead using bol --decrypt %s\n\n", filename, filename)
+		filename, leftOut, errDump := fs.DumpAll()
+		if errDump != nil {
+			fmt.Printf("\nProblem dumping: %s\n", errDump.Error())
+			return
+		}
+		fmt.Printf("\nContents written to %s\nRead using bol --decrypt %s\n", filename, filename)
+		for _, note := range leftOut {
+			fmt.Printf("Not in it: %s\n", note)
+		}
+		fmt.Println()
 		return
 	}
 
@@ -145,35 +253,64 @@ func Run(workingFile string, changeUser bool, dumpFile bool) {
 	entries := ssed.GetBlankEntries()
 	isNewEntry := true
 	logger.Debug("Working file input: '%s'", workingFile)
-	if len(workingFile) == 0 {
-		data := [][]string{}
-		for fileNum, file := range fs.ListDocuments() {
-			data = append(data, []string{strconv.Itoa(fileNum + 1), file})
-		}
-		table := tablewriter.NewWriter(os.Stdout)
-		table.SetHeader([]string{"#", "Document"})
-		for _, v := range data {
-			table.Append(v)
-		}
-		fmt.Printf("\n")
-		table.Render()
-
-		var document string
-		fmt.Print("Enter document: ")
-		fmt.Scanln(&document)
-		document = strings.TrimSpace(document)
-		if i, err := strconv.Atoi(document); err == nil {
-			workingFile = data[i-1][1]
-		} else {
-			workingFile = document
+	if len(tagName) > 0 {
+		// a virtual document of every entry with the tag, in any document
+		entries = fs.EntriesWithTag(tagName)
+		if len(entries) == 0 {
+			fmt.Printf("\nNo entries tagged #%s\n", strings.TrimPrefix(tagName, "#"))
+			fmt.Printf("Available tags: %s\n", strings.Join(fs.ListTags(), ", "))
+			return
 		}
-		if len(workingFile) == 0 {
-			workingFile = "notes"
+		isNewEntry = false
+		workingFile = "#" + strings.TrimPrefix(tagName, "#")
+	} else if len(workingFile) == 0 {
+		if workingFile, err = chooseDocument(fs.ListDocuments(), os.Stdin); err != nil {
+			c := color.New(color.FgRed)
+			c.Printf("\n%s\n", err.Error())
+			return
 		}
 		entries = fs.GetDocument(workingFile)
 	} else {
 		logger.Debug("Parsing whether it is a document or entry")
-		entries, isNewEntry, workingFile, _ = fs.GetDocumentOrEntry(workingFile)
+		entries, isNewEntry, workingFile, err = fs.GetDocumentOrEntry(workingFile)
+		if err == ssed.ErrAmbiguousEntry {
+			entry, err := chooseEntry(entries, os.Stdin)
+			if err != nil {
+				c := color.New(color.FgRed)
+				c.Printf("\n%s\n", err.Error())
+				return
+			}
+			entries = []ssed.Entry{entry}
+			workingFile = entry.Document
+		}
+	}
+
+	if len(attachFile) > 0 || Extract {
+		if isNewEntry || len(entries) != 1 {
+			fmt.Printf("\nNo entry named '%s'\n", workingFile)
+			return
+		}
+		entry := entries[0]
+		if Extract {
+			fileNames, errExtract := fs.Extract(entry.Document, entry.Entry, ".")
+			for _, fileName := range fileNames {
+				fmt.Printf("\nExtracted %s", fileName)
+			}
+			if errExtract != nil {
+				fmt.Printf("\nProblem extracting attachments: %s", errExtract.Error())
+			} else if len(fileNames) == 0 {
+				fmt.Printf("\n%s/%s has no attachments", entry.Document, entry.Entry)
+			}
+			fmt.Println("")
+			return
+		}
+		attachment, errAttach := fs.Attach(entry.Document, entry.Entry, attachFile)
+		if errAttach != nil {
+			fmt.Printf("\nProblem attaching %s: %s\n", attachFile, errAttach.Error())
+		} else {
+			fmt.Printf("\nAttached %s (%d bytes) to %s/%s\n", attachment.Name, attachment.Size, entry.Document, entry.Entry)
+		}
+		return
 	}
 
 	// Print a quote
@@ -185,7 +322,7 @@ func Run(workingFile string, changeUser bool, dumpFile bool) {
 
 	fullText := ""
 	for i, entry := range entries {
-		fullText += fmt.Sprintf("%s %s\n%s\n\n%s\n\n", JOURNAL_DELIMITER, entry.Entry, entry.Timestamp, strings.TrimSpace(entry.Text))
+		fullText += formatEntry(entry) + "\n"
 		if Summarize {
 			c := color.New(color.FgCyan)
 			if i == 0 {
@@ -202,49 +339,178 @@ func Run(workingFile string, changeUser bool, dumpFile bool) {
 		}
 	}
 	if isNewEntry {
-		fullText += fmt.Sprintf("%s %s\n%s\n\n\n%s", JOURNAL_DELIMITER, utils.GetRandomMD5Hash(), utils.GetCurrentDate(), "")
+		// a new Document/Entry is written with the given name
+		documentName, entryName := ssed.SplitEntryPath(workingFile)
+		if len(documentName) > 0 {
+			workingFile = documentName
+		} else {
+			entryName, err = fs.NewEntryName()
+			if err != nil {
+				c := color.New(color.FgRed)
+				c.Printf("\n%s\n", err.Error())
+				return
+			}
+		}
+		fullText += formatEntry(ssed.Entry{
+			Document:  workingFile,
+			Entry:     entryName,
+			Timestamp: utils.GetCurrentDate(),
+		}) + "\n"
 	}
 	if Summarize {
 		fmt.Println("")
-		os.Exit(-1)
+		exit(-1)
+	}
+	if !isNewEntry && len(entries) == 1 {
+		backlinks := fs.Backlinks(entries[0].Document, entries[0].Entry)
+		if len(backlinks) > 0 {
+			fullText += formatReferences(backlinks)
+		}
 	}
 
-	// Determine editor
-	editor := "vim"
-	if utils.Exists(path.Join(homePath, ".config", "bol", "editor")) {
-		editorBytes, _ := ioutil.ReadFile(path.Join(homePath, ".config", "bol", "editor"))
-		editor = string(editorBytes)
-	}
-	newText := WriteEntry(fullText, editor, len(entries) == 1)
-	if newText == "" {
-		return
+	// entries found by tag can be from any document, so they must
+	// keep their document header
+	defaultDocument := workingFile
+	if len(tagName) > 0 {
+		defaultDocument = ""
+	}
+	var edited []bufferEntry
+	for {
+		newText := WriteEntry(fullText, getEditor(), len(entries) == 1)
+		if len(strings.TrimSpace(newText)) == 0 {
+			return
+		}
+		edited, err = parseBuffer(newText, defaultDocument)
+		if err == nil {
+			break
+		}
+		// keep the edits, so nothing is lost while fixing the problem
+		fullText = newText
+		c := color.New(color.FgHiRed)
+		c.Printf("\nCould not read the entries, %s\n", err.Error())
+		fmt.Print("Press enter to fix it in the editor, or type 'discard' to lose the changes: ")
+		var answer string
+		fmt.Scanln(&answer)
+		if strings.ToLower(strings.TrimSpace(answer)) == "discard" {
+			return
+		}
+	}
+	// only write the entries that changed, and delete the entries whose
+	// block was removed or emptied
+	changes := diffBuffer(entries, edited)
+	saved := bufferChanges{Deleted: changes.Deleted}
+	save := func(e ssed.Entry) bool {
+		if err := fs.UpdateEntry(e); err != nil {
+			c := color.New(color.FgHiRed)
+			c.Printf("\nCould not save %s/%s: %s\n", e.Document, e.Entry, err.Error())
+			return false
+		}
+		return true
+	}
+	for _, e := range changes.Changed {
+		if save(e) {
+			saved.Changed = append(saved.Changed, e)
+		}
+	}
+	for _, e := range changes.Added {
+		if save(e) {
+			saved.Added = append(saved.Added, e)
+		}
+	}
+	for _, entry := range changes.Deleted {
+		fs.DeleteEntry(entry.Document, entry.Entry)
 	}
-	for _, splitText := range strings.Split(newText, JOURNAL_DELIMITER) {
-		lines := strings.Split(splitText, "\n")
-		if len(lines) < 3 {
-			continue
+	c := color.New(color.FgHiCyan)
+	c.Printf("\n%s\n", saved)
+}
+
+func pluralize(n int, singular, plural string) string {
+	if n == 1 {
+		return singular
+	}
+	return plural
+}
+
+// chooseDocument asks which document to edit, by its number or name. An
+// empty answer is the document 'notes'.
+func chooseDocument(documents []string, in io.Reader) (string, error) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"#", "Document"})
+	for i, document := range documents {
+		table.Append([]string{strconv.Itoa(i + 1), document})
+	}
+	fmt.Printf("\n")
+	table.Render()
+
+	var document string
+	fmt.Print("Enter document: ")
+	if _, err := fmt.Fscanln(in, &document); err == io.EOF {
+		return "", errors.New("No document was chosen")
+	}
+	document = strings.TrimSpace(document)
+	if i, err := strconv.Atoi(document); err == nil {
+		if i < 1 || i > len(documents) {
+			return "", fmt.Errorf("There is no document %d, choose one from 1 to %d", i, len(documents))
 		}
-		newEntryText := strings.TrimSpace(strings.Join(lines[2:], "\n"))
-		if len(newEntryText) == 0 {
-			continue
+		return documents[i-1], nil
+	}
+	if len(document) == 0 {
+		return "notes", nil
+	}
+	return document, nil
+}
+
+// chooseEntry asks which entry to edit when an entry name is used in
+// more than one document, until one is chosen or the input ends
+func chooseEntry(entries []ssed.Entry, in io.Reader) (ssed.Entry, error) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"#", "Document", "Entry", "Timestamp"})
+	for i, entry := range entries {
+		table.Append([]string{strconv.Itoa(i + 1), entry.Document, entry.Entry, entry.Timestamp})
+	}
+	fmt.Printf("\n")
+	table.Render()
+
+	for {
+		var choice string
+		fmt.Print("Enter entry: ")
+		_, err := fmt.Fscanln(in, &choice)
+		choice = strings.TrimSpace(choice)
+		if i, err := strconv.Atoi(choice); err == nil && i > 0 && i <= len(entries) {
+			return entries[i-1], nil
+		}
+		for _, entry := range entries {
+			if choice == entry.Document || choice == entry.Document+"/"+entry.Entry {
+				return entry, nil
+			}
 		}
-		entryName := ""
-		if len(lines[0]) > 1 {
-			entryName = strings.TrimSpace(lines[0])
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return ssed.Entry{}, errors.New("No entry was chosen")
 		}
-		timestamp := strings.TrimSpace(lines[1])
-		fs.Update(newEntryText, workingFile, entryName, timestamp)
+		fmt.Printf("Enter a number from 1 to %d, or the document of the entry\n", len(entries))
 	}
 }
 
 func WriteEntry(text string, editor string, singleEntry bool) string {
 	logger.Debug("Editing file")
 
+	// the new entry is at the bottom of the file
+	lastLine := strings.Count(text, "\n") + 1
+
+	tempFolder, err := ssed.TempFolder()
+	if err != nil {
+		c := color.New(color.FgHiRed)
+		c.Printf("\nCould not create a temporary folder: %s\n", err.Error())
+		exit(-1)
+	}
+	tempFile := path.Join(tempFolder, "temp")
+
 	var cmdArgs []string
 	if editor == "vim" {
 		// Setup vim
 		vimrc := `set nocompatible
 set backspace=2
+set noswapfile nobackup nowritebackup noundofile viminfo=
 func! WordProcessorModeCLI()
 	setlocal formatoptions=t1
 	setlocal textwidth=80
@@ -264,6 +530,7 @@ com! WPCLI call WordProcessorModeCLI()`
 		if singleEntry {
 			vimrc = `set nocompatible
 	set backspace=2
+	set noswapfile nobackup nowritebackup noundofile viminfo=
 	func! WordProcessorModeCLI()
 		setlocal formatoptions=t1
 		setlocal textwidth=80
@@ -281,18 +548,19 @@ com! WPCLI call WordProcessorModeCLI()`
 	com! WPCLI call WordProcessorModeCLI()`
 		}
 
-		err := ioutil.WriteFile(path.Join(ssed.PathToTempFolder, ".vimrc"), []byte(vimrc), 0644)
+		err := ioutil.WriteFile(path.Join(tempFolder, ".vimrc"), []byte(vimrc), 0600)
 		if err != nil {
-			log.Fatal(err)
+			logger.Error(err.Error())
+			exit(-1)
 		}
 
-		cmdArgs = []string{"-u", path.Join(ssed.PathToTempFolder, ".vimrc"), "-c", "WPCLI", "+startinsert", path.Join(ssed.PathToTempFolder, "temp")}
+		cmdArgs = []string{"-u", path.Join(tempFolder, ".vimrc"), "-c", "WPCLI", "+startinsert", tempFile}
 	} else if editor == "nano" {
-		lines := "100" // TODO: DETERMINE THIS
-		cmdArgs = []string{"+" + lines + ",1000000", "-r", "80", "--tempfile", path.Join(ssed.PathToTempFolder, "temp")}
+		lines := strconv.Itoa(lastLine)
+		cmdArgs = []string{"+" + lines + ",1000000", "-r", "80", "--tempfile", tempFile}
 	} else if editor == "emacs" {
-		lines := "100" // TODO: DETERMINE THIS
-		cmdArgs = []string{"+" + lines + ":1000000", path.Join(ssed.PathToTempFolder, "temp")}
+		lines := strconv.Itoa(lastLine)
+		cmdArgs = []string{"+" + lines + ":1000000", tempFile}
 	} else if editor == "micro" {
 		settings := `{
     "autoclose": false,
@@ -313,18 +581,23 @@ com! WPCLI call WordProcessorModeCLI()`
     "syntax": false,
     "tabsize": 4,
     "tabstospaces": false,
-		"softwrap": true
+		"softwrap": true,
+    "backup": false
 }`
 		if !utils.Exists(path.Join(homePath, ".config", "micro")) {
 			os.MkdirAll(path.Join(homePath, ".config", "micro"), 0755)
 		}
 		err := ioutil.WriteFile(path.Join(homePath, ".config", "micro", "settings.json"), []byte(settings), 0644)
 		if err != nil {
-			log.Fatal(err)
+			logger.Error(err.Error())
+			exit(-1)
 		}
 
 		lines := "10000000" // TODO determine this
-		cmdArgs = []string{"-startpos", lines + ",1000000", path.Join(ssed.PathToTempFolder, "temp")}
+		cmdArgs = []string{"-startpos", lines + ",1000000", tempFile}
+	} else {
+		// any other editor, from $VISUAL, $EDITOR or a command template
+		editor, cmdArgs = editorCommand(editor, tempFile, lastLine)
 	}
 
 	extension := ""
@@ -332,17 +605,20 @@ com! WPCLI call WordProcessorModeCLI()`
 		extension = ".exe"
 	}
 
-	// Write the file to load
-	ioutil.WriteFile(path.Join(ssed.PathToTempFolder, "temp"), []byte(text), 0644)
+	// Write the file to load, readable only by the user
+	err = ioutil.WriteFile(tempFile, []byte(text), 0600)
+	if err != nil {
+		logger.Error(err.Error())
+		exit(-1)
+	}
 
 	// Try to execute from the same folder
 	programPath, _ := osext.ExecutableFolder()
-	editor = filepath.Base(editor)
-	logger.Debug("Using editor in program path: %s", path.Join(programPath, editor+extension))
-	cmd := exec.Command(path.Join(programPath, editor+extension), cmdArgs...)
+	logger.Debug("Using editor in program path: %s", path.Join(programPath, filepath.Base(editor)+extension))
+	cmd := exec.Command(path.Join(programPath, filepath.Base(editor)+extension), cmdArgs...)
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
-	err := cmd.Run()
+	err = cmd.Run()
 	if err != nil {
 		logger.Debug("Failed using editor in program path: %s", err.Error())
 		// Try to execute from system path
@@ -356,16 +632,26 @@ com! WPCLI call WordProcessorModeCLI()`
 			c := color.New(color.FgHiRed)
 			c.Printf("\n%s not found in system path or local path, \ndo you have it installed?\n", editor)
 			c.Println("\nMake sure you have a editor installed \nin the system or current directory.")
-			c.Println("\nSupported editors are:")
+			c.Println("\nEditors with built-in settings are:")
 			c.Println("- vim:   ftp://ftp.vim.org/pub/vim/pc/vim80-069w32.zip")
 			c.Println("- micro: https://github.com/zyedidia/micro/releases/latest")
 			c.Println("- emacs")
 			c.Println("- nano")
-			c.Println("\nYou can switch editors with\n\n\tbol --editor [vim|emacs|micro]")
+			c.Println("\nYou can switch editors with\n\n\tbol --editor [vim|emacs|micro|nano]")
+			c.Println("\nor use any other editor with a command, like\n\n\tbol --editor \"code --wait {file}\"")
 			fmt.Println("")
-			os.Exit(-1)
+			exit(-1)
 		}
 	}
-	fileContents, _ := ioutil.ReadFile(path.Join(ssed.PathToTempFolder, "temp"))
-	return strings.TrimSpace(string(fileContents))
+	fileContents, _ := ioutil.ReadFile(tempFile)
+	// the text only needs to be kept until it is read back
+	ssed.CleanUp()
+	return string(fileContents)
+}
+
+// exit removes the decrypted files before exiting, as os.Exit does not
+// run deferred functions
+func exit(code int) {
+	ssed.CleanUp()
+	os.Exit(code)
 }
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/schollz/bol/ssed"
+)
+
+func TestChooseDocument(t *testing.T) {
+	documents := []string{"journal", "notes", "work/2017"}
+	tests := []struct {
+		input, document string
+		ok              bool
+	}{
+		{"2\n", "notes", true},
+		{"3\n", "work/2017", true},
+		{"recipes\n", "recipes", true},
+		{"\n", "notes", true},
+		{"0\n", "", false},
+		{"4\n", "", false},
+		{"-1\n", "", false},
+		{"", "", false},
+	}
+	for _, test := range tests {
+		document, err := chooseDocument(documents, strings.NewReader(test.input))
+		if (err == nil) != test.ok || document != test.document {
+			t.Errorf("%q: expected '%s', got '%s' %v", test.input, test.document, document, err)
+		}
+	}
+}
+
+func TestChooseEntry(t *testing.T) {
+	entries := []ssed.Entry{{Document: "journal", Entry: "monday"}, {Document: "work", Entry: "monday"}}
+	tests := []struct {
+		input, document string
+		ok              bool
+	}{
+		{"2\n", "work", true},
+		{"journal\n", "journal", true},
+		{"work/monday\n", "work", true},
+		{"3\n0\nnotes\n\n1\n", "journal", true},
+		{"work", "work", true},
+		{"", "", false},
+		{"3\n", "", false},
+		{"notes\n9\n", "", false},
+	}
+	for _, test := range tests {
+		entry, err := chooseEntry(entries, strings.NewReader(test.input))
+		if (err == nil) != test.ok || entry.Document != test.document {
+			t.Errorf("%q: expected '%s', got '%s' %v", test.input, test.document, entry.Document, err)
+		}
+	}
+}
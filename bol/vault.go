@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/schollz/bol/ssed"
+	"github.com/schollz/bol/utils"
+)
+
+// makeVault asks for a password for the document and moves it to a vault
+// that is encrypted with it. It returns whether the user wants the older
+// archives on the server removed once the vault is uploaded.
+func makeVault(fs *ssed.Fs, documentName string) bool {
+	var password string
+	for {
+		password = utils.GetPassword("password of the vault")
+		fmt.Println("")
+		if len(password) == 0 {
+			fmt.Println("The password can not be empty.")
+			continue
+		}
+		if utils.GetPassword("password of the vault again") != password {
+			fmt.Println("\nThe passwords do not match.")
+			continue
+		}
+		break
+	}
+	if err := fs.NewVault(documentName, password); err != nil {
+		c := color.New(color.FgRed)
+		c.Printf("\nCould not make the vault: %s\n", err.Error())
+		return false
+	}
+	c := color.New(color.FgGreen)
+	c.Printf("\n'%s' is in a vault, its password is asked for when it is opened\n", documentName)
+	c = color.New(color.FgYellow)
+	c.Println("Its versions from before, which open with your password, are removed from other devices when they open bol, but they stay in the older archives on the server. Copies made elsewhere, like with -dump, are not removed.")
+	fmt.Print("Remove the older archives on the server once the vault is uploaded? They also have the older versions of your other documents. (y/N) ")
+	var answer string
+	fmt.Scanln(&answer)
+	return strings.ToLower(strings.TrimSpace(answer)) == "y"
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"archive/tar"
+	"compress/bzip2"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/schollz/archiver"
+	"github.com/schollz/bol/utils"
+)
+
+// archivesLock keeps the web interface from adding to an archive while a
+// client pushes a new one
+var archivesLock sync.Mutex
+
+// ErrFileExists is returned when the web interface adds a file that is
+// already in the archive, as every version has its own file
+var ErrFileExists = errors.New("The file is already in the archive")
+
+// saltCache keeps the salt file of the latest archive of each user, so an
+// archive is read once for the salt, not on every login
+var saltCache = struct {
+	sync.Mutex
+	salts map[string]cachedSalt
+}{salts: make(map[string]cachedSalt)}
+
+type cachedSalt struct {
+	archive  string    // the archive the salt was read from
+	modified time.Time // when it was written, as pushes in the same second have the same name
+	salt     []byte    // nil for archives without a salt
+}
+
+// readSalt returns repository.salt of the latest archive of the user, or
+// nil when it has none
+func readSalt(username string) ([]byte, error) {
+	latestFileName, err := getLatestFileName(username)
+	if err != nil {
+		return nil, nil
+	}
+	fileName := path.Join(wd, "archive", username, latestFileName)
+	fi, err := os.Stat(fileName)
+	if err != nil {
+		return nil, err
+	}
+	saltCache.Lock()
+	cached, ok := saltCache.salts[username]
+	saltCache.Unlock()
+	if ok && cached.archive == latestFileName && cached.modified.Equal(fi.ModTime()) {
+		return cached.salt, nil
+	}
+	salt, err := readArchiveFile(fileName, "repository.salt")
+	if err != nil {
+		return nil, err
+	}
+	saltCache.Lock()
+	saltCache.salts[username] = cachedSalt{latestFileName, fi.ModTime(), salt}
+	saltCache.Unlock()
+	return salt, nil
+}
+
+// readArchiveFile returns a file of an archive without extracting the
+// others, or nil when the archive does not have it
+func readArchiveFile(archiveName, name string) ([]byte, error) {
+	file, err := os.Open(archiveName)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	tr := tar.NewReader(bzip2.NewReader(file))
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if filepath.Base(header.Name) == name {
+			return ioutil.ReadAll(tr)
+		}
+	}
+}
+
+// readArchive returns the files of the latest archive of the user, which
+// are all encrypted
+func readArchive(username string) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	latestFileName, err := getLatestFileName(username)
+	if err != nil {
+		return files, nil
+	}
+	folder, err := ioutil.TempDir("", "bolserver")
+	if err != nil {
+		return files, err
+	}
+	defer os.RemoveAll(folder)
+	if err = archiver.TarBz2.Open(path.Join(wd, "archive", username, latestFileName), folder); err != nil {
+		return files, err
+	}
+	fileNames, _ := filepath.Glob(path.Join(folder, "*"))
+	for _, fileName := range fileNames {
+		b, err := ioutil.ReadFile(fileName)
+		if err != nil {
+			return files, err
+		}
+		files[filepath.Base(fileName)] = b
+	}
+	return files, nil
+}
+
+// addToArchive makes a new archive of the user with the latest one and an
+// encrypted file from the web interface, which clients get when they pull
+func addToArchive(username, name string, data []byte) error {
+	archivesLock.Lock()
+	defer archivesLock.Unlock()
+	folder, err := ioutil.TempDir("", "bolserver")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(folder)
+	if latestFileName, err := getLatestFileName(username); err == nil {
+		if err = archiver.TarBz2.Open(path.Join(wd, "archive", username, latestFileName), folder); err != nil {
+			return err
+		}
+	}
+	if utils.Exists(path.Join(folder, filepath.Base(name))) {
+		return ErrFileExists
+	}
+	if err = ioutil.WriteFile(path.Join(folder, filepath.Base(name)), data, 0644); err != nil {
+		return err
+	}
+	fileNames, _ := filepath.Glob(path.Join(folder, "*"))
+	initializeUser(username)
+	archiveName := path.Join(folder, "archive.tar.bz2")
+	if err = archiver.TarBz2.Make(archiveName, fileNames); err != nil {
+		return err
+	}
+	fi, err := os.Stat(archiveName)
+	if err != nil {
+		return err
+	} else if fi.Size() > quotaOf(username)-sharesUsed(username, "") {
+		return ErrQuotaExceeded
+	}
+	fileName := path.Join(wd, "archive", username, username+"."+utils.GetUnixTimestamp()+".tar.bz2")
+	if err = utils.CopyFile(archiveName, fileName); err != nil {
+		return err
+	}
+	go cleanFiles(username)
+	return nil
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/schollz/cryptopasta"
+)
+
+// authPrefix marks the logins that are hashes of authentication keys (see
+// utils.AuthKey). Logins without it are hashes of the passwords that older
+// clients sent, which the clients replace with their key.
+const authPrefix = "auth:"
+
+// checkLogin returns whether the user exists, and whether the credential,
+// which is its authentication key, or its password for older clients, is
+// correct
+func checkLogin(username, credential string) (bool, bool) {
+	user, err := users.Get(username)
+	if err != nil {
+		return false, false
+	}
+	return true, cryptopasta.CheckPasswordHash([]byte(strings.TrimPrefix(user.Login, authPrefix)), []byte(credential)) == nil
+}
+
+// legacyLogin returns whether the login of the user is still its password
+func legacyLogin(username string) bool {
+	user, err := users.Get(username)
+	return err == nil && !strings.HasPrefix(user.Login, authPrefix)
+}
+
+// isAuthKey returns whether the client sent an authentication key, as
+// older clients send the password
+func isAuthKey(r *http.Request) bool {
+	return r.Header.Get("Bol-Authentication") == "key"
+}
+
+// hashLogin returns the login of a user for the UserStore
+func hashLogin(credential string, isKey bool) string {
+	hashedPassword, _ := cryptopasta.HashPassword([]byte(credential))
+	if isKey {
+		return authPrefix + string(hashedPassword)
+	}
+	return string(hashedPassword)
+}
+
+// addLogin adds a user with the credential, unless it exists
+func addLogin(username, credential string, isKey bool) bool {
+	err := users.Add(User{
+		Username: username,
+		Login:    hashLogin(credential, isKey),
+		Created:  time.Now(),
+	})
+	if err != nil && err != ErrUserExists {
+		log.Printf("Could not add user '%s': %s\n", username, err.Error())
+	}
+	return err == nil
+}
+
+// setLogin changes the credential of a user
+func setLogin(username, credential string, isKey bool) error {
+	return users.Update(username, func(user *User) error {
+		user.Login = hashLogin(credential, isKey)
+		return nil
+	})
+}
@@ -1,5 +1,6 @@
 // Code generated by go-bindata.
 // sources:
+// static/bol.js
 // static/bootstrap.min.css
 // static/github.css
 // static/jquery.min.js
@@ -74,6 +75,26 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
+var _staticBolJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xad\x3c\x6b\x97\xd3\x46\xb2\xdf\xf9\x15\x8d\xb2\x0b\x32\xe3\xf1\x43\x7e\x8c\xed\x61\x86\x43\x80\x04\x6e\x1e\x64\x03\x9b\xbd\x7b\x3d\x66\xd1\xa3\x6d\x8b\x91\x25\xaf\x24\x33\xcc\x49\xf8\xef\xb7\xaa\xfa\xa1\x6e\x49\x1e\xc8\xde\xcb\x49\x46\x52\xab\xbb\xba\xba\xba\xde\x5d\x72\xbf\xcf\x82\x2c\xe9\x7d\x28\x18\x4f\xc3\xfc\x76\x5f\x16\xcc\x4f\x23\x16\x71\xf9\xc0\xd3\x32\x8f\x79\xc1\xe2\x94\x95\x5b\xce\x82\x3c\xbb\x29\x78\xde\x65\x45\x46\xcf\x70\xff\x91\xe7\x2c\x4b\x93\xdb\x7b\xfd\x3e\xdb\xfa\x05\x35\x4b\x58\x3c\x62\xeb\x38\x81\xd1\xd9\x9a\x9a\x73\xbe\xcf\x8a\xb8\xcc\xf2\xdb\x1e\x7b\x55\xb2\x5d\x9c\xe7\x59\x2e\x46\x1c\xca\x38\x29\xd8\xde\x0f\xaf\xfd\x0d\x5f\x20\x2c\x31\xd2\xcf\x39\x7b\xfa\xe2\xcd\xa9\x37\x99\x9e\x7e\xff\xec\xa7\x2e\xbb\xe6\xb7\x00\xf6\x26\x2e\xb7\x34\xee\xcd\xcb\xa7\xf8\x4e\xcd\x00\x6f\xe5\x2d\x82\xa8\xe6\xeb\x56\x23\x86\xde\x69\x70\x5b\x72\x96\x66\x69\x08\x0b\xe2\xeb\x0c\xa6\xc0\x17\x61\xbc\xdf\xf2\xbc\xe4\x9f\x4a\xe8\x9d\xc7\x65\xc9\x53\x5c\xf6\x96\x7f\x42\x58\x6e\xc1\xa1\x0b\xae\x2a\xdb\xfb\x45\xe9\x77\xba\x2c\xcb\xd9\x7f\x3f\xdb\xfa\xf0\x9f\x37\x38\xfd\x25\x4b\x6e\x87\xa3\xc1\x84\xf9\xeb\x12\x28\xe2\xa7\xcc\xf9\x14\x6e\xfd\x10\x5f\xee\xe5\xbb\x85\x43\x44\xe2\x7e\xc4\xf3\x1e\x7b\x2b\xf1\x8d\x0b\x20\x77\x1e\x7f\x44\x6a\xe5\xd9\x8e\x70\x81\x29\x8a\x9b\x2c\x37\x16\x5a\xf8\x49\x09\x4b\x43\x00\x36\x29\x05\x66\x45\xc1\xa3\x3e\xf6\xe9\x6d\x32\x81\x5a\x5c\xd8\x90\x60\x29\x7a\x10\xec\x28\x91\x18\xa7\x93\x14\xc0\xb1\x45\xef\xde\x47\x3f\x47\x86\x60\x17\xcc\x5d\x1f\xd2\xb0\x8c\xb3\xd4\xed\xb0\xdf\xef\x31\xe6\x1c\x0a\xe8\x05\xdc\x10\x96\xce\xf9\x3d\x68\xc0\xae\xc5\x21\x28\x13\x0e\xbd\x6f\xe2\x34\xca\x6e\x7a\x82\x40\xec\xc1\x03\xbb\xa1\x27\xfa\x9d\xcb\x51\xc0\x1e\x19\x2c\x19\x86\xa5\xfc\x86\xbd\x05\x8a\xbf\x10\x2d\x6e\x47\x75\x01\x06\xac\x75\x79\xce\x6b\x5d\x7e\x78\xf1\x4f\x78\xed\x00\xb6\xa7\x40\x46\x81\x93\xc2\x99\x95\xd9\x4b\xfe\xc9\x0d\x0e\xeb\x35\xcf\x05\xfe\x62\x10\x6e\x7d\x21\xa1\xfe\x3d\x4e\xcb\xd9\xd3\x3c\xf7\x6f\x55\xc7\x73\xdd\x0f\x76\x1d\x81\x3b\xa2\x05\x28\xc4\x5c\x6c\x8e\xa1\x71\x70\x0e\x97\xc7\x02\x52\x2f\xe1\xe9\xa6\xdc\x42\xcb\xc9\x89\x9a\x86\xd1\xe0\x13\xa0\x20\x75\x59\xc6\x2b\xe8\x3d\x9c\xb2\x27\xcc\x19\x38\x6c\x01\x40\x3b\xec\x84\xa9\x77\xbd\x32\x7b\x03\x44\x4d\x37\xee\x70\x2a\xe7\xff\x4c\x7f\x73\x5e\x1e\x72\x62\x3f\x6c\xfd\x6c\xad\x0e\x37\x0e\xd7\x07\x2f\xd5\xac\xf1\x9a\xe1\xa3\x44\x88\xfd\x95\x79\xec\xfe\x05\x20\xcb\xfe\xf8\x83\xf5\x97\xef\x06\xa7\x73\xff\x74\xbd\xea\xc7\x3d\x98\xb6\xa4\x81\x15\xbe\xe5\x16\xc4\x9a\x48\xf2\x02\x25\xd2\x75\x7e\xce\x4a\x9c\xd8\xb1\x10\xba\x83\x7c\xc6\xc4\x7d\xe6\x75\xfe\x23\xa2\x69\x62\x5d\x00\xcf\xe6\x05\x7f\x95\x12\x9a\xc8\x3a\xc0\x75\xae\xc7\x1e\xb1\xb8\x0b\xc0\xbb\xac\x9d\x50\x34\xbe\x49\xaa\x02\x04\x70\x32\x45\x62\xa1\x64\xab\xe9\xe4\x18\xc1\x96\xbd\x28\xde\x20\x51\x1c\xa9\x4c\x9c\xae\xe2\xd0\x9e\xb8\x8a\xa1\x9d\x1e\xc8\x53\xea\x12\x67\x75\xf4\x44\x20\x46\xc5\x61\xbf\xcf\x72\xd4\x76\x02\x6c\xc1\x6e\xb6\x1c\xfa\xe6\xa6\xca\x64\x21\xa8\x04\xa9\x17\x41\xbf\x6c\xe3\x70\xcb\xe2\x92\x34\x27\xbe\x12\x90\x32\x54\xa6\xdb\xb2\xdc\x17\x28\xc1\x80\x7d\x92\x85\x7e\xb2\xcd\x8a\xd2\x5a\x92\x9a\xcf\xad\x2d\xe7\xfe\xfd\x4a\xce\x6c\x2a\xec\x83\xeb\x68\xed\x21\x15\x94\x3e\xe8\x92\xc4\x77\x01\x07\x9e\xfb\xd8\xa7\x68\xa7\x4d\xbc\xc3\xb9\x7e\xe0\xb7\xae\x93\xfb\x37\x4d\xd2\x28\x78\xb0\x2f\xce\x2f\xdf\xfe\xf0\xfc\x3b\x0f\xfa\xac\xfd\xa4\xe0\x5d\xb6\x74\x84\x62\xfb\x36\x2e\x0b\x67\x25\xe9\xa7\xd5\x0a\x08\x6d\xb5\xfb\xb5\x0d\xd1\xc3\xdc\xdf\x53\x7f\xc7\x17\x06\x6c\x44\x7b\xd1\x40\x7e\x61\xdc\x77\xd1\x0e\x6d\x61\x8c\xda\xcf\xcf\x64\x36\x80\x79\x26\x9a\x73\x8e\x6e\xa6\x7f\x28\xb7\xb0\x5a\x6d\x9c\xc8\x30\xf5\x9e\x8a\xd6\xba\xdd\x4b\x39\xfe\xdd\xf0\xd2\xd6\xb5\x26\xe5\x25\x3c\x17\xb4\x67\x8e\x4b\xe9\xea\x5e\x35\x7a\xb7\x6d\x51\x8d\xd6\xa8\xeb\x08\x20\x58\xe5\x38\xa4\xc5\x32\x07\x74\x89\x82\x8d\xb2\x31\xc0\x7f\xd6\x8a\x2a\x53\x61\xae\xcb\xb0\x96\x68\x3b\xba\xda\x06\xd5\x2d\x0e\x19\xd3\x1a\x20\x65\x6e\x0f\xe4\x0b\x80\xea\x01\x4e\x46\xdb\x9f\xa5\xdc\x5c\xbb\x35\xf3\x97\x29\xb0\xe6\x65\xb8\x75\x1d\x32\x63\x4f\x54\xef\x0b\x5c\xa0\x58\xff\xdf\x7f\x7d\xf5\x2c\x03\x76\x4c\x61\xf9\x1a\x5a\xa7\xce\x56\x39\x2f\xa0\x4b\xc1\x2b\xde\x42\xd5\xa8\x5a\x7b\x60\xba\xcb\x03\xa8\x30\xd0\x8d\xde\x60\x5c\x75\xaa\xf6\x41\x22\x77\x2e\x5f\x7c\x66\x1c\x98\x99\x80\xdc\xd7\x50\xb2\x6b\x73\x64\x43\x7f\x3e\xcb\x0e\x49\x04\x1e\x46\x89\xbc\x51\x27\xa6\x41\x16\xa5\x60\x95\x2a\xd3\x48\xe8\x89\x3e\x14\x68\x80\x6b\x4b\x44\x60\xe6\xfc\x88\x1b\xd9\x7e\xe0\x20\xd2\xfa\x8e\x60\xa6\x53\xa1\xfc\x1c\xb3\x6f\x0b\xb6\xdf\x0a\x0d\x55\x90\x8a\x42\xa4\x05\x2b\x20\x7b\x14\x82\x1d\x70\x0f\xd4\x04\x1a\xe5\x0a\xe9\x3b\x79\x58\xd9\x2a\x1a\x4f\x98\x0b\x19\xee\x19\x9a\x47\x53\x41\x8b\xa7\xc9\xc0\x49\xb6\x01\xe7\xe5\x9a\xf3\xbd\xe5\x5d\x22\x87\x91\xff\x24\x5c\xd3\xd2\x0f\xd8\x01\xe4\x22\x41\x66\x04\xe7\x27\x4c\x32\xe2\x6b\x70\x67\x15\xfb\x0a\xe5\x8c\x9d\x6b\x42\x04\x60\x4c\xbe\xa5\x09\xbf\xcc\xaf\x5f\x64\x6f\xb1\x6d\xda\x02\xdd\xa9\x00\x0b\x5e\x14\xd0\xf8\x06\xc0\x81\x07\xdc\x2b\x78\xf9\xaa\xe4\x3b\x17\x9c\x1c\x52\x5e\x9a\x44\x72\xee\x3b\xd4\x4a\x83\x84\xe6\xba\xb2\x43\xa9\xad\x46\x6d\xca\x9c\xef\xc0\xfe\xa8\x59\xad\x1d\x00\xd9\x46\xdd\xd1\x34\x6f\xe8\x64\x02\xdd\x6b\x7e\xbf\x24\xa9\x32\x73\x19\x76\x16\x80\xa0\xb3\x8e\x06\x0a\x40\x5b\xe1\xc0\xa2\x0c\x39\xcf\x44\x56\xcc\x59\x37\x71\x35\x9c\x37\x15\x99\x3a\xc4\xf9\xe9\x21\x49\x9a\x0b\xbf\x36\x00\x55\xde\xdd\x1d\xb0\xce\xb5\x4f\x75\xdf\xf0\xb1\x34\x16\xbf\x00\x4f\xc7\x05\x92\xec\x03\x0f\x4b\xd7\x90\xa5\x1f\xb3\x0d\x32\xa4\xbf\xf1\x91\x2d\x33\x15\x48\x39\x9d\x36\x97\xe5\x98\x89\x35\xdd\x3b\x30\xab\x18\xff\x40\xec\x63\xda\x55\x49\x68\x68\x72\xd4\x0c\x2b\x6b\xc7\xb4\x4c\x0b\xc2\x02\x35\x41\xa0\x5b\xa2\x15\x0a\x1e\xc8\xae\x9c\x8a\xf8\xc7\xe9\x90\x91\x23\x19\x12\xa0\x22\x50\xb8\x40\x31\x88\x11\x7c\x8a\x03\x7f\xfd\xee\x19\x9b\x8d\x47\x73\x19\x53\xbd\x54\x40\xc9\xe1\xc3\x8d\xf5\xc6\x46\x84\xd5\x63\xaf\x31\x38\x14\x90\x04\xaa\xe0\xec\xa2\x80\xa6\x9c\x47\x24\xa0\x82\x77\x6e\x78\x00\xc2\xc4\x29\xf4\x02\x1f\xd3\x08\xfa\x7a\x96\x4d\xc9\xca\xc4\xfd\xd4\x65\x69\x8d\x31\xdc\x4f\xec\xf1\x63\x6c\xfd\x03\x6f\x2f\x2f\x2f\x99\x3b\xf2\xd8\x29\xb4\xb4\x08\xc2\xbf\x0f\x3e\xf8\x4f\xf9\xaf\xd9\x21\x8d\x5c\xf0\x17\xfc\x2e\x0b\xba\x2c\x04\x23\xa8\x65\x63\xe9\xa3\x27\xea\xd2\x15\x94\xdf\x32\x58\x21\x68\x70\x63\x8b\x65\x84\x6f\x08\x0f\xba\x7f\x47\x9d\x0d\xb7\xb4\x58\x86\x72\x6c\x28\xc6\x46\xd5\xd8\xc0\x18\x1b\x88\xb1\x21\x8e\xf5\xf4\xd8\x3f\x3d\xef\xec\x3f\x9f\xf6\xcc\xe2\x19\x11\xae\xbe\x01\x03\xc9\x55\xf4\x58\xd0\x03\x08\xac\xcf\x02\xf0\x45\xaf\x95\x48\xf3\x34\x2a\x2a\x4f\x21\x04\x3a\x8a\xa0\x57\xaa\x5a\xda\x79\x8a\x42\xf1\xb5\x88\xb4\x01\x88\x66\x15\x73\x2f\x8c\x59\x5d\x80\x9f\x40\x70\x6d\x8a\xaa\x19\x6d\x8c\x3c\x11\x6e\x54\x94\x46\x3d\xe9\x2e\x07\x9f\xa6\xc3\xb3\xc1\xd9\x6c\x3a\xe9\xb2\xc1\xa7\xd1\xc8\x1b\x4c\xc7\x53\x8e\xf7\x67\xf3\xa9\xe7\x45\x23\x0f\xef\xa7\x01\xb4\x4f\xce\xc6\x2b\x6b\x30\xaa\xcc\xc2\xbd\x06\xae\x1f\x5b\xed\x88\x87\xb1\x2f\x4a\x62\x9b\xbc\x24\xf0\x27\x56\x2a\x5c\xed\x44\xb7\x05\x3f\xc3\x41\x2d\xe4\xa9\xb3\xe1\x00\x90\x80\xfd\x14\xd3\x36\xde\x0e\xbb\x0c\xd6\x07\x62\x37\x1c\x69\x6b\x50\xef\x03\x0b\x9d\xa2\x13\x08\xff\x8f\x5b\x40\x8c\x60\xcb\xe1\x15\x40\x1a\x4e\x8e\xc2\x18\xd0\x3c\x04\x63\xd2\x8e\xc6\x54\xc2\xf0\xee\xc2\xe3\x4c\xac\x64\xd4\x8e\xc6\x58\xac\x64\x6c\xa8\x45\xc5\x86\xb4\x23\x40\x70\x1f\xfe\x26\x71\x09\x1a\xf2\x14\xd8\x2d\x06\xdf\x64\xe4\x9d\x06\xa0\x98\xa8\x83\xd6\x1b\xe5\xed\x1e\xbc\x56\x1f\x19\x83\xb4\x5d\x26\x63\x29\x74\xcd\x6f\x55\xf4\x65\x6e\x98\xd8\x71\x0a\x17\x6b\x3a\xa4\xce\x67\x22\x5a\x2d\x92\x38\xe4\xe0\x83\x19\x39\x02\x9b\x03\xb6\x2a\xcf\x83\xfc\x4b\xcc\x5e\x67\xe0\x1a\x8f\x0b\x0c\x44\x4f\x49\x80\x1a\x17\x55\x89\x08\xb0\x11\xe8\xe4\x34\x85\x40\x8b\x3d\x75\x20\x9e\x2d\x30\x58\x26\x4a\xb8\xc8\x4a\x9d\x3b\x7b\x0c\x3d\x52\x59\x15\xdf\xd7\x88\x20\x43\x7b\x39\xb8\xbe\x78\xad\x2e\x40\xef\xeb\xcc\x61\x96\x57\x89\x43\xdc\x9a\xc8\x2f\xfd\x6e\x95\xd4\x22\x0d\xa2\x94\x45\x53\x84\x0c\xfa\x75\x55\xaf\x2e\xc1\x30\xc9\x09\x0e\x4c\x33\xff\x80\x9d\x64\x56\xc1\x20\x5d\x8a\x79\x29\x83\xd4\xb5\xb4\x44\xb6\x5e\x03\x49\x84\x78\xca\xfb\xc7\xcc\x80\xa4\x5b\x4f\x2e\xd8\x74\xac\x51\x32\x05\x98\x04\x3c\x8d\xcb\xd8\x4f\x9a\xbb\xbc\xd4\x6b\x48\x97\x83\x15\xfe\x1d\xd2\x5f\x6f\xb5\xd2\x72\xa3\x18\x44\x02\x51\xbc\xa6\x5e\xb7\x72\xc5\x11\xe5\x32\xad\x29\x17\x34\x08\xb1\x34\x08\x31\x1a\x04\x39\x07\x3c\x08\xb3\x50\x8b\x3c\x08\x95\x12\xc4\x6e\xd7\xa4\x6f\xd1\xb3\x12\x64\x06\x06\x1f\x04\x06\x1f\x00\x83\xe9\x18\xf3\x7e\x8a\x66\xd4\x64\x51\xf3\x83\x8d\x1d\x6c\xe4\xb2\xea\x8c\x88\x62\x6f\xab\xe9\x9d\x44\x68\xf9\x61\x65\x63\x6b\x79\x51\x00\xa8\xc5\xd5\x25\xc5\xf1\x82\xf4\x86\x2d\xeb\x88\x35\x7a\x7f\xdf\xc6\x1b\x4c\x31\x0d\xda\xb2\x55\x66\x9a\x0a\x7c\x88\x21\x92\xf8\x52\x90\xfa\xf4\xb4\x5a\x04\x82\x11\x7e\x87\x04\x36\xeb\x20\x69\xe5\x83\x4a\x68\xb5\x79\x7e\x2d\x39\x3d\x95\x24\x46\xd6\xd9\x81\x67\x0a\x2e\xa9\x89\x32\xa6\x42\xad\x45\x5d\x5b\xc2\x0e\x92\xdc\x61\x0f\xd4\xd4\xce\xe0\xd3\x60\x4d\xff\xc2\x23\xd7\xb5\x63\x08\xca\x1e\xd7\x21\x87\x0e\x3b\xc6\x7a\x00\x1f\x00\x7b\xaa\x1e\x27\x66\x82\xf4\x0b\x24\x94\x6c\x29\x57\x52\xa5\xfc\x50\x9a\x00\x59\x4b\x84\x84\x62\xb8\xd0\x9d\xf5\xc2\xe2\x2e\x0e\xa8\x3c\x2b\x46\xd3\xba\xee\x16\x1a\xed\x1d\x46\x08\x98\x56\x6d\x5d\xc6\x8c\x3d\x12\x73\x28\x1d\x01\x8b\x7a\xc4\xf2\x0e\xfb\x2b\xdb\x9b\x9b\x43\xc0\x1b\xb0\x0d\x42\x0f\xc1\xf2\x8d\xbc\x0e\x91\xda\x6d\xa7\x98\x37\x33\x29\x36\xec\x18\x24\x2b\xfd\x4d\x53\xb4\xf4\xda\xda\x44\x0a\x85\xda\x12\x1b\x00\xb1\x24\x59\xf9\xf9\xb0\x0b\x78\x0e\xc8\xea\x3d\xf7\x26\x93\x8e\x41\xa6\xcb\xcb\x8b\x8a\x2b\x5b\x38\x10\x20\x35\x79\xb0\x71\x62\xf1\x7a\x0f\x01\xeb\xb5\xad\x85\x31\x2a\x42\xa3\x0b\x0e\x9d\x98\x80\x92\x29\x0e\xe8\x28\xbe\x8e\x53\x1e\x39\x77\x24\x96\xdf\x62\xc4\x68\x66\x44\x45\xba\x81\x6c\x46\x73\x76\x3b\xff\x8c\xf3\x1a\x0a\x05\xc8\xe3\x8d\x05\x73\x1c\x9f\xef\x27\x3f\x01\xba\xee\xc0\x41\xa8\x4e\x77\x9a\x59\x6d\x6d\x63\x2d\x4b\x4e\x73\xd5\x65\xcc\xb0\x2e\xe4\xd5\x36\xf7\x53\x39\x45\x22\xfa\x41\xa3\x6b\x03\x42\x1e\xf2\xc6\x86\xdd\x45\x60\x15\x76\x52\x0f\x56\xfd\xbd\x71\xd7\xd4\xa3\xa8\x8e\x14\xcb\xc8\xb3\x20\xe4\xab\x10\x53\xc7\x45\xed\x1c\x8b\xed\xfd\x08\x42\x2c\x8c\x43\x87\x53\x99\xbb\xc7\xd3\xbd\x18\xec\xb3\x80\x56\xa9\x00\xd1\xf3\x82\xfd\xe4\x97\xdb\x5e\xc8\xe3\xc4\xad\xe0\x54\xb9\x7d\x24\xf6\x23\x64\x4b\x3d\x70\xe7\x87\xcf\x01\xbb\x26\x21\x24\x44\x43\x7a\x65\x57\xa2\x49\x05\x5c\x91\x0b\x46\xe3\xdb\xdf\x62\x7e\xe3\xaa\x9e\xd2\xea\xe0\x08\xe1\xfa\x54\x50\xc1\xb7\x6c\x20\xd8\x65\x65\x7e\xe0\x0d\x89\xd3\x9a\x55\xef\xae\xd8\x70\xed\x6c\xc0\xde\xd6\x90\x47\x21\xef\x2a\x84\x0d\x80\x51\xbc\x5e\x93\x78\xde\xfb\x6a\x3b\x4c\x43\xfe\xb8\x20\xd1\x8d\xd1\xa6\x91\x99\xab\xef\x28\xda\xe7\x55\x83\xdb\x71\x28\x9d\xe2\xdc\xc1\xe2\xaf\xd2\x30\xcb\x73\x1e\x96\x22\x9d\x0b\xbc\x04\x8f\x07\x7d\xf8\xea\xb4\x09\xff\x31\x42\x0c\x4d\xa2\x2a\x8f\xd7\xf4\xf9\xa4\x88\xfe\x06\xd3\x55\x47\x8c\xc4\x6a\xa0\x0d\x54\x62\x80\x15\x5c\xa8\x13\xaf\x37\xeb\x79\xf0\xe4\x27\x66\x5e\x59\x24\xdb\x30\xd5\x9e\x46\x55\x6c\x18\xe5\xfe\xba\x3c\x8d\xf3\x72\x7d\x1a\xae\xf3\xcd\xa9\x9c\x89\x3d\xed\x8d\x7a\x43\x91\x6a\x40\xd7\x0f\x59\x17\x58\x20\x46\xf8\x7e\x22\xd3\x0a\xe4\x66\x8a\xc0\x14\x33\x19\x51\x06\x7c\x8e\x8a\xe5\x50\x70\x71\xcc\x6a\x88\x84\xc4\x19\x73\x1a\x32\x0f\x45\x33\x77\x2b\x94\x00\x84\x48\xef\xf7\x83\x38\x45\xd8\xff\xc2\x23\xb3\xde\x26\x03\xb2\xf1\xf0\xba\xd0\x32\x47\x49\x1e\x3a\x9a\x81\x91\x9b\x2c\xf1\xd3\x4d\x2f\xcb\x37\xfd\x4f\x7d\x79\xea\x29\x0f\x2a\x6d\xa2\x5d\xc8\xad\x04\x32\x2c\x98\x33\x1b\xcc\x86\x33\x6f\x36\x9a\x8d\x67\x93\xd9\x74\x76\x36\x9b\xcd\xe6\x33\x7f\x16\xcc\xc2\x59\x34\xe3\xb3\xf5\x7c\x30\x1f\xce\xbd\xf9\x68\x3e\x9e\x4f\xe6\xd3\xf9\xd9\x7c\x36\x9f\xcf\xfd\x79\x30\x0f\xe7\xd1\x9c\xcf\xd7\x4e\x57\xa6\xf2\x90\xc6\x00\x6f\x3c\x18\x0f\xc7\xde\x78\x34\x1e\x8f\x27\xe3\xe9\xf8\x6c\x3c\x1b\xcf\xc7\xfe\x38\x18\x87\xe3\x68\xcc\xc7\xeb\xc9\x60\x32\x9c\x78\x93\xd1\x64\x3c\x99\x4c\x20\x1a\x76\xd8\x89\x64\x2c\x27\x88\xa6\xd1\xf0\x6c\x1e\x8d\xf8\x6c\x14\x8d\x47\xc1\x7c\x72\x06\x1d\x60\xe2\x51\x38\xe0\x80\xc1\xe4\xcc\xf3\x21\xcc\x1e\x78\x13\x2f\x58\xfb\x61\x18\xf0\xc8\x9b\x0f\xbc\xd0\x1b\x8e\xe6\xd3\x30\x08\x0c\x50\x67\xa3\x61\x78\xb6\x1e\x06\x83\x60\xec\xfb\xd3\xf1\x78\x10\xac\x47\xfe\xcc\x5b\x8f\x79\xe4\x9f\xf1\xd1\xdc\xe7\xd3\x71\x38\x3d\x1b\xcc\xc2\xc9\x18\xc6\xc3\xe8\xf9\x34\x38\xf3\xf8\x10\x60\x05\xe3\x89\x67\x80\xf2\xd6\xb3\x70\x1e\xf8\xe3\x41\x14\x4c\xa2\xf9\x78\x12\x0c\x87\xc1\x14\xd6\x3f\xf3\xc2\x61\x10\xcc\xf9\x68\x3d\x02\x64\xbc\x20\x1c\x4d\xe7\xe3\xd9\x6c\x7d\x06\x61\xfe\x68\x36\x82\xb5\x45\x23\x70\x73\xe6\x26\xa8\xe1\x7a\x3e\x85\x89\xe7\x67\xd3\xd1\x59\xe4\xc3\x65\x36\x1b\x7a\xeb\xe9\x70\x12\x4e\x67\x01\xcc\x3c\xf1\xf8\xfa\x8c\x4f\xe1\x6f\xb0\x1e\x4f\x06\xb3\x79\x14\x0c\x61\x0b\xc2\x99\x3f\x5a\x0f\xf8\x78\xc8\x27\x8a\xde\xc8\x4a\x40\xed\x1f\xfd\x28\x96\x3a\xf5\x7b\x9e\x82\xcb\xb0\xe3\xa9\x62\xab\x30\xf1\x0b\xca\x75\x3e\x9c\xcf\x17\xec\xd5\x9a\xbd\xc2\x08\x22\x89\xd0\x43\x06\xe3\x77\x9b\x1d\xc4\xe1\x1f\x72\x62\x19\xef\x75\xf6\x6c\x7d\x00\x11\x05\x59\x2c\x0e\x69\x11\xe6\x9c\x63\xc0\x8a\xc3\x02\x0e\xcc\xdf\x73\x50\x18\xcf\x6d\xc6\x7a\x86\x6c\x49\xca\x9b\xf2\x83\xe7\x3a\x3e\x83\x66\x99\xf2\x63\x19\x18\xf2\xa2\xc6\x89\x42\xe6\x8d\x0a\x88\x75\x9c\x17\x25\x69\x0d\xca\x01\xfa\x02\x8e\x32\xd7\xe5\xd6\x2f\xc5\x59\x17\xc6\xe0\x79\x96\x6e\x58\x81\x31\x37\x66\x0b\x41\x0c\x20\x5e\xa7\xc3\xa4\x6d\x76\x83\x09\xbe\x8d\x9f\x07\xe0\xcb\xd9\x61\x5e\x85\x90\x6b\x7a\x13\xf6\x3a\xea\xd9\xd6\xba\xb5\x46\xda\x8b\xb6\x12\x02\x7c\xad\x17\xa5\xed\x14\x55\x02\x3d\x71\x75\xdb\x1d\x1a\x95\x5f\xb5\xc8\xd1\xc3\x2c\x7b\x95\x7b\xb5\xdf\x09\xf9\xea\xe8\x2c\x2e\xf8\x2e\x25\x68\x1b\x97\xe7\x79\xa7\x8e\x82\x4a\x41\x9b\x9a\x9c\x5e\xa1\x26\xb7\xc1\x9a\x47\xd2\x5f\xf2\x96\xb4\x56\x3b\xea\x2e\x31\x69\x08\x92\x5b\x5b\xe9\x37\xd8\x04\xad\xa4\xa9\xd5\x15\x44\xf3\x84\x44\x78\x0b\x94\xf9\x43\x86\x10\x51\xbc\x38\x0c\x7d\x21\xe2\xfd\xb7\x78\x76\x6a\xee\xaf\x04\xe3\xea\xea\x1f\xb5\xb4\xaa\x1c\xe8\xa2\xba\xef\x95\x79\xbc\x73\x1b\x3e\x10\x56\x5d\xf8\xbc\xf0\x26\xd3\x4d\xb8\x73\xaa\xb7\xb1\x35\x16\x14\x33\xff\xf4\x7a\xed\x3a\x0b\xc7\xc8\xd5\x8b\x20\xad\x22\xa8\x86\x58\x0d\x14\x01\x36\x18\xfc\x58\xfb\xca\xed\xd8\x89\x8e\x14\x7d\x34\xbc\x50\x05\x17\xdd\xde\x16\xa7\xd5\x8a\x6d\xbe\xf2\xa8\xa1\xed\xb0\xe1\xff\xe1\xb8\xa1\x71\xb0\x88\x63\x25\x38\xeb\xc8\x34\x4b\x3e\x72\x73\x66\x5b\x56\xcf\x0d\x8c\xa8\xeb\x17\x04\x4b\x1c\x5c\xa8\xa7\x8a\x1f\x3a\xcd\x93\xbe\xea\x74\x55\x52\x95\xce\x2f\x2b\x0e\xf8\x13\x67\x2f\x2d\xe7\x98\x82\xaf\xf1\xf8\x52\x40\x6f\x3d\x86\xa1\xd3\xa1\xfa\xd9\x9c\xbd\x8b\x91\x70\x71\x9b\x2b\x3a\x3f\x52\xbf\x20\x24\x41\x15\x2f\x54\x27\x38\xf1\xc7\x85\xf4\xef\x15\x23\x42\xc0\x80\xf5\x09\x5d\xb3\x19\xda\xaa\x42\x05\x52\x35\x15\x66\x96\xca\x01\xe1\xfd\x07\x0f\x9e\x89\xaa\x2b\x32\x27\xa4\x92\xc9\xc3\xd9\xcb\xe3\x55\xb0\x0a\x20\xc0\x91\xa5\x64\x00\x48\x0f\x71\x13\x3c\xfc\x5a\x75\x25\x42\x3a\xec\xc9\x9f\xf2\x2f\xd9\x02\xc1\xb5\x1d\xdc\x4a\x32\x59\x7a\x85\x34\x61\x25\x72\x49\x7c\xcd\xbf\xa0\x56\x64\xe7\xb6\xfa\x9d\xaf\xd8\xb9\xf8\x63\xbd\x3a\x0d\xa5\xef\x57\x30\xd9\xd9\xee\x37\x3f\x39\xf0\xc2\x6d\x46\x70\x47\xb6\x55\x61\xd2\xbe\xad\xf1\x47\xb1\x8f\x77\xd4\x0e\x69\x24\xcd\x78\xda\x0c\xca\xa9\x6a\x2d\xfe\x88\x99\x0c\x71\x1f\x55\xf1\x47\xeb\xf1\x78\x95\x3e\xf2\x23\xb7\x71\x26\x96\xd2\xa9\x43\xad\xf2\x2c\x35\xb7\x08\xa3\x63\xbf\x7c\x8e\xa7\x3c\x76\xc5\xcb\xf7\xbc\x7c\x76\x80\x7d\x4f\xe9\xa5\x55\x7c\xa6\x87\xb8\xf5\x13\xf1\x08\x69\xfb\x1d\xd8\xbf\x7f\x72\x3f\x77\x71\x32\xe7\x14\x85\x0f\x91\xa3\x77\x3f\x65\x69\xb9\xa5\x17\xc3\x96\xb7\x04\xb3\x43\x2f\x58\xe5\xb7\xe9\xd7\x2f\xb3\x43\x5e\xc8\xf7\x0b\x1b\x6c\x9c\x1e\xc0\x5c\xb5\xbe\x7b\x03\x9e\x00\xa6\x4f\x3b\xad\x44\xcb\x0b\x4e\xb3\x5a\xe9\x41\xcc\x80\xf6\xdf\xb9\x57\xd1\xef\xe3\xcf\x9d\x53\xb8\x5e\x45\xea\xc2\xc4\x65\x61\x5d\xfe\xd2\xef\xf1\x4f\x3c\x74\x0b\x2c\xb6\x73\x4c\x8b\xb4\x6b\xa8\x2f\x19\xe7\x72\x77\x47\xe9\xe0\xdd\xd2\x5b\x61\x00\x88\x77\x23\x7a\x1e\xd3\xdf\x09\xfd\x9d\xae\x3a\xb8\x84\xb7\xf1\x4e\x27\x85\x2d\xcd\x85\x80\x7a\xb4\x08\x5c\xc0\x1f\x32\xab\xab\xf6\x16\xa6\x7a\x91\x82\x8f\xf4\x33\x0a\xba\xbd\xbb\x3f\xf3\x9b\xbf\xff\xf8\xea\xb9\x49\x0b\xb3\xb7\x75\x58\xee\x27\xfb\xad\x1f\x50\xa6\xdc\x19\x0c\xbd\xd1\x78\x32\x3d\x9b\xcd\x9f\x7e\xfb\xec\xf9\x8b\xef\xbe\x7f\xf9\x5f\x3f\xfc\xf4\xf3\x2f\x7f\xfb\xf5\xcd\xdb\xdf\xfe\xf1\xdf\xff\xfc\x1f\xc3\x60\x1f\x29\x15\xd4\xd9\x80\xbb\x65\x52\x1e\xbd\xa8\x24\x88\x95\x79\xd9\x21\x58\x5a\x7a\x9a\xdd\xb8\x6d\xc9\xc7\xc9\x91\x84\xad\x51\x63\x08\x40\xfe\x8a\x05\x61\x4a\xb6\x08\x28\x25\x3f\xd6\x49\x06\x7a\x0f\x9e\xfb\x66\xc1\x98\x4a\xb8\x0c\xbd\x19\x0b\x28\x0a\x05\xa7\xd9\x9b\x62\x28\x9d\xfb\x61\x89\x66\x07\x9c\xa5\x09\xbd\x33\xaa\x8d\x85\x4b\xbd\xcd\x92\x08\x9d\xe2\x11\xbd\xae\x52\x48\xa4\x81\x9b\x55\xa6\xa1\x48\x27\x84\x98\xe2\x9a\xc2\xb5\x7e\xe4\xf0\xb1\x4a\x41\xb4\xe7\x0d\x27\x8d\x6c\x3b\xe5\x78\x32\x5c\xe3\xd0\x9b\x00\xc3\x4d\xd8\x23\x80\x7f\xc2\x3e\x9c\x53\xa8\x40\x27\x15\x46\xfd\x71\x92\xdd\x40\xcc\x8b\xd8\x5a\x45\x46\x08\xe1\x31\x91\xe0\xc1\x03\x49\xcc\x21\x42\xa3\x17\x97\x97\x6c\xd4\x59\x61\x66\x74\x88\x09\x51\x6a\x7b\xc0\xce\x3a\x1d\xbb\xfc\xe8\x23\x26\x43\xa8\xc7\x87\x66\x25\x91\xba\x12\x65\x4e\x2e\x34\xef\x2d\x3f\xae\x5a\xd8\x1f\x7b\x59\xca\x0c\xec\x91\xc5\xec\x74\xfa\x4c\x65\x26\x05\xac\x6e\x13\x7f\xe4\x85\xdc\x95\x44\x9c\x6a\xa7\x54\xe0\x6e\x15\xff\x28\x20\xae\x95\x7e\x07\x1d\x52\x72\x4c\x75\x32\x4e\x5e\x3b\x16\xa5\xf5\xa2\x2c\x3c\xec\xb0\x15\x94\x4e\x9f\xea\xd4\x7a\x04\x8e\xee\x4a\x10\xda\xa2\xf4\x77\x7b\x7a\xda\x65\x51\xbc\x8e\x79\xf4\x2f\xdd\x5c\x29\x09\xe8\xeb\x6f\x0a\x24\xa9\xb8\x53\x79\xa1\x4b\xcb\x97\x95\x08\x00\x51\x9c\x6f\xc4\x5c\xd4\xf7\x43\x16\xa7\xae\xd3\xb5\x3d\x7e\x00\xe9\x97\x25\x38\x68\x88\x1d\x69\xa5\x25\x68\x12\xe0\x94\x17\xbe\xe9\x4a\xf8\xad\xe0\xfd\x5e\x1c\x99\x76\xa6\xb2\x82\xba\x94\x56\xf6\xae\x5b\x35\x2c\xbb\x6c\x28\x3c\x6c\x44\x02\x51\x29\x9b\x73\xdc\x80\x29\x0a\x55\x74\xd7\x05\x79\x4a\x49\xb7\x91\x11\x17\x67\x10\xbb\x63\x2b\xc0\x4f\x60\x71\xc4\x08\xcb\x09\x51\x60\x58\x05\x86\x22\x1d\xd4\x55\x98\x47\x05\x7c\x74\x8d\xae\xac\x82\x11\x65\xfd\x38\x58\x76\xc0\x28\x38\xe7\xfb\xc4\x0f\xb9\x38\x8c\x96\xed\x42\x33\x64\x39\xb8\x00\x30\x41\x70\x2b\xeb\x45\xd1\x08\xd9\xea\xb6\xc2\xcd\xdd\xe7\xfc\x63\x9c\x1d\x2c\x23\x04\xba\xad\x45\xcd\x91\x14\xca\xde\xc8\x31\xbb\x26\x08\xd0\x7b\x38\xf6\x94\x6a\x41\xab\xed\x10\xf0\x5a\xfa\x9f\x50\xc7\x16\xf1\x32\x0c\xbd\xb6\x5a\x00\xa5\x63\x17\xe7\xf9\x98\x1d\xd3\x12\x47\x9b\xf5\x34\x0f\xb7\x20\x6b\x0b\xa1\x4a\x44\x07\x45\x35\xa0\xb3\x38\x99\x27\x49\xe9\x02\x81\x04\x1c\xe7\xb9\x14\xa6\x3e\x59\x21\xf4\xa9\xd2\x30\x39\x90\xda\x94\x09\xbb\x42\xa4\x20\x6e\xb0\x14\x29\xe2\x09\x2f\xb9\x45\x51\x31\x91\x2b\xbf\x58\x31\x49\xa9\x77\xe6\x82\xfd\xfe\xf9\x5c\x86\xa4\xd4\xab\x29\x13\xdc\x56\xb5\x52\x45\x1f\x97\x75\x2b\x7c\x73\xa9\x3b\x84\x62\x6a\x46\x32\xca\x26\x67\x5f\x56\x4f\xaa\xcf\x12\x07\xad\x2c\x2d\x69\xbf\xc2\xf9\x6b\xe7\x9f\xb6\x60\xaa\xee\x4d\xa1\x0a\x6e\xdf\x2a\x0e\x77\xb1\xc8\xe9\xa8\x68\xf9\x86\x08\x01\xeb\x54\x2f\x82\x23\xb2\xa5\xe8\x51\xe8\xad\x07\x8f\x51\xed\xe1\x42\x56\x03\x5a\xdf\x0e\x91\x48\xe0\xdd\x2d\x6d\xa1\x00\xb3\xf3\x23\x5e\x65\x77\xe5\xae\xea\x91\xf4\x29\x92\x9a\xc8\x4a\x35\xa8\xc6\x2f\x6c\x77\x8d\x27\x8c\x44\x7e\x16\x9a\xec\xf0\x3a\xc0\x60\x12\x53\x40\x85\xde\x97\x16\x85\x49\x05\xc7\x16\x7f\x20\x73\xd8\xbb\xa5\x76\xca\xc5\x29\x96\x15\xe3\xd0\x09\x77\xbd\x49\x6a\xe6\xfd\xa1\xd8\xba\xbc\x63\x6b\x5d\x13\x27\x1c\xf8\x55\xf8\x24\x71\x51\xaa\x89\x2c\x74\x90\x3f\xf1\x65\xaf\xc8\x76\xdc\x66\x77\xc5\x0d\xd2\xb2\x51\x48\x18\x6f\x52\xcc\x0a\x2a\x4c\x9d\x73\x40\xcb\xe4\x50\xb1\x53\x2d\xf3\xd8\x59\xbb\xca\xa2\x57\x3d\xe9\x20\x1b\x10\x01\x43\x0b\x2a\xb5\x55\xf2\x58\x0d\xa5\xfb\x06\x4a\x24\x75\x4e\x15\x12\xc1\x82\x72\x3c\x6c\x7f\x6b\xb1\x69\x43\x46\x70\x7e\x3b\xe7\x25\x58\xcd\x34\x0b\x8a\xed\xc8\x16\x68\x81\xbf\x53\xe9\xc8\x26\xc5\x61\x5d\x3d\xec\x67\x63\x6b\xbe\x86\x29\x25\x9a\xed\x8c\xb8\xf3\xf7\xc7\x36\xbd\xa6\x02\xcc\xcd\x00\xda\xdc\x41\x63\x4d\x61\xbd\x50\xdc\x78\x13\x7d\xe9\x91\xd4\x79\xc2\xdc\x80\x63\xe4\xd7\x85\xc3\xb0\xd1\xf8\xbd\x80\xa1\x23\x5e\x8a\xa6\x85\x34\x03\x86\x5d\x50\xde\x58\x97\x81\xbb\xcc\x29\x13\x9d\xdb\x5f\xbe\x48\x70\xed\xd4\xee\x8a\xd1\x26\xe1\xd5\x1a\x95\xb2\xff\xbf\x53\x43\xf8\x77\xf8\x4e\x4f\x66\x53\xa2\x72\xae\x0c\x75\xab\xe1\x6b\xe5\xef\xa3\x96\xd5\x4f\xc1\x1d\x81\x3d\x2f\x42\x7f\xcf\x5f\xbe\xfd\xe9\x47\xb7\xf1\x89\x4e\x4f\x3a\x20\x6e\xff\x41\x7f\xd3\x65\xce\x03\xf4\x2b\x9d\x4e\xd5\xfc\x58\x34\x27\xa5\xd5\x7a\x29\x5a\x37\x76\xab\x23\x5a\xff\x7d\xc8\xb0\xbd\x89\xc8\x21\xfd\x4a\x54\x08\x02\x02\x7b\xe8\x3c\x34\xe0\xe3\x7c\x34\xc5\xa5\x39\x2b\xe2\x46\xad\x8f\xad\x56\x5c\x88\xc0\xc7\xb1\x18\x2a\x4e\x93\x38\xc5\x4f\x28\xd2\x48\x9d\x77\xef\xfc\xfc\x1a\x22\x49\xc1\x42\x0c\x5f\x57\xbe\x1b\x04\x10\x58\x48\x78\x2b\xa9\x18\xf5\xd8\x8f\x71\x7a\x2d\x5d\x3a\x88\x2b\x6e\xf0\x7c\x45\x5b\x9b\x9c\xeb\x0f\x3f\x97\x4b\xdb\x1f\x59\xad\x30\xf5\xb5\x5c\xca\x87\x2e\x25\xae\xe4\xe7\x5f\x10\x5b\xf4\x7e\x15\x98\x13\x74\xab\x5e\x59\x20\xec\x36\x79\x15\xcf\x30\xd3\x8d\x1d\x63\x44\x14\x31\x2f\xa5\xfc\xe2\xbd\x41\xd7\xf7\xee\xf2\xdd\xfb\xd5\x49\xe7\x3d\xd2\x45\xb3\xd9\xbf\xba\x34\xce\xf4\xe4\x01\x8a\xb0\x28\xf4\xa2\x96\xca\x72\xae\x0e\xf8\x85\x10\x7a\x30\xae\xe8\x5a\x9d\x42\x53\xf6\x44\xbe\xb7\x95\x28\xa2\x22\x31\x66\x4f\xe0\x69\x61\x22\x76\xb5\xbc\x5a\x02\x6e\x70\x59\x01\x7a\x57\xab\xab\x55\x1d\x43\x20\x41\x2d\x2d\xb7\x87\x48\x1b\x60\x5a\x3c\x85\xbd\xe4\xa9\x80\x55\x20\x17\xd3\x27\x81\x10\x99\x63\x4d\xee\x2b\x95\xf9\xef\x3b\x56\x27\xa0\x2e\xd6\xd1\x41\x78\x8a\xf9\x2e\x4b\x70\x17\x62\x74\x4b\xea\x5f\xd2\xe4\xe1\x63\x9f\x6d\x73\xbe\xbe\x70\x1e\x62\xe6\x88\x83\xdb\x8b\xb8\xa0\xd5\xed\x9a\x63\xc5\x69\x80\x42\x11\x1e\x1e\x3a\x97\x0f\xa9\x5c\x28\xbd\x46\xd2\x3d\xee\xfb\x97\x2d\x84\xb3\x48\x85\x84\x92\x64\x72\x5d\xfa\xd4\xef\xc9\xe2\xaa\x7f\xd5\x5f\xbe\xeb\x5c\x15\xd8\xde\x21\xc9\xd1\x18\xfd\xc5\x73\x00\xcd\xe4\xc2\x49\x33\x3c\xc3\xc3\x8f\xce\x40\x09\xaf\x79\x9e\xf3\xdc\xb9\xfc\xcb\x10\xe7\x7c\x78\x64\xb2\x47\x57\x8f\x60\xba\x47\x08\x15\x6e\x85\x94\x15\x25\x9e\xdc\xd1\x40\x79\xeb\x1c\x1b\xad\xc7\x8a\x91\x7c\x47\xa3\xe0\xe2\xd4\xe2\x42\x63\x10\x31\x8f\x7b\x15\xc1\x30\xba\xad\x73\x42\xdc\x50\x89\xce\x63\x64\xc3\x4b\xca\xd1\x23\x3f\x8a\x62\x45\xa0\xa5\x68\x6e\xcb\x2b\x0b\xe9\xb7\x4c\x37\x32\x90\x3a\x79\xb5\x14\x82\xb4\x29\x3d\xf6\x9d\x3c\x5f\x45\x36\xce\x72\x79\xde\x8f\x3b\x57\xb4\x2a\x82\x6b\xbe\x2f\x65\x89\xf1\xad\xa9\x19\xe8\x83\x22\xf9\x0d\xb7\xe8\x29\xbe\x5b\x91\xbe\x2c\x2b\x80\x77\x12\x71\x5a\x2a\xdc\x5e\xd1\x55\xe1\x26\x90\xb1\x3f\x92\xc3\xc5\xb8\xe2\xbb\xf3\x2f\xea\x88\x62\x1b\xaf\x4b\x4b\x18\x07\xc0\xdf\x9e\x51\x97\x57\xee\x12\x23\xa7\x24\x64\x2d\xf7\x37\xb9\xbf\xdf\x1a\xba\xc5\xf0\x15\xab\xf3\xca\x2a\x07\x92\xa0\xfa\xb0\xbf\xa0\xd3\x50\x5a\xd3\x13\x4c\x4c\x8c\xd9\x89\xc7\xfb\x4b\x91\x80\x55\xfd\x45\x8a\x82\x51\xf2\x19\xf6\x75\x7f\xe9\x54\xce\x62\x1b\x6e\x95\xd3\xa8\x9c\xd6\xf6\x79\x28\x0c\xa3\x45\x9c\xa0\x51\xa9\x80\x36\x16\x66\xd7\x8d\x8a\xb5\xa7\xa4\x6b\x0d\xf5\x23\x6a\x5e\x8a\x7d\x12\x97\xae\x73\x95\x3a\x77\x54\x35\xd2\xe8\xf6\xcf\x98\x15\x70\xf2\x72\x53\x62\x67\x53\x4d\xed\x4c\x7f\xbc\xff\xee\xfd\xfb\xf7\x7d\xf1\x45\x36\x76\xb6\x3c\x6c\xb9\x07\xe7\x56\x0a\x0f\x65\xc2\xa2\x94\xc4\x0f\x50\x68\x62\x86\x1e\xcb\xfd\xfa\x1c\xa2\x22\xb5\x5e\x2a\x2c\xcc\x86\xb0\x1a\x55\xaf\x96\x0f\xfd\x8c\x5d\xce\xf9\xa5\x2d\xba\x72\xa3\x91\x74\x86\x04\xc3\x86\xe7\xbc\xda\x1d\xe3\xc0\xcf\x95\x79\xf6\x6f\x7e\x1f\x76\xa7\x9f\x41\xfd\x9d\xb8\xbd\x47\x3a\x99\x2e\x08\x72\x27\x45\x2a\x64\xb6\x64\xcf\x30\xa5\xae\xd6\x7e\x22\x44\xa5\x23\x78\x83\xca\x9f\xc9\x10\x63\xc2\xfd\xa8\x9c\x11\xd6\x5f\x82\x75\x7c\x25\x57\xc5\x23\xf7\xc9\x62\x79\xfa\xe8\x64\xf5\x87\xd0\x81\xbd\x2f\xaf\xaa\xaa\x53\xc3\x29\xf1\xac\x26\x4b\xe8\xb0\xe6\x90\x18\x2c\x7d\x4c\x02\x31\x82\x24\x76\xc7\x10\x09\xc0\xd8\x9b\xda\xa0\x98\x96\x0d\x59\xfe\xd9\xa4\x24\x2e\x1e\xd1\xa9\x89\x54\x1b\x07\x24\xf1\x9f\x23\x2c\xf6\x6f\x21\x1e\x8e\x96\x36\x55\xc4\x19\xce\x1d\x9b\x2e\xc7\xd9\xdf\xbe\xd6\x55\x44\xcb\xc2\x3f\x37\x35\x8e\xe0\x76\x89\xbd\x70\x1b\xdb\xb1\xef\xb4\x29\x11\x6b\x06\x95\xea\x04\xd2\x58\x3f\x0c\xc0\xfd\x1c\xdc\xd0\xb6\x10\x93\x0e\x08\x44\x04\x44\xbf\xce\x21\xcd\xc3\xbf\x0f\xd8\x22\x12\x26\x71\x6e\x67\x5a\xba\x4c\x7c\xe8\x29\xca\xed\x88\x69\x0a\x2a\x5c\x6c\x0d\x95\xc4\xdc\x55\xa4\x44\x90\x4d\x4b\x22\x3e\xb3\xb9\x10\x2f\x7a\x65\xf6\x63\x06\x31\xee\x33\xbf\xc0\x4f\x5d\x84\x12\xec\x03\xef\xf6\x9b\x31\xa4\xf9\x25\xac\x5e\x3a\x36\x1a\x6c\x69\x3b\x41\xb8\x47\x34\x9d\xea\x71\x61\xd7\x34\x4a\x18\xcb\x55\xbd\xd4\x47\x66\x69\x9a\xd9\x9e\xca\x88\xad\xf1\xe3\x08\x43\x25\xfe\xe9\xac\x49\x95\x98\xb8\x33\x19\x58\xd5\x1e\xe1\x64\x55\x9c\xd8\x55\x41\x61\x57\xc6\xca\xab\x5e\x98\xa5\xa1\x5f\xaa\xb4\xbe\x4c\xf1\x54\xba\xd1\xa6\xb5\x2d\xe2\x82\x4a\xc4\x17\x0d\x82\xeb\x43\x62\x2c\x78\x55\x05\x30\xe2\x9d\x38\xea\xfa\x5c\x3b\x69\x21\xd2\xd8\xb9\x25\xeb\xac\xa5\x3d\x51\x22\x46\x35\xa2\x7a\xf0\xf1\x30\x54\x97\x18\x2b\x0e\xd7\xbe\xb2\xfd\x75\x75\x14\xe5\xbc\xa8\xe7\x51\xb2\x5c\x78\x3f\x2a\x1f\xb8\xf7\x37\x2a\x52\x93\xe2\x52\x62\xed\x82\xfd\x4b\x43\xf4\xcd\x29\x55\x6d\x56\x09\xf8\x6f\xac\x23\x5c\xd3\x5b\xbf\x2b\x17\x20\x0d\x33\x7e\x67\xe0\x7c\xd3\x3f\xf2\xab\x02\x56\xf6\xc6\x38\xa1\xa9\x03\x63\xd2\xe7\xbf\x50\xc9\xe0\x26\xa8\x6a\x4c\x4b\x6a\x1d\x47\x9b\x74\x54\x2e\xeb\xeb\xb5\x7d\x4e\x61\x78\xb2\xe2\x43\x4b\x3a\x77\xd1\x9f\x50\x0b\x5f\x96\xb9\x3e\xfb\xdb\x21\x4e\x12\x9d\xd9\xa2\x9f\x27\xd2\x05\x85\x94\xc6\x97\xc9\x73\xe9\x3a\xef\xfc\x6b\x6e\x25\x54\x2b\x04\x5c\x31\xde\xa6\x9a\x15\x97\x1a\x2e\x8e\x72\x33\x69\x4c\x2f\xdb\xb7\x24\xd4\xb3\xbd\xed\x47\xca\x4f\x07\xb2\x3d\xf0\x30\x6c\xb0\x4c\xee\x15\xf4\x1b\x38\x4e\x33\x05\xd8\xf6\x99\x92\x5f\x42\xef\xe0\x20\xce\x97\x01\x90\xf1\x0c\xd2\xa6\x72\xba\xac\x9a\xc3\xf4\xea\x9a\x18\x82\x45\x28\xad\xc8\x44\x57\x93\xd9\x1e\xae\x2e\xb8\x07\x9d\x4b\xeb\x57\x9f\xbd\x0b\x0a\xab\x4d\xc1\x57\xe6\x79\x27\xaa\x0d\x58\x33\x52\x6b\xef\x98\x46\x17\x27\xa9\x50\xef\x89\x40\xc1\x9e\x90\xe9\xa1\x5b\x6b\x28\x53\x1b\x80\x07\x33\xe2\x60\xbd\x01\x49\x04\xab\x42\xef\x7c\xe3\xe8\xaa\x0a\x1a\x69\xc2\x32\x4c\xb1\x01\xa3\x69\x53\x35\x2e\xb5\x5e\xad\x78\xb9\xa2\x2f\x6e\xad\x3c\x0a\xc3\xb2\x22\x67\xd8\x63\xe4\xdb\x9c\x8a\x68\xe0\xab\x50\x59\x3a\x28\x5b\xa7\xf4\x01\x8f\xb3\x3a\x46\x1f\xec\x63\x91\xe8\xf3\x3d\x1b\x29\x99\x13\xf9\x1d\x07\x2c\x68\x58\x57\x96\xf1\xe2\xdb\xcf\x35\x27\xc9\xe2\x6e\x1b\x9c\x74\xc5\xca\x23\xc6\xac\xc9\xba\xf5\xc1\x06\xfd\xec\xd4\x8d\x74\x4f\xa8\xd2\xe2\xbd\x8c\xa1\x28\xb8\x79\xef\x7c\x05\xac\x20\x4b\xa2\x76\x58\x8f\x1e\x99\xc0\xe0\xe9\x2b\xa0\xc5\x25\x68\xd5\xf0\x08\x3c\x0b\xdc\xd7\x40\xb3\x13\x40\x26\xac\xa5\x09\x6b\xe5\xe2\x53\x6d\x1c\xbe\xe8\xb4\x4e\x42\xdb\x74\x42\xbf\x17\x55\x9e\x1f\xb1\x6a\xda\xc3\x6c\x8b\x5a\x9b\x7c\x01\x22\xda\xc6\x16\xa4\xa7\xd5\x07\x2f\x52\x27\xd7\x82\x6c\x75\x28\x6b\xc6\x9d\x02\x7e\x43\xe1\x24\xb6\x46\x4c\x7a\x95\xb0\xec\x1d\x8c\xda\x12\xf2\x24\xa4\x53\x7c\x94\xcf\x5a\xa7\xbc\x5b\x73\x96\xd9\x46\xfc\x74\xc7\x45\x35\x5a\xfd\x6c\x06\x4e\x5c\x81\x84\x46\x89\x97\xc0\x07\xef\xee\x5b\x18\xea\x96\x4a\x35\x59\xa7\xd3\xb4\x1e\x12\x4b\x0a\x44\xd5\xd4\xe6\x0a\x34\x2d\xd1\x90\x5e\xa5\x10\xa9\x3a\x6d\x29\x00\xd5\xed\x48\xe6\xc1\x84\xa2\x17\xf8\x04\x01\x92\xae\xb9\x4a\xaf\xd2\x56\xb0\x26\xdd\xff\x04\x9e\x80\x65\x0b\x40\xb3\x8b\xd8\x3e\xd5\xc3\xd8\x26\x31\x63\x93\x41\x5b\x68\x56\x4d\x7f\x94\x48\x96\x47\xa1\x7a\x69\xaf\x42\xb6\xcb\x9f\xb3\x50\x3f\x19\xb6\xa8\x6e\xc5\x97\x0c\xf4\x5b\x37\x0b\x71\xd1\x2d\xd9\xa1\x5c\xc8\xab\x68\x13\x3f\xc6\xb2\x90\xd7\xae\xb4\xf9\x54\xbb\xb5\x50\x37\x5d\xb3\xd6\x7b\xa1\x7f\xeb\xcc\xec\xfb\x96\x04\xab\x72\xaf\xeb\x85\xe2\xd5\x69\x5d\xa3\x94\xbc\x56\x6a\x12\x18\x0e\x71\xad\xe6\x3f\xe8\x9c\x57\x5a\xa0\xab\x72\x38\xb2\x92\x61\x61\xdc\x77\xd5\x87\x67\xba\xee\x6d\x61\x3d\xc9\xb1\xb2\x1e\x68\xa1\xef\xd4\xb8\xaa\x80\x63\x61\x3d\x49\x32\xd2\x29\xdf\x42\x5e\x25\x19\x54\x20\xb3\xa8\x6e\x15\x81\xe8\x1c\x71\xa1\x6e\x24\xd9\xd5\x59\x99\xbc\xe9\xde\x33\x19\xe2\xf5\x7a\x61\xdc\xeb\x0f\x81\x20\xec\x5b\xc8\xab\x68\xd3\x2e\xf2\xa2\xba\x95\x9b\xa5\xb3\x5f\x0b\xe3\xbe\x2b\xf9\x0a\x3d\xc5\x85\xbc\x8a\xcf\x4e\x3e\x77\xd0\xfd\xff\x5f\x6c\x79\x9b\xc6\x34\x54\x00\x00")
+
+func staticBolJsBytes() ([]byte, error) {
+	return bindataRead(
+		_staticBolJs,
+		"static/bol.js",
+	)
+}
+
+func staticBolJs() (*asset, error) {
+	bytes, err := staticBolJsBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "static/bol.js", size: 21556, mode: os.FileMode(420), modTime: time.Unix(1792360965, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _staticBootstrapMinCss = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\xfd\x6d\xaf\xe3\x38\xb2\x20\x08\x7f\x9f\x5f\xa1\xce\x42\xa1\x32\x2b\x2d\xa7\x24\xbf\x1d\xdb\xa8\xf3\xf4\x7d\x7a\x06\x73\x1b\xb8\x7d\x3f\xec\xf4\x02\x03\x54\xe7\x2e\x68\x89\xb6\x55\x29\x4b\x6a\x49\x3e\x2f\xe5\xf1\xfc\xf6\x85\xf8\x26\x32\x18\x94\x64\x9f\x53\xd5\xbd\xd8\xbe\x89\xdb\xe5\x43\x06\x83\xc1\x88\x60\x04\x19\x22\x83\x5f\x7e\xfc\xc3\x7f\xf1\x7e\xf4\xfe\xff\x45\xd1\xd4\x4d\x45\x4a\xef\x69\x36\x9d\x4d\x97\xde\xc7\x63\xd3\x94\x9b\x2f\x5f\x0e\xb4\xd9\xc9\xba\x69\x5c\x9c\x3e\xb5\xd0\x7f\x2a\xca\xd7\x2a\x3d\x1c\x1b\x2f\x0a\xc2\xd0\x8f\x82\x70\xe1\xfd\xf5\x39\x6d\x1a\x5a\x4d\xbc\x3f\xe7\xf1\xb4\x05\xfa\x8f\x34\xa6\x79\x4d\x13\xef\x9c\x27\xb4\xf2\xfe\xf2\xe7\xbf\x72\xa4\x75\x8b\x35\x6d\x8e\xe7\x5d\x8b\xef\x4b\xf3\xbc\xab\xbf\xa8\x2e\xbe\xec\xb2\x62\xf7\xe5\x44\xea\x86\x56\x5f\xfe\xe3\xcf\x7f\xfa\x6f\xff\xf9\x3f\xfe\x5b\xdb\xe5\x97\x2f\x3f\xfe\xc1\xcb\x8b\xea\x44\xb2\xf4\x57\x3a\x8d\xeb\xba\x25\x34\x98\xce\xbc\xff\xc5\x30\x8b\xce\xbc\xff\xe5\x69\xa8\x73\x1a\x17\x19\xa9\xbf\x98\xed\x7e\xfc\x72\x6c\x4e\xd9\x65\x5f\xe4\x8d\xbf\x27\xa7\x34\x7b\xdd\xd4\x24\xaf\xfd\x9a\x56\xe9\x7e\xeb\x3f\xd3\xdd\xb7\xb4\xf1\x1b\xfa\xd2\xf8\x75\xfa\x2b\xf5\x49\xf2\xcb\xb9\x6e\x36\x61\x10\x7c\xbf\xf5\x4f\x35\x5e\x73\xdd\x15\xc9\xeb\xe5\x44\xaa\x43\x9a\x6f\x82\x2b\xa9\x9a\x34\xce\xe8\x84\xd4\x69\x42\x27\x09\x6d\x48\x9a\xd5\x93\x7d\x7a\x88\x49\xd9\xa4\x45\xde\xfe\x3c\x57\x74\xb2\x2f\x8a\x96\x67\x47\x4a\x92\xf6\x3f\x87\xaa\x38\x97\x93\x13\x49\xf3\xc9\x89\xe6\xe7\x49\x4e\x9e\x26\x35\x8d\x59\x8b\xfa\x7c\x3a\x91\xea\xf5\x92\xa4\x75\x99\x91\xd7\xcd\x2e\x2b\xe2\x6f\x57\x72\x4e\xd2\x62\x12\x93\xfc\x89\xd4\x93\xb2\x2a\x0e\x15\xad\xeb\xc9\x53\x9a\xd0\x42\x41\xa6\x79\x96\xe6\xd4\x67\x0d\xb6\x4f\xb4\x25\x8d\x64\x3e\xc9\xd2\x43\xbe\xd9\x91\x9a\xb6\xb5\x1c\xd1\x26\x2f\x9a\x8f\x3f\xc7\x45\xde\x54\x45\x56\x7f\xfd\xa4\x50\xe4\x45\x4e\xb7\x47\xda\x8a\x7c\x13\x5c\x7f\x3e\xa6\x49\x42\xf3\xaf\x93\x86\x9e\xca\x8c\x34\xd4\x80\xbb\x92\xcb\x8e\xc4\xdf\xda\xb1\xe4\x89\x1f\x17\x59\x51\x6d\x9a\x8a\xe4\x75\x49\x2a\x9a\x37\x57\xb2\x21\x71\x93\x3e\xd1\x09\xd9\x1c\x8b\x27\x5a\x5d\x8a\x73\xd3\x92\xd0\xb2\x6d\xb7\xab\x7e\x6e\xd2\x26\xa3\x5f\x2f\xbb\xa2\x4a\x68\xe5\xef\x8a\xa6\x29\x4e\x9b\xb0\x7c\xf1\x92\xa2\x69\x68\x72\xdd\x4d\xea\xa6\x2a\xf2\x03\x97\xe0\x33\x27\x6a\x15\x04\xd7\x64\x9f\xf3\xb2\xba\x79\xcd\xe8\x26\x6d\x48\x96\xc6\xd7\x63\x28\xc5\x32\x5d\xae\xe8\xc9\x0b\xb6\x1c\x26\xfd\x95\x6e\x22\x7a\xba\x9e\x48\xf5\xed\xc2\xa9\xfc\x2e\x08\x82\x6d\x47\xfb\xe6\xbb\xfd\x3e\xb8\xd6\x27\x92\x09\x6d\x61\x6d\x1e\x82\xef\xaf\xf5\x79\x37\xa9\xcf\xe5\xa5\x2c\xea\xb4\x15\xce\xa6\xa2\x19\x69\xc7\xa4\xe1\x5e\x2d\xbe\xdf\x32\xbe\x4b\xb6\x39\x59\xdf\x62\x6a\x8a\x72\xe3\x4f\x17\xf4\xd4\xe2\xbe\x88\x41\xfb\xd3\xa8\x2d\x49\x4f\x07\xc1\x8d\x4d\x70\xad\x9f\x0e\x4c\x4a\x9b\xaa\x28\x9a\x4f\x97\x96\x81\xfb\xac\x78\xde\x70\x91\x5c\xb9\x5e\xc9\x11\x87\xf4\xe4\xcd\x83\xf2\xe5\x7a\xac\x2e\x8a\x0c\xa9\xe1\xbb\xe2\xa5\xa5\x34\xcd\x0f\x9b\x56\xe2\x34\x67\x45\x5b\xff\x54\xfc\xea\xaa\xc3\x8b\xaf\x65\x45\x3b\x42\xc8\xb9\x29\xae\x71\x91\xd0\xc9\xb7\x5d\x32\x29\x2b\x3a\xa9\xc9\xa9\x34\xa6\xdb\xa9\xc8\x8b\xba\x24\x31\x9d\xa8\x5f\x1a\xe3\x42\x7a\xba\xee\xce\x4d\x53\xe4\x93\x34\x2f\xcf\xcd\xa4\x28\x1b\x3e\x31\x6a\x9a\xd1\xb8\x99\xb4\x13\x90\x54\x94\xa8\xe9\xc6\x1a\x6f\xd2\xfc\x48\xab\xb4\xd9\x72\x59\x8a\xbf\x04\xa6\x8e\xbc\xa7\xb4\x4e\x77\x19\x95\x3d\x70\x94\x17\x36\xa7\x99\x92\xee\x8b\xea\xc4\xd5\x58\x40\xb4\xc6\xc2\x63\x84\xfc\xdc\xbc\x96\xf4\x27\x5e\xfc\x75\xa2\x15\x55\xb4\xa6\x8d\x51\x52\x9f\x77\xa7\xb4\xf9\x7a\x91\xbc\x26\x65\x49\x49\x45\xf2\x98\x6e\x78\xfb\x6d\x7c\xae\xea\xa2\xda\x94\x45\x9a\x37\xb4\x12\x9d\xfd\x9c\xa4\x35\xd9\x65\x34\xf9\xaa\x77\xab\x0a\x2f\xa2\x51\x42\xf7\xe4\x9c\xc9\xb1\x6d\x36\x4c\x64\xfb\x22\x3e\xd7\x7e\x9a\xe7\xb4\xe2\x94\xd8\xe5\x97\x92\x24\x49\x2b\xbc\x60\xab\xf4\x89\x81\x5e\x74\x45\xe5\xd6\xf2\xaa\x8d\x26\x3e\xd2\xf8\xdb\xae\x78\x31\x07\x4d\x92\xb4\xe8\x46\xa8\xa9\x86\x9a\xb9\xb6\x32\x69\x55\x78\xa9\xa2\x50\xef\x3f\x3f\x9f\x76\xb4\xfa\xba\xd9\xc8\xce\xd8\x68\xfc\xba\x4c\x73\x5f\xd7\x14\x07\x74\x71\x6e\x4c\x68\x39\x17\x98\xaa\xea\x52\xa3\xa4\x8a\x8f\xe8\x98\xde\x36\x43\xb6\x88\x1e\xb4\x2a\xb7\x4f\x69\x96\x20\x14\x74\xb4\xf3\x02\x3f\x6e\x9b\x64\xc8\x60\x5d\x0d\x12\x1a\x17\x15\x69\x6d\x13\xa6\x83\x4c\xbf\x59\xe7\x35\x6d\x94\x56\x4c\x67\x0b\x7a\xf2\xa6\xcb\x88\xfd\x67\xb5\xa0\xa7\xad\x9c\x61\x5e\x54\xbe\x48\x9d\x69\x4d\x71\x5d\x64\x69\xe2\xd5\x69\xf6\x44\xab\x6b\x46\x0f\x34\x4f\x30\xe5\x52\x33\xd5\xb4\x0e\x72\x42\x5b\x16\xbc\x69\xf5\x5c\x5a\xfe\xd6\x2e\xe8\xf8\x5a\x57\x92\x91\xb2\xa6\x1b\xf9\xe3\xda\x24\x93\xe6\xd8\x75\x7c\x6d\x17\x09\xff\xa3\x38\x57\x31\xdd\x78\xc8\x52\xe3\xb8\xd8\x95\xcc\xf9\x2f\xfc\x5d\x91\x66\xb4\x62\xce\xcb\x58\x72\xd4\x55\xfc\x25\xae\xeb\x2f\xad\x0f\x16\xab\x85\x3f\x9e\x68\x92\x12\xaf\xac\xd2\xbc\xb9\xfc\x38\xd9\x90\x7d\xeb\xb2\x37\x3b\xba\x2f\x2a\xaa\x79\x8e\x3f\xa4\xa7\xb2\xa8\x1a\x92\x37\x5b\xbe\x44\x38\x92\xa4\x78\x66\xbc\xd6\xaa\x34\xf7\x12\x78\x7a\x1b\x43\xe9\xf0\xa6\xae\x9a\x2b\x99\x10\x66\xd8\x1a\x9a\x70\x53\xd6\x89\x7f\xc3\x56\x5f\xdc\xc5\xff\x7c\xac\xe8\xfe\x2b\x1f\xc0\x45\xa8\xe7\xe6\x83\xf7\xf1\x83\x47\x9a\xa6\xfa\xd8\xd6\x7e\xf2\x3e\x7c\xfa\xa0\xfb\x61\x27\x34\xab\x16\xe0\x0c\xf1\xff\xf5\xd3\x87\x5f\xc8\x13\xa9\xe3\x2a\x2d\x9b\xcd\x07\xd1\x72\xa2\x2a\xbf\xfb\x60\x21\xfb\x70\x65\x8b\x92\xbf\x9f\x8b\x86\xb6\xae\xe2\x62\xa9\xd8\x77\xeb\xf5\x7a\x5b\x92\x03\xf5\x77\x15\x25\xdf\xfc\x34\x6f\x57\x54\x1b\xf2\x54\xa4\xc9\xb5\x69\xd7\x4d\x6a\xed\xc1\x94\xc7\xe7\x4b\x29\x9f\xe9\x57\xeb\x39\x27\x4d\x6b\xf6\xf0\xf6\xad\x63\x3d\x91\x17\xff\x39\x4d\x9a\x23\x5b\xc6\x69\x3c\x3d\x46\x93\xe3\x6c\x52\x5e\x8a\xaa\x3c\x92\xbc\xde\xcc\xb6\xcf\x69\x52\x3c\xd7\x9b\x19\xaf\xd2\xb1\xb2\x61\x09\xa4\xd3\x9c\x3c\xed\x48\x65\x2e\x89\xa6\xbb\x26\x7f\x9c\xc6\xa4\xa2\xcd\x64\x9a\x54\x45\x79\x2e\x1f\xb5\x32\xa9\xf2\x4d\x51\xfa\x98\x42\x5d\xa7\x19\xd9\xd1\x0c\x61\x4f\x10\x04\xd7\xa9\x31\x6d\xac\x59\xa2\xa3\x61\x90\x5e\x93\x4c\xe4\xaf\xa3\xbd\x56\xfb\x6e\xbf\xdf\x5b\x6d\x7c\x8e\x9d\x26\x5d\x63\xad\xe8\x88\x50\x96\x24\x89\x86\xe5\xfa\x47\xb1\x00\x88\xa9\xb1\x14\xf8\xe1\xbf\x67\xaf\xe5\x31\x8d\x8b\xbc\xf6\xfe\x9d\x64\xfb\x2c\xcd\x0f\xf5\x0f\xdb\xba\x8a\x37\xe7\x2a\xfb\x38\x9d\x7e\x69\xa1\xeb\x2f\x07\x05\xe6\x1f\x25\x98\x5f\xd1\xc3\x39\x23\xd5\x94\x16\xcd\xa7\xdb\x9b\xfc\xff\xbe\x4b\xe9\x3e\x7d\xf9\xe4\xb5\x2e\x9f\x34\x1f\x7f\xa0\xa7\x1d\x4d\x12\x9a\xf8\x45\x49\xf3\xd6\xba\xfe\xf0\x69\x32\x1e\xe3\x73\xb1\xdf\x47\x1d\x32\xf6\xe7\xcd\x08\xcc\xf6\x37\x35\x6f\x1a\xad\x75\x53\x9d\xe9\xcd\x23\xa8\x9f\x0e\xdf\x75\x00\xff\xb7\x02\x10\xf5\x1d\xf6\xfa\xe9\xf0\xc3\xa7\xeb\x54\xc1\x22\xeb\xe0\x76\x3d\x1b\x96\x2f\x5b\x74\x0f\x32\x42\x01\xb4\x75\x3c\x5f\x8f\x6c\x75\x5f\x31\x0f\x02\x63\x6d\x1d\x2a\xeb\xc9\xdb\x9d\x8a\xa2\x39\xb6\x2e\x81\xe4\x4d\x4a\xb2\x94\xd4\x34\xe1\x6e\xbb\xa8\x5f\x20\xcc\xa1\x22\xaf\x75\x4c\x32\xaa\x8d\xc8\x67\xde\x20\xad\xbf\x75\x66\x5e\x98\xac\xbf\x05\x41\x44\x3e\xe8\xa0\x65\x76\xae\x51\xb0\x9d\x01\x46\xcf\x95\x80\x9a\x98\xa5\x85\xdd\x38\x0a\x48\x6c\x34\x3e\xa5\x39\xd6\x49\x14\x85\x91\x01\x17\x67\xc5\x39\x41\xe0\x96\x41\x68\x12\x93\x3f\xd1\xac\x28\x29\x02\xba\x0a\xd6\xe6\xf0\x68\x1e\xa7\x19\x0a\xb8\x37\x00\x0f\x19\xa9\x11\x1a\x69\x00\xfa\x3e\x9d\xeb\x34\x46\xe1\xcc\xb1\xf0\x95\x0c\x0a\x38\x33\x00\x8f\x94\x54\x0d\x0a\xb7\x30\x11\x36\xa4\x42\xc1\x96\x16\x98\x4f\x4f\x65\xf3\x8a\x02\xaf\x0c\xe0\x73\x4d\x71\x9c\x0f\x06\xd8\x3e\xcd\x4e\x28\x98\xc9\xeb\xe6\xe8\x67\xa4\x3a\x20\x62\xa1\x41\x18\x00\x50\x14\x28\xb4\xf0\xa5\x35\xca\x1b\xa0\x38\x05\xa2\xe9\x34\x08\x4d\x46\x57\xf4\x54\x3c\xe1\xc4\xcd\x0d\xc0\x5f\x8b\xe2\xe4\xa7\x39\x0a\xb9\xb0\x21\x8b\x33\x4e\xa2\x29\x97\x62\xbf\x47\xa1\x4c\x81\xd4\xe9\x21\x27\x88\xba\xd2\x20\x34\x45\x12\x17\x07\x14\x0a\x48\xa4\x22\x35\xca\xe9\xc8\x14\xc7\xb1\x38\xa1\x8c\x89\x42\xa8\x07\x38\x98\x29\x8d\x26\x75\x60\x03\xf2\x28\x08\x32\xd9\x69\x10\x99\xd2\x48\x8a\xe7\x3c\x2b\x48\xe2\x93\x0c\xe5\x73\xb4\x40\xc1\x51\x50\x53\x24\xe7\xd2\x09\x68\x4a\x25\xcd\x77\xc5\x0b\x0a\xf7\x00\x6c\x29\x79\xf5\xe3\xb4\x8a\x1d\x6c\x5a\x03\x7d\x2c\x29\x41\x87\x34\x0b\x00\xe0\xbe\xa2\xb8\x1c\x67\xa6\x80\xda\xe9\xe2\xe2\xd3\xcc\x14\x52\xeb\xca\x50\x30\x53\x48\xfb\x8c\xa0\x8a\x36\x9b\x43\x23\x96\x94\xc7\x22\xa7\xa8\x09\x9d\x99\x22\x7a\x2a\xb2\xf3\x89\xba\x66\xc4\x6c\x89\x01\xb7\x62\x45\xa1\x57\x18\xf4\xb9\x44\x61\x4d\x69\xfd\xbd\x8a\x8b\x04\x15\xd4\xcc\x14\xd4\x8e\x38\x21\xe7\xc0\xac\xe1\xcc\x9a\x87\x10\x0a\x65\xd3\xdc\x94\xd0\xae\xc0\xcd\xda\x7c\x66\x81\x9d\x48\x85\x83\x9a\x52\x62\x9b\x40\x14\xce\x14\x50\x4c\x4e\xb4\x22\x28\xa0\x29\x1c\x16\xb9\xc2\xc0\x56\x80\xc4\x0c\x9d\x66\x73\x53\x20\x3c\xe4\x89\x02\x02\xb3\xd6\x6e\x12\xc5\xe2\x09\x81\x5e\x04\x36\x34\xdf\x24\x61\xc0\xa6\x6c\x58\x70\xd3\xcf\xe8\x1e\xc7\x1c\x21\xc0\x31\xcd\x1b\xdc\x8d\x2e\x66\x08\x78\xe5\x24\x7b\x8e\x40\xff\x72\xae\x9b\x74\x8f\xfa\xf2\xc5\xc2\x9a\xfb\x28\xd8\x12\xd8\xb2\x84\xe6\x8d\x7b\x84\xd0\xf2\x31\x68\x37\xcd\x60\xa1\x40\x62\xda\x5a\x7f\x9f\x85\xf0\xd1\x06\x60\x79\x96\xc6\xcd\xb9\x42\xa7\xd6\xd2\x94\xe2\x89\x94\x7e\xab\xe6\x38\xa7\x97\x40\x30\xfc\xd3\x06\x06\x38\x03\xae\x0a\x57\xe0\xa5\x29\x0b\x9a\xa4\x38\x18\x58\xa2\x1d\x89\x63\x2c\xa6\x0c\x58\x44\x12\x85\x33\xb9\xef\x5a\xaf\x2c\x1f\xc0\x92\x8f\x96\x7e\xbb\x11\x7e\x26\x15\x3a\xcf\x96\x6b\x20\xa5\xba\xe9\x85\x5f\x05\xc0\xfe\xf5\x80\x86\x96\x07\x44\xc1\x4c\xf9\x94\xe4\x5c\xa3\x23\x5b\xcd\xc0\xc8\x0a\xd4\x92\xaf\xe6\xc0\x0c\x55\x4e\xfa\x16\xf6\xd0\xfb\xc0\xe1\x62\x9a\x96\xbd\xe0\xa6\xbc\xe8\x2f\x34\x46\xf5\x64\xf5\x00\xe5\xff\x54\x15\x6e\x33\xb3\x5a\xa3\xe0\xce\x59\xf8\x10\x58\x5b\x3a\xb6\x92\x44\x61\x43\x7b\x6b\xe6\x06\x8e\x90\x15\xb4\x1b\x7a\x06\x16\xe5\x6e\x48\x53\x7e\x7f\x3f\xd3\xba\xdd\x80\xbb\xe1\x17\xc0\x2a\xed\x0b\x37\x2c\x10\x61\x5c\x51\x9a\xd7\xc7\x02\xe7\xdc\x0a\x1b\xa0\x7b\x09\xf7\xf0\x00\x87\xd8\x03\x0b\x57\x11\x79\x0f\xf0\xda\x14\x21\xa9\xaa\xe2\xd9\xa9\x1f\xeb\x10\x01\x76\x6a\xc7\x3a\x42\xa0\xf1\x15\xd2\x7a\x86\x80\xba\x96\x5e\xeb\xb9\x6d\xfc\x5c\x8b\xcf\xf5\x02\xf0\x99\x7d\x81\xde\x9f\x33\x74\xaf\xb3\x5e\x62\xd0\xec\x53\x26\x0a\x0e\x66\xe1\x4b\x9c\x91\x13\xe9\x53\xa8\x10\x6c\xea\x0f\x29\xca\xe8\x10\xec\xe9\x33\x4a\xb0\x25\x6b\x08\x76\xf4\xfb\x14\xf5\x02\x61\x00\x9c\xca\x2b\x65\xb1\x3a\x14\x74\x61\x81\xc6\x59\x81\xda\xcc\x10\x04\x00\x9e\x49\x95\xa7\xf9\xc1\x3d\xf4\x15\xb4\xd8\x39\x8e\x16\xd8\x2c\x92\xd1\x3c\x41\x43\x10\x21\x88\x03\x54\x24\x4f\x0a\x2c\x60\x10\x82\x28\x40\x5c\x9c\x4e\x14\x75\xc0\x21\x08\x05\x9c\xc8\x21\xa7\x38\x60\x84\xda\x4a\x54\xbf\x43\x10\x11\x90\xc0\x0e\x0d\x0f\x41\x5c\xa0\xa2\xcd\x33\x75\x50\x01\x17\x02\x45\x59\xb6\x42\x88\xf1\xd8\x4e\x18\xc2\x75\x74\xc6\x82\xdf\x2e\x11\x83\x28\x81\x00\x77\x29\x0f\x08\x15\x88\xe9\x23\xbf\xdf\xa3\x2d\xe0\xce\x94\xb5\x38\x16\x55\xfa\x6b\x91\x37\x78\x1b\x18\x42\x48\x30\x0f\x19\x82\x08\xc2\xee\x9c\x65\xc7\xa2\x42\xc9\x06\x51\x84\x1d\x45\x67\x7b\x08\xa2\x08\x71\x3b\xac\x7d\x1a\x93\x06\xe5\x1c\x08\x26\x34\xc7\xf3\x69\x57\x3b\xb4\x03\x44\x12\x04\xac\x4b\x39\x40\x30\xe1\x48\xf2\xc4\x69\x83\x43\x10\x50\x60\xc0\x0e\xeb\x1e\x82\xa0\x02\x83\x75\x10\xbc\xb6\x21\x5d\xe4\x82\x98\x02\xf7\x44\x03\xae\x23\x04\xe1\x05\xa3\x91\x8b\x7c\x10\x67\x30\xda\xe0\xc3\x00\x21\x07\xa3\x85\x73\x38\xa6\x5c\x0f\x59\xb1\x43\xe5\x0f\x42\x0f\xcf\x15\xcd\xd1\xa8\x6c\x08\xc2\x0e\x0d\xa9\xbf\x61\x9b\xf4\x10\x04\x1c\xf6\x69\x86\x6f\xfe\x42\x10\x6d\xd8\x55\x29\xdd\xc7\x04\x9f\xdf\x20\xe0\xd0\xfa\x45\xbe\x6e\xc1\x80\x41\xcc\x21\x21\xf5\x71\x57\xe0\x0b\xd4\x10\x44\x1e\x4a\x52\xd2\x2a\xce\x52\x54\x0c\x20\xfc\xc0\xe2\xd2\xce\x48\x72\x08\xa2\x10\x59\x9a\x63\x3b\x9a\x10\x46\x20\x8e\x05\xee\x6d\x40\x04\xa2\x3c\xd7\xc7\x12\x0d\xc1\x86\x20\x04\x71\xae\xf1\x81\x9b\xdc\x3f\xec\xf0\x21\x9b\x7c\xaf\x0b\xdc\x5a\x83\x80\x42\x0b\xe6\xef\x5e\x7d\x92\x95\x47\xb2\xc3\x1d\x02\x08\x2b\xc0\x26\x8e\x75\x52\x08\x02\x0c\xb2\x19\xff\x3c\x89\xc1\xcf\xdc\xf0\xce\x3e\xe6\x38\x69\x4d\x53\xa5\xbb\x73\x83\x86\xf0\x42\x10\x6c\xb0\x1b\x39\x7b\x03\xe2\xca\xd9\xe6\x97\xa2\x42\x5b\xc0\x85\x5c\x49\x72\x1c\x10\x06\xc3\xf9\xb7\x62\xa7\xb5\x00\x51\x07\x05\x8f\xdb\x23\x10\x79\xc8\x8a\x03\xfe\x35\x20\x5c\x86\x30\x56\x8a\x46\x69\xc3\x25\x0c\xbd\x1e\x1c\x1f\x0d\x42\x10\x9e\xc8\xe9\xb3\xff\x9c\xe6\x49\xf1\x8c\x02\xc3\xe5\x49\x5c\xe0\x56\x00\x86\x29\x08\x1a\x56\x08\x41\x94\xc2\xb5\xbc\x00\x41\x8a\x16\x1b\xde\x2b\x88\xee\xb1\xaf\xe9\x28\xe0\x1a\x8a\xdd\x01\x08\xe2\x12\x35\xc5\xb5\x63\x05\xc5\x52\x94\xe5\xab\x9f\xa0\xdf\x43\x69\x08\x42\x13\x02\xda\x39\xaa\x15\x8c\x8f\x33\x70\xe7\xb7\xa5\x10\x86\x2a\x3a\xf4\x28\xf4\x02\x83\x76\x49\x02\x44\x2b\xe2\x8a\x26\x69\xd3\xae\x39\x71\xca\x4d\xb9\xf1\xf3\x82\xb8\x59\x81\xf1\x8a\x73\x93\xd1\x0a\x75\x03\x20\x54\xc1\xcf\xaf\x60\x80\x0f\xd6\xd2\xbf\xac\x68\x5d\xe3\x4c\x06\x41\x0a\x4a\x2a\xa7\xe3\x00\x21\x0a\x06\xe7\xb2\x45\x20\x40\xd1\x14\xcf\x0e\x5a\x81\x85\x6c\x48\x83\x1a\x45\x10\x96\xa8\x13\x67\xdc\x33\x04\x51\x89\x63\x1f\x28\x98\x5f\xe7\x1d\x3b\xac\x84\x53\x00\x22\x81\xec\x20\x4c\xdd\xd0\xca\x81\x1a\xfa\xbb\x33\x5b\x31\x66\x3b\x54\xb6\x6b\xe8\xf6\x5a\xe8\x85\x1f\xa2\xb0\xd0\xdf\xb5\xb0\x4b\x07\x2c\x74\x72\x2d\xec\xca\x01\x0b\xd6\x86\xf2\xe8\xbe\xef\xf8\xe4\x11\xae\xa1\x51\x3c\xa4\x75\xc3\x0f\x93\xb9\xdb\x80\xcf\x1f\x59\x71\x4e\xfa\x3e\x24\x86\x20\xe2\xc0\x1b\x38\x3f\x27\x86\xeb\x07\x30\xf3\x28\xf5\xe3\x22\x4f\x1d\xb3\x6f\x0d\x3f\xe2\x52\xea\x27\x34\x4e\x93\x73\x81\x1d\xa3\xa0\x51\x00\xe6\x16\x46\x44\x04\x42\x1e\xad\x05\x72\x7d\xd0\x8d\x40\xdc\xa3\xb5\x3f\x6e\x58\xb0\x10\xa4\x4f\x34\xc3\x1d\x6b\x04\x02\x20\xad\x30\x51\x30\xb0\x16\x24\x35\xba\xb7\x8b\x40\xe0\x83\x64\x14\x75\x1b\x11\x08\x4f\xd0\xbf\x9f\xd9\x75\x0a\x8c\xf7\x11\x88\x50\x7c\x63\x07\x7c\x11\xb0\x10\x06\x30\x51\x0b\x0d\x0f\xb8\x94\x04\x5d\x9f\x44\x20\x2e\xb1\x4b\xeb\x23\x1a\xf8\x8e\x40\x44\xe2\x5b\xee\xd8\xb9\x45\x20\x20\xb1\x23\xbb\x57\x7f\x5f\x54\xa7\x73\x86\x7d\xd7\x8b\x40\x3c\xa2\x41\xa3\x32\xd1\x72\x6f\x9e\x1d\xda\x65\x24\xfe\xe6\xda\x7b\x44\x20\x0c\xb1\x43\x4d\x7d\x04\x42\x0f\xa4\x2c\x31\x35\xdb\x3f\xec\xcd\xe3\x3a\xb4\xc2\xb7\x52\x11\x08\x38\x1c\x8b\x73\xe5\x38\xda\x13\xcd\x42\xf3\x8c\x53\x46\x4e\x28\xd3\x41\xc4\x21\x39\x97\x99\x2b\xde\x10\x81\x78\x43\x99\x1e\x0e\xaf\xfe\x8e\xa0\x9b\xa3\x08\x04\x1c\xea\x38\xad\xeb\xa2\x42\xa7\x38\x88\x36\xec\xd2\x26\x2e\xd0\x45\x69\x04\x42\x0d\xbb\x06\xfb\xa2\x0a\xa1\x5e\x76\xa8\x16\x01\xa8\x57\x4c\xc9\x83\x80\x98\xc3\xf8\x05\x9b\xd5\x16\x54\x75\xde\x61\x82\x8e\x82\x5d\x02\xe1\x46\x40\xb1\x13\x70\xd8\x08\x40\xd8\x23\x8d\xa9\x9f\x15\x59\x86\xda\x1d\x10\xed\x50\xb0\x7e\xd3\x5a\x20\x54\x7b\x41\xb0\x83\x26\xe7\x98\x9f\x5b\xc6\x60\xc1\xe7\x11\x76\x95\xaa\x3f\xc8\x16\x81\x30\x87\x68\xd3\x13\xca\x8b\x40\xc0\xe3\x44\xf3\xb3\x7f\x24\xa7\xdd\xb9\x3a\xe0\x16\x0f\x04\x3e\x4e\x45\x42\x32\xf7\xa6\x23\x02\xf1\x8f\x02\x3b\x5c\x47\x23\x10\xfc\x38\x54\x04\x57\x56\x10\xf8\xa8\xcf\x39\x9b\xac\xe8\x62\x27\x82\x87\x2d\xe4\x4d\x36\x14\x36\xb4\x61\xf9\x01\x61\x0c\x38\xb2\x81\xb5\x93\xee\x58\x0b\x20\xcb\xdd\x2f\x34\x6e\xc4\x27\x7b\xfc\x9b\x65\x04\x22\x21\x46\x13\x71\x63\x0a\x6b\xb5\x70\xb7\xea\x57\x1d\x10\x27\x31\x5a\x3a\x62\x77\x11\x38\xb7\x61\xb4\xe9\x53\x3a\x10\x6d\x31\xda\xb9\x82\x8b\x11\x3c\xd4\x51\xa5\x24\x3f\x64\xd4\xdd\x00\x9e\xeb\x90\x0d\x5c\xa3\x01\x31\x18\x05\xef\xe6\x36\x08\xbf\xa8\x16\x0e\x91\x2e\xe0\xe2\x34\xaf\x0b\xdc\x0c\xc1\x98\xcb\xb9\xa4\x95\xb8\x6a\x80\x41\x2f\xe0\x0e\xa0\x07\x76\x69\xcf\x77\x27\x43\x56\x36\xac\x9b\xdb\x0f\x36\xb0\x23\xbe\x12\x81\xf8\x0a\x83\xc5\x97\x80\xcb\xe0\xc3\xf5\xc7\x77\xbe\x76\x75\x05\x97\x5a\xde\x19\x7b\x77\xdf\x96\x5f\xf0\x0b\xca\xee\x46\x54\x43\x4a\xff\x98\x1e\x8e\x19\xdb\x93\x70\x03\x53\x1d\x76\xe4\x63\x30\x61\xff\x3e\xf1\x9b\xb5\xfa\x91\xf1\x0f\xff\x4e\xb3\x27\xda\x4e\x25\xef\x3f\xe9\x99\x7e\x98\xa8\xbf\x27\xff\x56\xa5\x24\x9b\x68\xd7\x79\xb5\x5e\xe7\xe5\x8b\x79\x68\x7c\x3a\x8f\x1e\x16\xab\x70\x3e\x13\x57\x06\xbf\x9b\xcd\x66\x5b\xf4\x3a\x84\x79\x1f\x11\x5e\x43\xd4\x69\x93\x97\x10\xbb\x7e\x65\x89\xde\xb5\xbc\x9c\x48\x2e\xaa\xe7\x15\xd9\xad\xb6\xf0\xee\x0e\xbf\x4e\xbb\x61\x57\xf8\xd4\x75\x59\xd1\x24\x9a\x2d\xa2\x55\x6c\x35\xd1\xae\xfb\xf0\x76\xea\x7a\x6d\x73\x4c\x73\x71\x87\x76\x2b\xcb\x16\xe5\x8b\x47\xce\x4d\xe1\x75\xe7\xe7\xe3\x73\xed\x57\xec\xcb\x5b\xdb\x8f\x84\xf4\x8b\xfd\xbe\xa6\xcd\xc6\x8f\xca\x17\x70\xc9\x34\x60\xf7\x68\xc0\xe5\xd6\x53\x9a\x24\x19\xbd\x4e\x63\x52\x15\xe7\x9a\x66\xfc\xca\xde\xe3\x34\x6d\xe8\xe9\x91\x3c\xa6\xa7\xc3\x04\xaf\x63\x35\xe9\xe9\xe0\x57\xb4\x2e\x8b\xbc\x4e\x9f\xe8\x64\xca\x3e\x24\xe5\x24\xcd\x3c\xd1\x54\x15\xb4\x7f\x9a\x57\xa2\xb7\xe6\x8d\x9e\xad\x7e\xd7\x8f\x23\x6e\x85\x4b\x13\x79\x5f\xa6\x22\x49\x7a\xae\x37\xcb\xf2\x85\x57\x2b\xd4\xf8\xfd\x69\x37\x76\x75\x77\xb1\x47\xd1\x50\xed\xb2\x2f\xd6\x7d\x97\x24\xc9\xd6\xa4\x6f\xae\xcf\x99\x8a\xe4\xe2\xc2\x05\xc9\x32\x6f\x1a\xd5\x1e\x25\x35\xf5\xd3\xdc\x2f\xce\xcd\xd6\x2f\x86\x20\xfa\xab\x39\x1f\xf8\x47\x24\xc0\xa5\x45\xf0\xfd\xf5\x58\x09\xc9\x33\xa3\x1e\xb5\x93\x59\xfc\x2d\xdc\x02\x2b\x92\x77\x00\xb7\xdd\xd5\x26\x7d\x80\x94\xd2\xeb\xb4\xae\xfc\x22\xcf\x5e\xbb\xeb\x23\x64\x57\x17\xd9\xb9\xa1\x5b\xc1\xe1\xf2\x45\x32\xb8\xfd\xd9\x5d\x30\x14\x9a\xe7\xb7\xa5\xe0\x06\xf4\x96\x7d\x9c\xa9\x68\xdc\x28\x0b\xd2\xdd\x47\x94\x3d\x72\x35\x27\xed\xfa\x59\xdc\x45\x47\x6a\xf8\xec\x51\xb4\xd5\x0d\x69\xd2\x58\x50\xc6\xe4\xad\xcb\x5e\x5d\x45\x86\x17\x8d\x39\x3d\x4c\xfb\x7e\xae\x8a\x4c\xdd\x1f\xbe\x80\x0b\xc0\xd3\x63\x38\x99\x1e\xa3\xc9\xf4\x38\x9b\x4c\x8f\xf3\xc9\xf4\xb8\x98\x4c\x8f\xcb\xc9\x31\x9c\xf0\x9b\x68\xc7\xf9\xe4\xb8\x98\x1c\x97\x6e\x73\x23\x2e\xc7\x2c\xe0\xe5\x98\x69\x08\xee\x44\x4f\x8f\xa1\x37\x65\x87\x41\x26\xed\x4f\xf9\x2b\xea\x0a\x23\x55\x38\xeb\x0a\x67\xaa\x70\xde\x15\xce\x55\xe1\xa2\x2b\x5c\xa8\xc2\x65\x57\xb8\x14\x85\x5d\xe7\xaa\xef\xae\x6b\xd5\x73\xd7\xb1\xea\xb7\xeb\x56\xf5\xda\x75\xaa\xfa\xec\xba\x94\x3d\x5e\xfa\xaf\x0e\x89\x89\xb8\x5a\xad\x0c\x21\x48\xc6\x0f\x28\x7b\xeb\xcc\xde\xca\xd0\x7b\x39\xa2\xf9\xd4\xe5\xe2\xfb\xab\xa1\x36\x52\x5b\x34\xea\x43\x27\xf5\x6f\x93\xe7\x9b\xc4\x22\x73\x25\x30\xde\x1f\x43\xad\x70\xc6\x4c\x72\x2b\x83\x48\x2f\xe5\x14\xcf\x5a\xc9\x68\x89\x1c\xe6\x7c\x1c\x93\xe3\x5c\x5f\x68\x3c\xb0\xd2\xc5\xe4\xb8\xb8\x98\x0b\x81\x2b\xe3\xd1\x52\x2f\x6d\x1d\x5b\xa9\x7c\x9a\x17\x78\x9c\x37\x19\x25\xc9\x05\xb1\x6f\x5a\xcb\xa5\xfc\x53\xa8\xd8\xcc\x9a\x80\xf3\xab\xb8\x44\xfc\xf1\x94\xe6\xc2\x7d\xac\x96\x0f\xe5\xcb\xa7\x0b\xef\x40\x1b\x49\x58\xbe\x5c\xaf\x82\x55\x56\xee\x89\x96\x4f\x27\x52\x7d\x9b\xb0\xac\x15\xea\xe2\x76\x44\x4f\x98\x6b\x89\xf7\x0f\x74\x76\x9d\xb2\x25\x42\xbb\xa2\xe5\x77\x83\xb9\x83\x6e\xff\x16\x55\x6c\x01\xab\xd7\xb1\x02\x51\xc9\xcf\x66\xeb\xb5\xbc\x44\x54\x8b\xd3\xd5\x7a\xbd\x28\x12\x00\x79\xf1\x5c\x91\xf2\xf2\x7c\x4c\x1b\xca\xae\x74\xd3\x0d\x2f\x92\x74\x15\xcf\xb4\x8a\x49\x4d\x61\x0e\x06\x55\x21\x00\xcf\x65\x89\x03\xaa\x0a\x49\x31\x29\xd9\x39\xf8\x5f\x2d\xc8\xae\x46\x80\x9e\xce\x0d\x4d\x2e\xba\x01\x60\xc5\x65\x95\xb2\x54\x2b\xc6\xe2\xec\x4a\x8c\x4a\xb9\x28\x33\x0b\xcd\x15\xda\xc3\x32\x58\x07\x02\x67\x7d\x8e\x63\x5a\xd7\x0a\x67\xbc\x5a\xce\x12\x89\x53\x54\x9a\x38\x65\xa1\x89\x73\xb7\x98\x47\xb1\xc0\x99\xe6\xfb\x42\x21\x0c\x57\xc1\xc3\x5e\x22\x6c\x6b\x4c\x6c\xac\xc4\x44\x35\x5f\x44\xcb\xb5\x40\x25\xce\xbc\xc9\xba\x07\xb2\x4c\x66\x3b\x89\x4d\x54\x9a\x08\x65\xa1\x81\x73\xb9\x5c\x84\x8a\xbc\x84\xe4\x87\xae\x8a\xac\xe7\xf3\x79\x24\x51\xf2\x3a\x13\xa3\x28\x33\x10\x3e\xcc\x67\x8b\xd9\xfc\x3a\xdd\x1d\xa0\x54\xd8\xc2\xc9\xd2\x79\x25\xab\xae\x81\xea\x44\x2b\xe2\x7d\xd8\xcd\xa5\xc8\x76\x07\x25\x30\x1b\x28\xd9\xef\x83\xe4\x81\xf7\x01\x25\xa7\x15\xb9\xfa\x88\x43\x1a\xed\x66\xac\x0f\x26\x40\xa4\x83\x35\x4d\xf6\x62\x10\x86\x24\xe5\xdf\x2e\xd4\x64\x9f\xac\xdb\x85\xd5\xee\xa0\x04\xea\x34\x0b\x44\x83\xd2\x3b\x30\xe5\x8a\x34\x5f\xd1\x78\xb7\x60\x7d\x08\x01\x23\x30\x51\x42\x13\xca\xbb\x00\x92\xee\x4a\x5c\x1d\xd0\xf9\x6e\xbd\x5b\x5f\xa7\xec\x7e\x3d\xff\x1c\x2a\x2d\x9d\xb4\xc0\x6b\xe5\xc8\x36\xf3\xa0\x7c\xf1\x02\x4f\x5b\x73\xea\x29\x83\xb4\xd5\x66\x91\x4d\xce\x99\xee\x0e\x03\xcc\x17\x16\x99\x57\x64\x93\x22\xf3\xce\x2d\xb8\xc7\x1a\x79\x5d\x3b\x01\x1a\x5c\xa7\xec\xa2\xd8\x39\x67\x57\x94\x55\xee\x0b\x1e\x37\x68\xad\x7f\xdd\xdd\x5e\xce\xa9\x80\xe6\xfb\x08\x08\x2b\x30\xb3\xbf\xfc\x05\xdb\x3a\xb8\x1b\x3f\x66\x29\xbe\x2d\x91\x48\x79\x30\x62\xd1\xad\x96\x39\xe2\x45\xf9\x72\x4d\x7a\x47\xdf\x32\xf0\x9a\x24\x93\xc4\x4c\x06\xd3\xed\x5d\xae\x49\x63\x67\x5c\x52\xae\x91\x0f\xa6\xc7\xcd\x25\x99\x16\x6d\xf3\x5a\x5c\x59\x41\x1a\xe6\x86\xe4\x6a\x7f\x19\xa0\xcb\x79\x4a\x2a\x0e\x06\x3d\x14\x2f\x50\x0d\x68\x96\xa5\x65\x9d\xd6\x5b\xcc\xd7\x80\xee\x4d\xba\xc3\x87\x76\xf0\x3c\xeb\x45\x42\x1a\xe2\x17\x55\x7a\x48\x73\x92\xf9\x3c\x07\xc6\x44\xcf\x4b\x25\xd6\xed\x47\x9a\x95\x88\xc2\xf1\xfd\xb5\xc7\x9d\x49\x9a\xa7\xec\xfa\x79\x7d\xd2\x7c\xf8\x3a\xf8\x7e\xeb\xf4\x60\x5d\x3a\x0c\xe5\xdc\x5b\xb5\xf4\xb4\x85\x27\x5b\x9b\xc0\x25\xc8\x6a\xba\xe8\xf4\x5f\x4a\x5c\xd7\xfe\x0e\xb1\x57\x64\x9b\x8c\xd4\x8d\x1f\x1f\xd3\x2c\x99\x68\x15\xa5\xa3\xfc\xac\x37\xb0\x66\x82\x06\x28\x56\x2d\x5a\x89\xc8\x6f\xa6\x95\xf0\x25\x8d\xb9\x63\x37\x92\x6b\x0d\xc4\x68\x5a\xc6\x5a\x5d\x8a\xb8\x95\xdd\x33\x52\x81\x1e\x82\xff\xe1\x6f\x51\x10\xce\xbd\xbf\x05\xc1\xbf\x05\x3f\x5c\xa7\x1d\xb8\x5f\xd1\x27\x5a\xd5\x3a\x86\x69\x79\xce\x32\xb1\x68\x32\xa7\x5d\x68\xcd\xbb\xc0\x56\x5a\xb9\xa1\x96\x13\x55\x93\x92\x21\xc0\x00\x23\x03\x8c\x17\x83\x30\x07\x8e\x41\x38\x58\xa6\x8d\xcb\xc9\x56\x1d\xc6\xc5\x61\x1d\x06\x67\x36\xca\x61\xd9\x27\x8f\x44\xf6\x8c\xcc\x0d\xa0\x23\xe8\x1d\x57\x1f\x88\xd1\x4b\xdf\xa8\xcc\x5c\x36\x3f\x30\xdd\xf1\x98\x1e\xfd\x70\x25\x49\x52\xb5\x8b\x07\xe7\xc6\x41\xcf\x6c\xe1\xb0\xb7\xfd\x39\xd4\xfe\x42\xf3\xac\x98\xfc\xa5\xc8\x49\x5c\x4c\xfe\xc4\xe2\xe6\xa4\x9e\x7c\xf8\x53\x71\xae\x52\x5a\x79\xff\x49\x9f\x3f\x74\xd9\xd5\x18\x2e\x65\x51\xa2\xf2\xc5\x9b\x1b\xf6\xa3\xb5\x49\x72\x75\xb2\x8a\x16\x73\x8a\xed\x26\xd6\xfb\x68\x3f\xb7\xa3\x52\xd7\x6f\xbb\x64\x1c\x6a\xd7\x8a\x6d\x06\x90\xce\xb4\x50\x97\x96\xf3\x28\xcd\x6b\xda\x78\x81\xe7\x87\xcc\xe3\x6b\x41\xe2\x69\xb4\xf8\xb4\x1d\x0d\xd9\x12\xec\xe9\x44\xeb\x19\x01\x59\x50\x0f\xb8\x39\x57\x6a\x26\x98\x90\x89\xe5\xc1\x33\x2d\x9b\xec\x62\xcd\xec\x33\xd8\x5c\xea\xdd\xce\x46\x05\xa7\x9f\x8b\x2a\xe1\x29\x87\x36\x22\xf1\x50\x96\xf1\xc2\xd6\xcb\x89\xb2\xf6\x6f\x4c\x7e\x8b\xf6\x1f\x12\x6b\x8c\xe3\x18\x91\x6a\x59\x51\xcf\xd0\x9a\x00\x89\x69\x1b\x61\x25\xc3\xef\x96\x15\x65\x34\xd9\x84\x68\xa9\x20\x41\xb7\xc1\x75\xda\x36\xab\xe3\xaa\xc8\x32\x96\xd2\xe8\x44\x5e\x24\x43\x66\x73\x7d\x75\xe0\xbf\x6e\x38\xd8\x75\xda\x4e\x40\x92\x6a\xd9\xed\x9c\xc6\x38\xec\x64\x20\x60\xb4\xd0\x1d\x07\x61\x71\x3a\xf7\x22\xa6\xeb\x4b\x94\x2f\xd8\xc2\xc1\x6e\xb0\x5e\x47\x68\x83\xf5\xca\xd1\x20\x8c\x82\x00\x6d\x11\x86\xbc\x49\x57\xe1\xef\xb3\x73\x9a\xbc\xdb\x68\xa7\x55\xf1\x7c\x31\xe0\x7c\xbd\x29\x5f\x97\xb6\x25\x2d\x09\x99\x9f\x1d\xfc\x70\xa2\x7e\x05\xdd\x4f\xad\x34\x52\x3f\xbb\x5f\x33\xf5\x6b\xae\x7e\x2d\xd4\xaf\xa5\xfa\xb5\x52\xbf\x1e\xd4\xaf\x35\xff\x75\x4a\x64\xd7\xed\xaf\xa0\xfb\xa9\x95\x46\xea\x67\xf7\x6b\xa6\x7e\xcd\xd5\xaf\x85\xfa\xb5\x54\xbf\x56\xea\xd7\x83\xfa\x25\xba\xae\x4f\xb2\xeb\xf6\x57\xd0\xfd\xd4\x4a\x23\xf5\xb3\xfb\x35\x53\xbf\xe6\xea\xd7\x42\xfd\x5a\xaa\x5f\x2b\xf5\xeb\x41\xfd\x12\x5d\xbf\xd4\xb2\xeb\xf6\x57\xd0\xfd\xd4\x4a\x23\xf5\xb3\xfb\x35\x53\xbf\xe6\xea\xd7\x42\xfd\x5a\xaa\x5f\x2b\xf5\xeb\x41\xfd\x5a\x23\x19\x9d\x5a\x5d\xb5\x83\xf1\xbd\xea\x77\xfd\x07\x0e\xa0\xdb\x5e\x74\x54\x44\x97\xee\xcb\x4d\x57\x1a\xca\xb9\x19\x4e\x97\xfc\xff\x56\x5a\x6d\x20\x6a\x1f\x66\xd3\x99\xf8\xbf\xae\x76\xad\xec\x40\x57\xf6\x20\xca\x96\x4b\x04\xdd\x4a\x54\x2e\x1e\x10\x6c\x4b\x59\xa9\x51\xb7\x10\x65\x73\x8c\xb8\xb9\xa8\x9c\x61\xb4\xcd\x44\x65\xa4\xd1\xa6\x18\x80\xd1\x26\xf9\x80\x91\xc6\x16\x3f\x61\x74\x11\xd2\xd6\xf9\xc7\xab\x42\x51\x85\x32\x91\x83\x04\x02\x04\xe5\x24\x03\x59\x0b\x08\x9d\x9d\xac\xe2\x41\x54\xa0\x3c\x65\x10\x2b\x01\x81\x32\x96\x41\x2c\x25\x04\xa4\x7d\x21\x2a\x50\x16\x33\x88\xb9\x80\x40\xf9\xcc\x20\x66\x02\x22\x82\x94\x2b\x96\x39\x29\x97\x9c\x73\x12\x2e\xf9\xc6\xad\xb5\xaa\xa9\x8f\xad\x40\xf8\x5c\x33\xe5\xd1\xd6\x84\xbc\xc6\x21\x8e\x16\x22\xe0\x10\x0e\x69\xd4\x47\x7f\xcd\x01\x4c\x61\xd4\x47\xff\x81\x97\x3b\x64\x51\x1f\xfd\x15\x07\x70\x88\xa2\x3e\xfa\x4b\x01\x00\xa9\x5e\xf0\x72\x87\x20\xea\xa3\x3f\xe7\x00\x0e\x39\xd4\x47\x7f\xc6\x01\x22\x48\xb3\x64\x94\x93\x66\xc1\x2f\x27\xc9\x82\x5b\x86\x0c\xf8\x27\xf1\x56\x0a\x46\x30\x41\x17\x86\x04\x09\x0d\x10\x54\x2a\x12\x34\x30\x40\x51\xf1\x08\xd0\xb5\x01\xa9\xcb\x49\x00\x3c\x18\x00\xa8\xc0\x04\xe4\xca\x80\x44\x25\x27\x20\x97\x26\xa4\x3d\xd6\x85\x01\x80\xca\x52\x40\xce\x0d\x48\x54\xa8\x02\x72\x66\x40\x46\xf6\x48\x81\x08\x7a\x46\x6a\x4a\xa2\x67\xa0\xc1\xe8\xd0\xd6\x3f\x6c\x81\x60\x39\x39\xd6\x8b\xe5\xe4\x18\x19\x4e\x27\xc7\xe8\x75\x3a\x39\xd6\x0d\x70\x72\x2d\x11\x4e\x27\xd7\xd2\xea\x74\x72\xed\x90\xa0\x93\x6b\x07\xec\x74\x72\x2d\x5f\x9c\x4e\xae\x65\x1f\x74\x72\x2d\x73\x9d\x4e\xae\x1d\xaa\xcb\xc9\xd5\x27\xa7\x93\x53\x55\x6e\x27\xa7\x40\xdc\x4e\x4e\x82\x58\x4e\x4e\x56\xb8\x9d\x9c\x84\x70\x3b\x39\x09\x61\x39\x39\x59\xe1\x76\x72\x12\xc2\xed\xe4\x24\x84\xe5\xe4\x64\x85\xdb\xc9\x29\xbe\xb8\x9c\x9c\x04\xb0\x9d\x1c\xab\x41\x9d\x9c\xaa\x71\x3a\x39\x05\xe1\x74\x72\x12\x02\x3a\x39\x59\xee\x74\x72\x12\xc0\xe9\xe4\x24\x00\x74\x72\xb2\xdc\xe9\xe4\x24\x80\xd3\xc9\x49\x00\xe8\xe4\x64\xb9\xd3\xc9\x29\x76\x38\x9c\x9c\xac\xb7\x9c\x5c\x7d\x1a\x74\x72\x1a\xc8\x90\x93\xd3\x40\x87\x9c\x5c\x07\xea\x70\x72\x1d\xc0\x90\x93\xeb\x20\x87\x9c\x5c\x07\xe9\x70\x72\x1d\xc0\x90\x93\xeb\x20\x87\x9c\x5c\x07\xe9\x70\x72\x1d\xc0\x90\x93\xd3\xf8\xdb\xef\xe4\x3a\x40\xe8\xe4\x7a\x43\x19\xff\xa0\x1d\xb8\xe5\xe5\x58\x2f\x96\x97\x63\x64\x38\xbd\x1c\xa3\xd7\xe9\xe5\x58\x37\xc0\xcb\xb5\x44\x38\xbd\x5c\x4b\xab\xd3\xcb\xb5\x43\x82\x5e\xae\x1d\xb0\xd3\xcb\xb5\x7c\x71\x7a\xb9\x96\x7d\xd0\xcb\xb5\xcc\x75\x7a\xb9\x76\xa8\x2e\x2f\x77\x4a\x9c\x5e\x4e\x55\xb9\xbd\x9c\x02\x71\x7b\x39\x09\x62\x79\x39\x59\xe1\xf6\x72\x12\xc2\xed\xe5\x24\x84\xe5\xe5\x64\x85\xdb\xcb\x49\x08\xb7\x97\x93\x10\x96\x97\x93\x15\x6e\x2f\xa7\xf8\xe2\xf2\x72\x12\xc0\xf6\x72\xac\x06\xf5\x72\xaa\xc6\xe9\xe5\x14\x84\xd3\xcb\x49\x08\xe8\xe5\x64\xb9\xd3\xcb\x49\x00\xa7\x97\x93\x00\xd0\xcb\xc9\x72\xa7\x97\x93\x00\x4e\x2f\x27\x01\xa0\x97\x93\xe5\x4e\x2f\xa7\xd8\xe1\xf0\x72\xb2\xde\xf2\x72\xa7\x64\xd0\xcb\x69\x20\x43\x5e\x4e\x03\x1d\xf2\x72\x1d\xa8\xc3\xcb\x75\x00\x43\x5e\xae\x83\x1c\xf2\x72\x1d\xa4\xc3\xcb\x75\x00\x43\x5e\xae\x83\x1c\xf2\x72\x1d\xa4\xc3\xcb\x75\x00\x43\x5e\x4e\xe3\x6f\xbf\x97\xeb\x00\x47\x78\x39\x2d\xfe\xfe\x0f\x8a\x71\x5b\x6e\x8e\xf5\x62\xb9\x39\x46\x86\xd3\xcd\x31\x7a\x9d\x6e\x8e\x75\x03\xdc\x5c\x4b\x84\xd3\xcd\xb5\xb4\x3a\xdd\x5c\x3b\x24\xe8\xe6\xda\x01\x3b\xdd\x5c\xcb\x17\xa7\x9b\x6b\xd9\x07\xdd\x5c\xcb\x5c\xa7\x9b\x6b\x87\xea\x72\x73\xd9\xc1\xe9\xe6\x54\x95\xdb\xcd\x29\x10\xb7\x9b\x93\x20\x96\x9b\x93\x15\x6e\x37\x27\x21\xdc\x6e\x4e\x42\x58\x6e\x4e\x56\xb8\xdd\x9c\x84\x70\xbb\x39\x09\x61\xb9\x39\x59\xe1\x76\x73\x8a\x2f\x2e\x37\x27\x01\x6c\x37\xc7\x6a\x50\x37\xa7\x6a\x9c\x6e\x4e\x41\x38\xdd\x9c\x84\x80\x6e\x4e\x96\x3b\xdd\x9c\x04\x70\xba\x39\x09\x00\xdd\x9c\x2c\x77\xba\x39\x09\xe0\x74\x73\x12\x00\xba\x39\x59\xee\x74\x73\x8a\x1d\x0e\x37\x27\xeb\x2d\x37\x97\x1d\x06\xdd\x9c\x06\x32\xe4\xe6\x34\xd0\x21\x37\xd7\x81\x3a\xdc\x5c\x07\x30\xe4\xe6\x3a\xc8\x21\x37\xd7\x41\x3a\xdc\x5c\x07\x30\xe4\xe6\x3a\xc8\x21\x37\xd7\x41\x3a\xdc\x5c\x07\x30\xe4\xe6\x34\xfe\xf6\xbb\xb9\x0e\xd0\x72\x73\xe2\x5d\xa0\xbe\xc7\x18\xc5\x7b\x94\xea\x6b\x72\x53\x94\x9b\x07\xed\x5b\x9e\x38\xb9\xd2\x16\x75\x07\xb0\xb6\xf0\x1c\x79\x73\x44\x8e\x96\xb3\xce\xb5\xab\x52\xe0\xe6\x14\x72\xfc\x90\xb7\x79\x6c\x76\x45\xf2\xfa\xd8\x54\x8f\xea\xa9\x21\xad\xe8\xa8\x8a\xf6\x45\xd1\x00\x28\x55\xd4\x41\x1d\x29\x49\x00\x94\x2a\xea\x9e\x0e\x7b\x70\x1f\xbf\x00\x17\xdb\x9a\xa2\x74\x5c\x69\x4a\x92\xe4\x8a\x74\x01\x5f\x7d\x64\xe3\x05\x27\x07\x23\x14\x8b\x90\xcd\x67\x89\x6d\xb3\x4f\x2b\x79\x0c\x4f\x1b\x4f\x3f\x98\xe2\x44\x5c\x64\xec\x51\xac\x41\x74\xfd\x70\x26\x67\xcd\x3a\x17\xca\x61\xd0\xa3\xf6\x02\xd6\x26\x30\x14\xe1\x33\xfb\x5f\xbd\x1e\xe5\x96\x37\x75\x68\x3b\xbb\xbf\x29\x5e\xac\x8a\x8b\x3c\x61\x6f\xd2\x22\x3a\x86\x56\x1e\x91\x4a\x4b\xef\xd0\x4a\xac\xa5\xa5\x8b\x68\x65\xa7\x95\x0b\x35\x27\xd4\x5b\x5b\xf8\x43\x5b\x10\x0a\x1b\x1e\x52\x77\xb4\xeb\xec\xc1\x21\x75\x48\x3b\x7b\x68\x48\x9d\xe3\x99\x30\x9b\xfa\x9b\xb0\x89\x49\x24\x6c\x4b\xd4\xf1\xac\x6e\xaa\xb4\xd4\x06\xbc\xc9\x9b\xa3\x5f\xec\xfd\xe6\xb5\xa4\x1f\x8b\x24\xf9\x84\x29\xcb\xba\xfd\x27\x31\xb0\x23\xea\x5d\x7b\xe7\x91\x78\x76\xb4\x8a\x9b\x5b\x2f\x2e\xb2\x9f\xe3\x8c\xd4\xf5\x8f\x3f\xb5\xe6\xf9\xab\x75\x83\xd0\x7c\xaf\x2e\x2e\xb2\xf3\x29\xdf\xf2\xc5\x3f\x3b\x45\x26\xdf\x68\x33\xb0\x4c\xe4\x7b\x6d\x37\xe1\xa6\x59\xa6\x63\x06\xc6\x74\xca\xef\x3e\x22\x66\x56\xd5\x1c\x6d\x03\x9c\x4c\xe5\x95\x49\xcb\x34\xc3\x1a\xa1\x30\x48\x3f\xb0\x06\xb3\xea\x0e\x6c\x48\x3f\x42\x25\x90\x7e\x60\x0d\xe6\x17\x1c\xd8\xba\x7e\xdc\x12\x47\xd5\x44\xb4\xda\x88\x52\xa5\xc2\xbd\x50\x47\x1c\x4a\x54\x9b\x24\x02\x98\x6e\x08\x1c\xda\x05\x75\x34\xa0\xb0\x8b\x17\x0f\xed\x3f\x4b\x4b\xc4\x7d\x16\x4c\x4d\x54\x15\xaa\x27\xa2\x16\x53\x14\x58\x25\xf5\x01\xe9\xcb\xaa\x42\x75\xc5\x81\x10\xeb\x4b\xea\x04\xd2\x97\x55\x85\xea\x8b\x03\xa1\xd6\x97\xfb\xd2\x10\xae\x0b\xc6\x95\x21\xb7\xca\x00\xb0\x01\x9d\x31\xc9\x44\x94\xc6\x40\xe7\xd6\x9a\xa1\xfb\x4c\x49\x40\xd7\xf1\xd2\x52\x9b\x34\xdf\x17\x98\xce\xf0\x72\x54\x61\xda\x2a\x4c\x5b\x8c\x72\xa9\x0f\x10\xbf\x59\x8e\x2a\x09\x86\xc7\xc2\x2f\x75\x00\xe2\x37\xcb\x51\xc5\xc0\xf0\x48\xfc\xee\x6b\x5e\xb8\xac\xbb\x7b\x5e\x6e\x7d\xd0\x61\x06\x94\x41\x23\x0d\xd1\x84\x0e\x91\x5b\x0d\x7a\x2f\x9e\xc5\x73\x3a\xdb\xcf\x2c\x1d\x10\x77\xc9\x30\x35\x50\x55\xa8\x26\x88\x5a\x4c\x19\x60\x95\x94\x3b\xd2\x97\x55\x85\x6a\x85\x03\x21\xd6\x97\xd4\x01\xa4\x2f\xab\x0a\xd5\x10\x07\x42\xad\x2f\xf7\x85\x3d\x5c\x07\x8c\xeb\x7a\x6e\x55\x01\x60\x03\xda\x62\x92\x89\x28\x8c\x81\xce\xad\x33\x83\x77\x09\xc9\x3e\x8a\x63\x4b\x6d\xf8\x05\x41\x4c\x6b\x64\x0d\xaa\x34\xbc\x12\xd3\x19\x50\x23\xf5\xc2\xee\x07\xd6\xa0\x0a\x83\x63\x43\xfa\x91\x3a\x61\xf7\x03\x6b\x50\x65\xc1\xb1\x75\xfd\xb8\x2f\x5e\xe2\x3a\xa0\xdf\xbb\x74\x6b\x8a\x09\x35\xa0\x28\x06\x89\x88\x9e\xe8\xc8\xdc\x6a\x32\x74\x21\x74\x17\xc7\x4a\x4b\xb4\xbc\x30\x17\xed\x48\xf2\x34\x08\xbf\xef\x6e\x07\xbc\x18\x07\xf9\x79\x22\x78\x8f\xe4\x89\xf7\xb1\x0b\x42\xac\x96\x2b\x16\xf0\xb7\xb0\x3a\x63\x14\xec\x90\xb3\x76\x03\x41\xdc\x50\xf4\x4f\xb5\xba\x84\x28\x2e\xf6\xb4\x45\x2d\x05\xc7\x94\x05\x51\xf8\x55\x85\x1d\xa9\xf0\x4c\x2f\xf6\xc8\x1e\xc5\x66\xd6\xba\x75\xea\x00\xc4\xf6\x7b\x7d\x40\xc7\x1e\x20\x7b\x07\xd8\x07\xd4\x87\xc9\xde\xc5\xf5\x01\x1d\xf1\x1c\x01\x78\x3b\x6b\x3f\xec\xe6\x0d\xba\x29\xd6\xa3\x0f\x4e\xe2\xd0\x2d\xf3\xad\x2d\x3b\x76\xde\xdd\xf2\xe6\x3e\x3b\xc6\xdf\xdd\xd2\xe8\xf3\x02\xee\x25\xde\xc4\x69\xed\x4e\xe9\x6d\x8c\xbe\xad\xa1\xc6\xe7\x3b\x1b\xde\xda\xa3\xc6\xe5\x3b\x1b\xea\x3d\x5e\x8c\x7b\xa1\xb7\x30\x59\x43\xd2\x37\xd5\x06\x1a\xba\x27\xb2\xcd\xab\xdb\x7b\xc4\x1a\x82\xf8\xcd\x26\xb8\x5e\xf7\x29\xcd\x92\x9a\x36\x97\xee\xc3\x6c\x60\xa7\x7d\x0a\xba\x84\x4e\x19\x3d\xd0\x3c\x01\x97\xee\x34\x03\x0e\xdb\x3a\x52\xb8\x44\x21\x88\xff\x9a\x17\xdc\xb4\x3b\x8a\x5d\x42\x2b\x24\xcb\xc0\xa2\xfd\x77\xe5\x4f\xf5\x8f\x49\x1e\x66\xd2\xb4\x00\x69\x64\x56\x41\x70\x65\x09\xe7\x7e\x6e\x5e\x4b\xfa\x13\x7f\x0e\xfb\xeb\x7b\xe7\xe6\xd3\x7a\x60\xef\x4a\xec\x8a\x97\xaf\x13\xad\xb0\x22\x49\x5a\x7c\x95\x79\x71\xe6\xec\x42\xa5\xe2\xa6\x88\x80\xff\x6d\x6d\x30\x8f\x5f\x6b\xd5\x31\xef\xd3\x8c\x7e\x35\xa5\x74\x35\xfa\xc8\x0f\xb0\x5e\x93\xe2\x95\x27\xdc\xfb\xf9\x74\xce\x9a\xb4\xcc\xe8\x57\x91\x81\xef\xe7\x56\x76\x5f\x2f\x7a\x82\x37\xd8\xa7\x48\x3b\x81\x0d\xd2\xae\xe2\x43\xfd\xcd\xb2\xe6\x15\xe7\xa6\x3c\x37\xf8\x05\x51\xc6\xc9\x95\x79\x25\x74\x38\x5f\xe1\x62\xb1\xb8\x4e\xf7\x45\x75\xf2\xe3\x22\x6f\xaa\x02\xde\xab\xb7\xf3\xd4\xcd\xe6\x5a\x1e\xb5\x65\xf9\xe2\x85\xd1\x1d\x9d\xba\xd2\xd8\x75\xa5\xe9\x89\x1c\xa8\xbc\x24\x3b\xea\xc2\x69\xdf\x8d\xdf\xb6\x6d\xfb\xff\xfa\x45\xde\x60\x85\xdf\xf9\x75\xc2\x22\xc9\xf3\x04\x11\x6c\x08\x7a\x02\x3c\x6f\x1a\x2e\xea\x89\x4d\x90\x05\x03\x52\xed\xf5\xe3\xeb\xc3\xf3\x1e\x48\x4c\x55\x10\x6a\xac\x63\xdb\x7c\xb7\x5c\x92\x3d\x5d\x2b\x3d\x46\x6f\x36\x0f\x31\x72\x12\x78\x81\xf7\x20\x2b\xc2\x20\x9a\x84\xab\xc5\x24\x9a\xcd\x26\xd3\xe5\x4d\x12\xe9\x45\x04\x06\xb3\x61\x76\xad\xcc\x48\x4c\x8f\xec\xe9\x36\x99\x05\x68\xbd\x5e\x6f\x8b\x92\xc4\x69\xf3\xba\x09\x41\xa3\x76\x15\xce\xa6\xb7\xa3\xa1\xd5\x87\x60\xc6\x4d\x6d\x4e\xb5\x78\x65\xa7\xf7\x3b\xab\x96\x82\x50\x6f\xff\x73\x92\xb2\x5c\x83\xc9\xd7\x89\x59\x5e\x51\x92\x14\x79\xf6\xfa\x75\x22\x5d\x62\x07\xea\x99\x53\x1e\xd9\x2f\x51\xea\xe2\x89\xd6\xe1\x20\x62\x91\xe8\x24\x2f\x1a\x9f\x64\x59\xf1\x4c\x93\xab\x4c\x79\x6a\x02\x3a\x0c\x30\x74\x58\xa4\x2c\x29\xa9\x48\x1e\x8b\xbc\x36\xc8\xee\x4c\x82\xb6\xee\x3f\xa1\x4f\x69\x4c\xfd\x32\x7d\xa1\x99\xcf\x92\x9b\x6e\x82\x4f\x17\x0d\x7f\x42\x1a\xfa\xd5\xa0\x44\x37\xe6\x4d\x7a\xea\xa9\x6d\xdb\xb2\x67\x96\xb3\x22\x26\x99\x1b\xee\x54\xe4\xcd\xd1\xac\x36\xd2\xe3\xcc\x58\x2e\x39\xae\x30\xec\xab\xa9\x5f\x9f\x3c\x48\xe3\xa4\x07\x80\x91\xd9\x07\x00\x28\xed\x03\xe5\xc4\xc2\x61\x7e\x15\x2d\xea\x93\xcd\x1e\xac\x06\xb2\x06\x83\x11\x6c\x91\x55\x26\x4b\x02\xc8\x92\xec\x30\xc0\x12\x13\x00\x61\x89\x8d\xc1\xc9\x12\x13\xb4\x9f\x25\xd9\xc1\xc5\x12\xb3\x06\x67\x89\x09\x63\xb0\x24\x3b\x18\x2c\x99\x2f\xd9\x0d\x7e\xa6\x45\x8c\xca\x8b\x1d\x58\xb8\x4e\xe5\xca\x64\x32\x65\x0b\x11\xe4\x0a\x36\xcc\x73\x3b\x9c\xd9\x51\xe2\xf4\xd8\xa2\x54\x60\xe6\x7f\xe8\xd1\x13\xb6\x1c\x36\xae\x6f\x23\x99\x2e\x83\x2d\xcc\xa3\x09\x53\x98\xaa\xde\xd0\x45\xa5\xaa\x16\xb9\xac\x1c\x50\x9c\x44\x6b\x59\x26\x2a\x90\xb6\x62\x85\x6a\x27\x91\xd5\x18\x34\x67\x8b\x54\x23\xa5\x41\x64\x30\xe8\x33\x64\xff\x67\x21\x05\x0d\x89\x6f\x88\x49\x50\x62\xd2\xd5\x23\x34\x34\x5d\xd7\x78\x5e\xa3\xf9\x95\x9d\x12\x10\xe4\x7c\xee\xa7\xf6\xb3\x49\x3b\x96\x1d\x4c\x1c\x10\x63\x49\x9f\x2d\x7f\x81\x0a\xb0\x1f\x4e\x08\x13\x6b\x39\x95\x0d\xd0\x5a\xcd\x73\x59\xe8\xd0\x96\xbc\xaa\x6b\x86\xf9\x33\xc8\x9e\x0e\x91\xc1\x99\xae\x18\x73\x9a\x90\xc5\x18\x8c\xc1\xe7\x3e\x42\x54\x57\xc6\x8c\x85\xa5\x7d\x64\xf4\x80\xe8\xb3\x1f\x23\x42\x77\x73\x3e\x3f\x51\xa0\x5b\x09\x7d\xf3\xa0\x36\x2c\xe0\xa0\xd8\xca\x56\x65\x14\x6f\x67\x43\x7b\x6a\xeb\x13\xc8\x72\x12\x80\xec\x5a\xd7\x0e\x50\xf3\x3e\x5b\xed\xfc\x8c\x95\x68\x27\xb2\x36\x38\x0b\x3b\xfd\x90\xd8\x71\xe2\xd8\x2d\x67\x07\xb7\xa7\x9d\xcb\x54\xeb\x25\x88\x88\x9f\xca\xec\x1c\x42\xeb\xc9\xd1\x45\xd5\x3b\x8d\x07\x74\x25\x86\xe7\xec\xd0\xf6\xe7\x58\x7b\x6d\xc4\xc6\xfa\x09\x00\x0f\x2f\x1a\xfb\x18\x21\xf5\x50\x27\x4f\xd7\xc9\x08\x6e\x68\x87\xf9\x73\xed\xbc\xb4\xe6\xa0\xb7\x46\xc2\xbd\x2e\xa9\xaf\xca\x24\x0c\xd0\x88\x53\x98\x5b\x3b\x8d\xbc\xa1\x3c\xa0\x13\x6b\x59\xe0\x50\x9e\xec\x00\x95\xa7\x43\x64\xf1\x2c\x3b\xe0\xca\xf3\xbe\xc3\x02\x3d\xf6\xe8\x10\x3e\x52\xb4\xfd\x18\x1d\xca\x0e\x37\xea\x10\xe4\x07\xd0\x21\x46\x9e\xae\x43\x0f\x3a\x9b\xc2\x5b\xd8\x74\x9d\x1e\x49\xed\xef\x29\x4d\xda\x6d\x98\xed\xfd\xcd\x7a\x20\x25\xd3\xb6\xcd\xa3\xe9\x42\x71\x49\x12\x6e\x63\x56\xab\x1b\xee\xa6\xa5\x5d\xfc\xd5\x4f\xf3\x84\xbe\x6c\xa2\x2d\x16\x02\x62\x96\x5b\xb7\xe2\x70\x0f\xb3\xb5\xf2\x3b\x6f\xc5\x9a\xc2\xa7\x4f\x34\x6f\x6a\x71\x80\xac\x87\xc9\x9f\x71\xca\xe1\xea\x7c\x00\xcc\x09\x20\x6f\x95\x2c\xbb\x91\x0c\xab\x19\x34\x26\xa3\x68\xac\x4f\x03\x60\x4e\x00\x79\xb9\x25\xd0\xb8\x8d\x9b\xd3\x56\x2b\xc4\xb9\x1a\x4f\x5b\x79\xa2\xc5\x6a\xe9\x66\xd6\x8a\xbe\xc5\x32\xc1\xa8\x3b\xd2\xac\xe4\x0b\x4d\x50\xc1\x16\x00\x58\x19\xd6\x87\xb5\x79\xc0\xea\xe4\x7a\x1c\x01\xd1\x56\x1b\x48\x85\xd1\x10\x64\xc5\x36\x09\x34\xa3\x1d\x46\x3c\x8b\xc3\xff\xd6\xa1\xc3\x1e\x7a\xd0\x28\x1b\xcf\xd1\x7d\x77\x6c\xad\xf5\x62\xdf\x2d\x57\xbb\x70\xf9\x70\x73\x38\x4d\x6b\x0b\xa8\xd6\x35\x9c\x24\x49\x91\x9b\x3c\x47\x42\xba\xfc\xc4\xda\x16\xe3\x78\x0f\x47\xba\xc9\x80\xb4\x10\xe7\x41\xa0\xca\x5b\xc5\x86\x3a\x76\xb5\xb6\xca\xab\x3a\xa8\xf2\xaa\x42\x53\x79\xb3\x0c\xeb\x03\x55\x79\x58\x87\xa8\xbc\x04\xb1\x54\xde\xa8\x40\x55\x5e\x64\x5a\x37\x09\xec\x51\x79\x0e\xff\xfb\xa8\x3c\x4a\x8f\x23\xb0\xbc\x08\xdf\xaa\xf2\x71\x40\xc2\xe5\xee\x3e\x95\xe7\x6d\x01\xd5\x4e\x95\x17\x3c\x74\x9d\xb4\xda\x62\x1c\xef\xe1\x88\xa5\xf2\x7a\x0b\x5a\x55\x45\x05\x15\x1e\x14\x1a\xaa\x28\xeb\x6c\x65\x17\x35\x50\xd5\x45\xb1\xa6\xe8\x7a\x89\x8d\x1b\x55\x72\xb3\x06\x51\x71\x0e\x60\x29\xb8\x56\x8c\xaa\xb7\xc8\xfa\xaf\x93\xd5\xa3\xdc\x1c\xfa\xf7\x51\x6e\x84\x1a\x54\xb5\xf9\x0b\x04\x6f\x54\x6d\xfa\x30\x7f\x98\xdd\xa9\xda\xac\xad\x41\xb3\x53\xb1\x05\xff\x5c\xe7\xc2\xb6\x18\xb7\x9d\xdc\xb0\xd4\x5a\x87\x57\x4b\x5a\x26\xed\xff\xed\x68\xc8\x6e\xeb\x2c\xe4\x82\xc7\x6c\x23\x5f\x5c\xea\x6b\x1b\x5c\x35\x6d\xb7\x9e\xf9\x52\xd1\xaa\x05\x1a\xfd\x54\x17\xd6\x66\xed\xbf\x9e\xfc\x5d\xac\x7f\xa1\xbc\x7a\x90\xd6\xf1\x09\xdf\x8c\xd3\x39\xde\x3d\xb3\x71\xc2\x4f\xb4\x06\x56\xed\x55\xa9\x5b\x11\xca\xcd\x0d\x86\x17\x34\xd3\xf4\x06\x82\xb3\x03\x1b\xa3\xfa\xd6\x90\x78\xd8\x9e\x0d\x85\xb3\x34\x76\x2c\xf0\xae\xc9\x2f\x1d\x73\xdc\xa4\x3c\x9a\x4c\xd6\x6f\xcd\x1b\x4d\x0c\xb3\x0a\xcf\xd7\x8d\x1a\x7f\x67\xc9\x8d\x62\x1e\x2d\xee\x53\x19\xec\xd5\x85\xdb\xba\x94\x86\xd7\xee\x58\x18\x5d\x18\x1d\xc3\xb1\xe0\x71\x77\x04\x69\x4f\x90\xbd\x4b\x0b\x6b\x5c\x39\x35\xb1\xb8\xf7\xbe\x70\x9a\x8b\x86\xda\x13\x0d\x90\xcf\x58\x95\xf2\x6d\x16\x84\x70\x86\x78\x39\x7c\x88\x43\x86\x32\xfb\xe4\x74\x1b\x81\xe2\xd3\x81\xf6\x81\x65\xa5\x76\xa6\x3a\x9c\xfd\x45\xa8\x3f\x03\xf3\x80\x0d\x33\xa8\x33\x14\xdd\x31\x54\xa5\x85\xd6\xe3\x4f\x08\xb1\x63\xa4\xd9\x65\x03\xbe\x85\x56\x10\x55\x70\x93\x1e\x86\x56\x5c\x06\x4b\xe6\x31\xa6\xa3\xfa\xd4\xd7\xd1\xd2\x0a\x22\x5e\xaf\xd3\xd6\x16\xf5\x7d\xcd\xe9\x0e\xd4\xa0\x1f\x73\xba\x03\x36\xbd\xef\xd1\x75\x07\x6e\xec\x98\x8c\x7d\x42\xd6\xf1\x5d\xc8\x3f\xd5\x7e\x53\x9c\xe3\xa3\x4f\x62\x36\x5f\x4f\x24\x4f\xcb\x73\xc6\xde\x08\xdd\xba\x6b\xcc\xef\x49\x6a\xd1\x73\xae\x69\xe5\xf3\x80\x1d\x3f\xd4\xc3\x8e\x63\x20\xa5\xb5\x5d\x68\x15\x8c\x3c\x26\xe4\xce\x13\xcf\xbe\xb9\xef\x9a\x5c\xdc\x67\x9b\xf2\x83\x5c\x5a\xc9\x46\x2b\xe9\x7e\x6e\x2c\xf0\x8d\x05\xfe\x9b\x1d\xfe\x82\xb4\x68\x3f\x8d\xf7\xae\x66\xb3\x19\xfe\x0a\xac\x36\x3c\x9d\xf6\x0b\xce\xcd\x51\x27\x7c\x66\xe5\x8b\xb7\x00\x6b\xcf\xd0\xf1\x94\x82\x0b\x96\xd3\xd5\x7d\x36\xdb\x35\xf9\xc0\x19\x93\x76\x16\xd9\x1f\x9f\xb6\xfb\x34\x63\xcf\x6e\x64\xe5\x91\x7c\x14\xa7\x57\x7e\x5a\x6a\xe7\xb6\x06\x1e\x5f\x50\x27\x5e\xa6\xcb\xc5\x95\x98\x54\x21\x64\x30\x88\x0b\x1a\xe3\xdc\x35\xb9\x9f\xd0\x3d\x39\x67\xcd\x65\xe8\x61\x60\xb0\xa2\x66\xd7\x14\xb4\xf6\x9a\xc4\x65\x91\xd0\xb0\x5e\xc4\x74\xd9\xfe\x83\xdb\xd0\xb8\xfd\x67\xa0\xb7\x55\x67\x24\x2e\x92\xb4\xff\x4c\x52\x35\xdd\x52\xf8\x65\x59\x51\xd2\xfc\x71\x9a\x54\x45\x99\x14\xcf\xad\x87\x3c\x1c\x32\x3a\x9e\x51\x37\xd2\x80\x70\xcd\x9e\xd8\xb0\x46\x5c\x31\xb1\xa9\xc7\x64\xe0\xc4\xb6\x31\xb1\x0d\x8e\x5b\x22\x1f\x04\xdc\x8c\x06\x1c\x21\xd3\x64\xde\xfe\x1b\xd6\x8f\x37\xca\x14\x35\x2d\x66\x07\x72\x42\x61\x22\x93\x75\x98\xd0\x54\x9d\x2d\xb6\x6e\x92\x22\x48\xbb\x4a\x04\xab\x56\xc9\xd1\x3a\xcc\x0f\x10\xde\x00\xd4\x66\x1c\x94\xf3\x8a\xdb\xa0\x91\xf0\xa6\x3b\x92\x1c\xe8\xd0\xbb\x86\x33\xde\xe8\x86\x57\x10\x41\xbf\x11\x5d\x26\x64\x6e\x60\xd1\x39\x6c\xbc\x96\xd8\x8f\x9e\xbf\x92\x08\xd0\x87\x51\xb4\x9b\x07\x06\x7a\x53\x97\x6f\xc0\x15\x05\xf3\x64\x05\x48\xd5\x75\x59\xe2\x1f\xd6\xe5\x51\xec\xba\x91\x06\x84\x6b\x88\x7d\x02\x35\x9a\xa2\x9b\xd4\x63\x32\x70\x62\x1b\x6d\x9f\x80\x80\x07\x01\x87\xed\xd3\x2d\x32\x65\xac\x1b\xd6\x8f\x37\xca\xb4\xc7\x3e\xc9\x0e\x30\xfb\x04\xeb\x30\xa1\x61\xf6\x49\xd4\xe1\xf6\xc9\xaa\x44\xb0\x8e\xb6\x4f\xa6\xf0\x06\xa0\x06\xec\xd3\xd0\x8b\xa7\x63\x4d\x05\xb0\x52\xb2\x19\x9e\x6d\xa7\x6d\x07\x1e\xbc\xc5\x35\x65\x11\xef\x1e\x16\x31\xe8\x7d\x1e\x13\x3a\x8f\x0d\x2c\x3a\xab\x8d\x27\x57\xfb\xd1\xcf\xe7\xeb\x64\x0e\x15\x31\x5a\x2c\x96\xd1\xc2\x40\x3f\x46\xa9\x51\x5c\xb3\xf5\xc3\x7c\xb6\x36\x49\xd5\x95\x5a\xe2\x1f\x56\xea\x51\xec\xba\x91\x06\x84\x6b\x88\xa1\x02\x35\x9a\xc6\x9b\xd4\x63\x32\x70\x62\x1b\x6d\xa8\x80\x80\x07\x01\x87\x0d\xd5\x0d\x32\xe5\xac\x1b\xd6\x8f\x37\xca\xb4\xc7\x50\xc9\x0e\x30\x43\x05\xeb\x30\xa1\x61\x86\x4a\xd4\xe1\x86\xca\xaa\x44\xb0\x8e\x36\x54\xa6\xf0\x06\xa0\x06\x0c\xd5\x50\x9a\x91\xb1\xa6\x02\x18\x2a\xd9\xcc\x6d\xa8\xf4\x57\xb4\x1d\x56\x6a\x17\x07\xd6\xd7\x93\xf9\x72\xf7\x90\x90\x0e\x85\xce\xe4\xee\xd1\xe6\x01\xfd\x0b\x77\x41\xb2\x80\x8e\x72\xb7\x4c\x1e\x16\x1d\xe2\x51\x8a\x8c\x21\x8a\x96\x6b\xb2\x8b\x35\x0a\x75\x2d\x66\x98\x87\x55\x78\x98\x39\xb7\x74\x0d\x79\x84\x58\x23\xbd\x58\xd3\x69\x8d\x5c\x8b\xd1\x38\x92\xd1\x16\x48\x17\x5e\x3f\xd4\xb0\xed\x19\x2b\x2f\xce\x9f\x01\xc1\xdf\x2d\xaf\x1e\x7b\xc3\xf0\x62\xc6\xc6\xa8\xb0\x04\x82\x99\x99\xb6\x02\xb7\x31\x66\x0d\x44\x36\xda\xba\x68\x82\xe9\x03\x19\xb0\x2b\xbd\x79\x6b\x46\xcd\x6c\x68\x51\x44\x1b\xb7\x45\x01\x8f\xe9\xe3\x2a\xb0\x0f\x48\x32\x87\x5d\x53\x4a\xa2\xd9\xd2\xc0\xa2\x33\xd6\x78\xae\xbd\x1f\x3d\x8d\xd7\xab\x10\x6e\x3d\xd7\x0f\x8b\x7d\x90\x18\xe8\xc7\x68\x2b\x8a\x2b\x59\x3c\x2c\xc2\xc8\x24\x55\x57\x58\x89\x7f\x58\x67\x47\xb1\xeb\x46\x1a\x10\xae\x21\xc6\x06\xd4\x68\xca\x6d\x52\x8f\xc9\xc0\x89\x6d\xb4\xe1\x01\x02\x1e\x04\x1c\x36\x3f\x37\xc8\x94\xb3\x6e\x58\x3f\xde\x28\xd3\x1e\x53\x24\x3b\xc0\xac\x11\xac\xc3\x84\x86\xd9\x24\x51\x87\x9b\x25\xab\x12\xc1\x3a\xda\x38\x99\xc2\x1b\x80\x1a\x30\x51\x83\x69\x92\x46\x9a\x0a\x18\x49\x12\xcd\xdc\x86\x4a\xe4\x11\xea\x57\x94\xf5\x62\x36\xb7\x26\xde\x7c\xb6\x9f\x11\x1d\x89\x11\xac\xe3\xf9\x7c\x46\x58\xa9\x78\x3d\x0b\x22\xe8\x07\x57\xcb\x30\x0e\xd7\x3a\xf2\x31\x0a\x8d\xa2\x22\x71\xb4\x96\x6b\x79\x41\xa7\x11\x13\xe5\xd8\x47\x84\x44\x47\x30\xea\x36\x02\x6c\x7e\x61\x31\x6e\xa3\x42\x8f\x95\xea\x84\x23\xac\x77\xa1\x1a\x1f\xdf\x36\x84\x3a\x04\x37\x22\xba\x3d\x5a\x8e\x9c\x63\x83\x2a\xf1\x26\x39\xf6\x45\xb6\x39\x76\x34\xb0\x6d\x56\x21\x82\x42\xc3\xda\xac\xca\x11\xd5\x06\x75\x36\xca\xf1\x31\x6d\x5d\x60\xfd\x40\x43\x11\xed\xfe\x6c\x5c\x23\xed\x01\xb0\x45\xb2\x95\xdb\x16\x65\x69\xfe\xed\x62\xdd\x30\xc5\x82\x54\xdd\x73\xe1\xb2\xdd\x44\xfd\x32\xd4\xa2\x2d\xd8\xc0\x82\xe1\xcf\x93\x9c\x94\xde\xeb\xfc\x63\x5f\x84\x47\x28\xb4\x08\xd2\x85\xce\xfe\x16\x9c\xd7\x19\xac\xa7\x6c\x1f\x6a\x28\xb7\x18\xb3\x45\xb4\x8a\xad\x8f\xc9\xe7\x3c\xa1\x55\x96\xe6\x88\x5f\x40\x3b\xc1\x95\x13\xd4\xf4\xab\xa6\x46\x6b\x2f\x88\x41\xbe\x4a\x33\x8f\x7d\x08\x57\x87\x45\x1e\xdb\xbf\x04\x49\x87\xcb\xfb\x5d\x75\xea\xfa\xa8\x4f\x5a\x1f\xdd\x85\xc4\xb7\xdc\xc5\xeb\x90\xbf\xd4\x1a\xf2\x97\xba\x1b\x00\xff\xda\x7e\x27\x6e\xec\xb4\xa4\x7e\x20\x4e\xc1\x7c\xd6\xc0\xcd\xe3\x94\x7a\x1e\x87\xdd\xb9\x69\x8a\xfc\x6b\x07\x6b\xdc\xb2\xa5\x35\x6d\x1c\x75\xf5\x79\x77\x4a\xf5\x4a\xf3\x58\x1e\x49\xe8\x45\x7e\xb4\x0f\xb0\xf4\x2c\xa2\x92\x25\x37\xf1\xda\xb1\x93\x0a\x24\x5c\xc1\x20\xfa\xab\x79\xbf\xd3\x34\xbf\x68\x19\x32\xe2\x22\xcb\x48\x59\x53\xc5\x33\xae\x68\xb2\xb8\x85\x36\x73\x17\x35\x15\x5a\x29\xf2\x60\x15\xcf\x57\x96\x62\xab\x1f\x86\xab\x80\xea\xa5\x5d\x2c\xdb\xa7\xeb\x84\xb0\x03\x95\x76\x50\x25\x1d\xb4\xd8\xe5\x37\xe9\x29\xcd\x0f\xfe\xfe\x9c\xf3\x83\x3d\x94\xd4\xd4\xe4\x17\x0e\x32\x88\xc2\xee\x2a\x39\x8b\x19\x39\x9d\xc1\x24\x38\xa0\xce\xdd\xc8\xc6\x5a\x56\x45\x49\xab\xe6\x75\xc3\x47\x3d\x79\x4a\xeb\x74\x97\x66\x69\xf3\x0a\xba\xe8\x01\x1c\x05\x75\x9d\xc6\xa4\xa2\x4d\xdf\x51\xdc\xa0\x63\xbd\xf1\x90\x47\xf9\xe2\x38\x71\xa5\xbd\x4f\x30\x2f\x5f\xbc\x84\xd4\x47\x9a\xc0\x52\x76\xb2\xe9\x6f\x2a\xe0\x2c\x2e\xfe\xf5\x1d\x79\xe2\x4f\x92\x60\x10\x57\xb5\xc0\x99\xb0\x5f\xe7\x12\xbb\x7e\x08\x16\x41\xe0\x7c\x53\xa0\x01\x9c\x68\x7e\x76\x5c\x33\x64\x19\xa4\xf8\x09\x4f\x75\xd1\x30\x0c\x82\x60\xab\xcf\x97\x6d\xf7\x7e\xd5\x56\x7b\x57\x6b\x09\x2f\x30\xab\x34\x6e\x91\xc8\x25\x06\x0e\xc7\x81\x87\x44\xb6\x59\x5a\x37\x22\xad\x26\x3c\x3e\xa6\xad\x23\x95\x43\xd6\x6a\xb3\xb4\xdc\x74\xd7\xd3\x5f\xb6\xbd\x75\x3d\x59\xaa\xb4\x52\xe3\xf8\x13\x3b\x29\x35\x22\x8d\x15\x3f\xc8\xdf\x5a\x70\xb3\x3d\xb8\xb6\xd0\x03\x06\xc4\x34\x65\xaf\x2c\x31\xed\xb9\xc8\x0b\xa0\xda\x63\x3f\x06\xac\x37\x4d\xd2\xa7\x34\xa1\x95\xbc\xfd\x1a\xaa\xf3\x89\x9b\x35\x13\x07\x34\x2d\x48\xec\x85\x27\xb6\x33\x11\x3f\x66\xe9\x23\xc1\xb3\x99\xb5\xae\xc8\x63\x59\x2d\xe2\x8c\x92\x6a\xb3\x2b\x9a\xe3\xd8\x43\x8f\xda\xc1\x17\x2c\x1f\xa8\x4d\x82\x5c\x96\x20\x35\xe6\x7a\x68\xd9\xfe\x43\xd7\x14\x98\x4e\x89\x04\xff\x26\x56\xf9\x8e\x00\x81\xdd\xa9\x0a\x9c\x9a\xae\xda\xda\x00\x8d\xa4\x47\xac\x80\x5d\x13\xf7\x51\x6d\x3d\x10\xda\xba\x2a\x07\x75\x1a\x00\x5c\x83\xf5\xf4\x33\x16\x19\x3a\x42\xe4\x04\x60\x7f\xfe\x2c\xf4\x98\xa3\x38\x34\x58\x56\xc5\x21\x4d\x36\xff\xf5\x7f\xfe\xb9\xad\xfa\x6b\xdb\x6c\x5f\x54\xa7\xe9\x5f\xd2\xb8\x2a\xea\x62\xdf\x4c\x0f\xed\x0c\xa5\x79\xf3\x91\xe6\x8c\xb8\x9f\xf6\x24\xab\xe9\xa7\x2b\xdc\x2a\x32\x23\x68\xfa\x7a\x0e\x42\x9c\x36\x73\xe4\x3c\x64\x96\x5c\x7b\x17\x6d\x2b\x4f\xcc\x2b\xa8\x23\x25\xed\x34\x1d\x98\x51\xbd\x4b\x42\x38\x8b\xda\x45\x74\xef\x2c\x6a\xd9\xda\xfe\xd1\x19\xfe\x7d\xfa\x42\x13\x70\xb9\x5c\x9d\x63\x06\x3e\x60\xbd\x0e\xae\x9a\x2d\x82\x7c\x74\xb0\xe4\x5c\x7a\xdc\xff\x4e\xa6\x39\x79\xda\x91\xca\x67\x7d\x8a\xd3\xd2\x9e\x42\x22\xa0\x2e\x71\x91\x37\x34\x6f\x36\x1f\x3e\xe8\xee\x14\xe6\xe1\xb4\x9d\xae\x56\x21\xfc\x6e\xd7\xbf\x41\xe8\x20\x1d\xe6\xb0\xda\xde\x99\x04\xd5\x5d\x23\xfb\x35\xab\xde\xf3\xef\xa2\x37\xc6\x1e\x88\x1c\xe1\x59\x0f\xb8\x4b\xa9\xb4\x3d\xc6\x44\xdb\x6e\xc8\x95\xcb\xd8\x2c\x45\x8e\xbb\x29\x36\x42\x6d\x17\x23\x6e\xe3\xf0\xd3\xb5\xb0\x17\xfd\x89\x4b\x07\x16\x63\x03\x8f\xd4\x6f\x86\xea\xb5\x5d\x2a\x56\xad\x45\x66\x3a\x52\xed\x4e\x1d\x7d\xe1\x5d\x68\x98\x2f\x2a\x11\x83\x36\x42\xb6\xc7\xfd\x0c\x78\xd4\x15\x5a\x82\xf2\xba\x9f\x68\x2b\xad\xca\xbc\x3d\x22\xae\x8a\xc8\x8d\x60\x53\x14\xd9\x8e\x54\x66\xed\x02\xd4\x7a\x5d\x0f\x7a\x89\x4e\x94\x2a\xd7\x2f\x90\x41\x59\x0a\xa0\x47\x0b\xdd\xa3\x03\xdd\xa3\x81\xce\x78\xa3\xcf\xd8\x25\x73\xee\xe6\x45\xf3\x51\xcf\x49\xfd\x89\x97\x74\x09\x85\x79\x01\x5c\xf0\x7e\xba\xa0\x41\x23\x5d\x98\x5a\x9e\x6b\x70\x99\xc9\x0d\x79\x63\xe7\x4d\x51\xf2\xf9\xab\xc8\x30\x8d\x14\xa8\xb4\x7a\xee\x3a\xb2\xf9\x60\xe8\x21\x5c\xee\x5b\xd0\x3a\x45\xed\x30\x5d\x04\x19\x75\x90\x1e\x87\x06\x40\x80\x11\x32\xe3\x86\x62\x40\x44\x02\x5b\x1f\xfb\x21\x9b\xe0\xe4\x1c\x85\x02\x30\xef\x9d\x84\x27\xba\xee\x13\xa1\xad\x88\x6f\x94\x92\x67\x29\x82\x65\xc9\xd8\xb2\xc6\x82\xd3\x57\x39\xe6\x30\x3e\x5b\xa0\x66\xfa\x9a\x07\x98\x41\xf0\xc1\x9e\xc4\x2c\xbb\x4b\x3f\x9a\x30\x82\x78\xc2\xc8\x40\xe4\xa0\xfb\xf7\xb8\xe6\xd2\x47\x40\x17\x37\xbe\x25\x34\x2c\x97\x38\x88\xdd\x61\x17\xe1\x58\xa5\x10\xb9\x78\x69\x59\x10\x08\x35\x41\x44\x2d\xba\x15\x4e\x0f\x8e\xc0\x13\x58\xc6\xb8\x72\xb3\xc2\xbd\xa6\x78\x84\xde\xdf\x5c\xc7\x76\xaf\xd0\x6d\x9d\x4f\x82\x3a\xc9\xd1\xd1\xea\xcf\xd9\x39\xc0\xa1\xcf\x44\x6a\x47\x8d\xa3\x1f\x8f\xd3\x09\xb3\x54\x91\x21\xb8\xbf\x19\x38\xa9\x1d\x61\x25\x7b\x0c\x24\x5c\xfc\xf4\x18\x38\x97\x51\x99\x97\x2f\x5b\x97\xa9\xd3\xea\x50\x63\x37\xd2\x1e\x01\x2a\xfb\x6c\xe1\xa0\xe9\xbb\xcd\x1c\xdb\x03\x00\x43\x1f\x50\xba\x77\xf0\x61\x28\xda\x3b\x9d\xd9\x1d\xb8\x1c\x5e\xed\xfd\xc4\xf9\x9b\x38\x38\x5b\xca\x7a\xff\xbf\x9c\xeb\x26\xdd\xa7\x34\x31\xc3\xea\xba\x69\xe1\x71\xf6\x8c\xbc\x16\xe7\x46\x6c\x6a\xbb\x2f\x6a\x2c\x2a\xbf\xa9\x69\x49\x2a\xd2\x50\x14\xb3\x65\x07\xcd\x1a\x90\x45\x81\xf7\x06\xde\xdb\x94\xe4\x7c\xef\xee\x40\x5b\xd4\x5f\x70\x43\x88\xc3\x9b\x9b\xc6\x6e\xb3\xf8\x73\x42\x1a\x22\x24\x2d\x3e\xdc\xd4\x5f\x59\x4b\xfc\xd6\xff\x38\x78\x91\xb4\xd5\x0d\xac\x19\xe8\x5b\xfb\x71\x34\x75\x26\xf5\x65\x91\xdb\x8a\xc6\x8d\x70\xcf\xc1\x27\x3c\x53\x9d\xbe\xb7\x70\x6f\x77\xb9\xda\xb8\x15\x43\xc3\x62\x3e\xbc\xaa\x49\x79\x5c\x8a\xd2\x83\x9d\x30\x03\xa1\xab\x4b\xe3\xa7\x45\xd3\x9d\x2f\x86\xf4\x21\x17\x91\x7e\x89\x70\x06\x53\x72\x3f\x82\xdc\x1d\xb0\x16\xc9\xd8\xd1\x07\xb2\x6b\x72\x6e\x07\x7f\xfb\x8c\x9a\x8e\x11\x38\x60\xec\x71\x8c\x00\x44\x47\x33\x36\x75\x67\x2f\x7d\x3d\xd0\x2e\x4a\x47\x36\x91\x34\xc3\xbc\xa1\x0e\x72\x9c\x50\x36\x19\xa3\x40\x21\xcb\x78\xec\xca\xcc\xab\xd8\xa7\x73\x6d\xed\x80\xce\x41\x10\xd8\xe5\x6f\x91\xfb\xd7\x41\xba\x03\x66\x94\xb2\x8d\x1a\xc6\xd8\x24\xc3\xbd\xf4\xf5\x40\xdf\xa8\x6c\x2e\x9a\x71\xdd\xb0\xc8\x71\x42\x8d\x54\xb6\x21\x96\x59\xca\x06\x33\x13\x0d\x68\x96\xbe\x5f\xe9\x5c\x79\x9f\x75\x1d\x5e\x16\x22\x9d\xde\xdc\xea\xde\xed\xc1\x88\xe1\xca\xb5\xc9\xe8\x9c\x22\x08\xd6\xcb\xd0\x4b\x42\xbd\xdf\xfc\xf4\x07\x85\xec\x54\x27\xf8\xb3\x26\x23\x1f\x13\x42\x68\xb5\x52\x8a\x3b\x2d\x04\x72\xaa\xc6\x85\x6d\xcc\x99\x23\xe4\x74\x91\x85\xce\x91\x92\xa9\x17\xce\x78\x98\xcb\x17\xd9\xd2\x7a\x96\x03\xfa\x43\x80\xb6\x66\x3a\xab\xc1\xc2\x5d\x2c\x8b\x87\x20\xb4\x55\xdd\x00\x30\xd8\x9e\xd8\xd0\xda\x93\x71\x70\x5b\x06\xf7\x4c\x83\x8d\x7f\xeb\xb0\x6d\x2f\x5f\xad\x17\xfe\xdc\xc2\xd2\xf7\x7e\x36\x4e\x57\xad\x5b\x10\x8e\x1d\xd9\x70\x73\x2c\xdc\x3c\xc0\xe3\x91\x12\x1c\x01\xdb\x13\x8f\xbd\x21\x28\xda\xc7\x3f\xf8\xb0\x25\x34\x92\xc8\x17\x2c\x35\xb9\x03\xf4\xcb\x2a\xea\xa5\x90\x73\x39\x18\x1c\x8b\x25\x21\x9f\x72\x30\x58\x15\xd0\x45\x2b\xc5\x07\x2a\xb4\xce\xfa\x4c\xf5\x5e\xb3\x1d\xa6\x30\x43\x48\xbf\x53\x57\x3a\x6a\xb7\x36\x77\x72\xf2\x04\xf2\xde\x59\xe9\xb7\xc0\x11\x22\xd6\xe6\x31\x4b\x87\x5e\xd8\x91\x70\x8f\x64\xf0\x2d\x1e\xd3\x0b\x2c\x04\x5d\xc6\xf9\x14\xf5\x77\xcf\xa9\x08\xd4\xe9\x49\x54\xda\xc9\x0a\xe3\x80\x86\x55\x6b\xf6\xd8\x77\xba\x03\x3f\x7d\x72\xe3\xd9\x0c\x46\x81\x27\x8e\x49\x4c\xf4\x3f\x34\x42\x54\x91\xf3\x91\x67\x0a\x2f\xb8\xf0\x23\x2f\x02\x7b\x4e\x9e\xfc\xf7\x3b\xc8\x24\x65\xf1\x98\x9e\x0e\x97\x2e\x08\xad\x94\xc3\x6f\xc8\xae\xbe\x38\x1f\xfa\x64\x6f\x36\x4b\xb0\x56\x91\xf4\xa3\x6f\x86\xee\x29\x15\x95\xa0\x8f\xc4\x9c\x26\x3d\x67\x38\x6e\xcb\x88\xe6\x89\xa7\x39\x41\x6f\xd8\x61\xf2\x96\xd7\x1e\xff\x1f\x38\x12\xed\x80\x13\x56\xaa\x09\xd4\xae\x33\x94\xab\x5d\xd4\x09\x3d\x12\x69\x6e\x7a\x13\x68\x01\xee\x02\x8b\x8e\xab\x1c\xa3\x80\xfd\xe8\xc2\x81\x5a\xa8\xc4\x94\x5e\xe0\x6a\xd2\x89\xcf\x94\xbe\x05\xd5\x49\x4e\x7b\xaf\xd5\x5a\xb7\x3a\x11\x0c\x1f\x27\xe9\xa2\x78\xbd\x47\x47\x1c\x63\x40\x22\x91\x5d\xf8\x71\xfc\xa0\x82\xeb\x28\xe8\xee\x64\x90\xb5\xf2\x76\x8d\xdf\xd6\x2c\x17\x04\xd4\x32\x27\x9c\xae\xdb\x70\x7e\xde\xc1\xc3\x47\xd2\x3b\xe5\xc7\xcc\xb9\xdf\x79\xd0\xe6\x04\x61\x77\x69\x38\x2d\x65\x9a\x65\xc0\x32\x99\x15\xdd\x58\xa1\xec\x24\xc4\xe7\x2c\xbd\x80\xa3\xcf\x26\x00\x18\x9c\x55\xac\x8f\xc8\xae\x1c\x93\xd4\xa0\x73\x00\x7e\xdd\x90\xf8\x1b\x3e\x5b\xbb\x2a\x8d\x64\x96\x79\xda\xfe\x06\xe7\xb2\x16\xd7\x61\xa3\x70\xaf\x2d\xf8\x2d\x4c\xc0\x6d\x33\x7f\xf4\x84\xd7\x58\xe3\xb4\x9e\xf7\x1b\x84\xfe\x79\x31\x66\x4e\xfc\x06\x46\xe0\xbd\x0d\xc0\xef\x30\x48\x74\xd2\x37\x64\xe7\x8b\x83\x92\xec\xf5\x75\xbf\x24\x39\xbc\xcf\x62\xc0\x88\xf4\xa1\xf6\x82\x97\x11\x01\x35\x14\x7e\xda\xbe\xe7\xf3\x1d\x3f\xc7\x88\xa5\x90\xee\xf2\x24\x2f\xec\xa7\x10\xd9\xa9\xd7\xbe\xb5\xd0\xe0\x49\x4b\xc4\xd2\x0d\x9f\xce\x14\xa7\x71\x35\xfb\xa9\x4e\x62\xaa\xeb\x42\xe0\xe8\xca\xc2\x3a\xba\xd2\x96\xc8\x55\xa9\xff\xb2\x61\x37\x50\xb2\xee\x46\x8d\xaa\xaa\xe3\xaa\xc8\xb2\x76\x03\xc1\x52\xf3\xea\xe7\x5c\xf1\xe5\xdf\xc0\x6b\x04\x01\x3f\xc0\x12\x2d\x16\x13\xf9\xff\xd3\xd0\xf9\x46\x02\x0e\x6d\x0d\x97\x5d\x9a\x92\x14\xbf\x8e\x30\x53\x06\xaf\xb4\x0c\xf7\xc6\x29\xde\xd1\xc7\x65\x20\x35\xd6\xa5\x2d\xa6\xc2\x7f\x48\x4f\x65\x51\x35\x24\x6f\xb6\x5a\x6c\x58\x2b\x05\xef\x15\x6a\xbb\x06\x21\x9d\x0e\x76\x88\x03\xa2\xc1\x15\x3f\x3e\x0c\xda\x82\x43\xc6\x4d\x51\xba\x41\x78\x46\x7f\x14\x66\xe8\x41\xc4\x77\x23\x86\x6d\x8b\xd4\x0b\x61\x81\x7e\xa0\x99\xbc\xc8\xc7\x99\xc5\x9b\x5c\x0f\x41\xf9\xf2\x89\xbf\xe0\x5c\x54\x29\xcd\x1b\xbe\x99\xcc\x48\x9e\xd4\x31\x29\x69\xa7\x0e\xef\x49\x55\x14\x04\x2c\x2d\x77\x6b\xd2\x48\x9a\xd3\xca\xdf\x67\xe7\x34\x79\xb4\xd1\xba\x20\xf8\x1c\xd7\xea\xfb\xda\x82\x56\x6f\x4d\xd8\xfe\xcf\x40\x75\x00\x96\x47\x57\x5b\x01\x2f\xc6\x7d\x2f\x70\x8c\x8c\x99\x8f\x61\x1b\xa0\x61\x83\x5f\x2a\x50\x75\xb5\xf5\x00\x5e\x4f\x30\x4e\xc9\xeb\x77\xd2\x66\xc1\x30\x39\x03\x5d\x0d\x90\xd8\x82\x18\xf7\x0f\x4c\x66\x60\x9d\x5c\x94\xad\x81\x81\x29\x03\x45\xa8\xad\x25\x5a\x14\xbb\x8a\xe4\x89\x1e\x5a\xd0\x7d\xa4\x0a\x38\x2d\x44\xc0\xa9\xef\xd3\x3a\x7f\xea\x58\x47\xab\x2d\x3a\xba\x32\x77\x60\xca\x6c\xcc\xa2\x26\xe6\xc2\x61\x88\xe9\x8f\x9d\x46\x7a\x06\xae\x89\x0d\xc0\xd5\xdd\x04\xbb\xd8\x53\x4b\xd1\x24\xcf\x91\x3a\x6e\x1b\x30\x5d\x51\xfc\x5a\xcb\x8f\x3e\xda\x8a\xe6\xa1\xfb\x53\xf3\xe4\xa6\xac\xd8\x97\x9c\xdb\x2f\x28\xdd\x9a\xd5\xde\x18\x92\x7d\x59\xd3\xa8\xf6\xa6\x69\x5c\xe4\x7e\xbb\xc0\xc1\xee\x7a\x47\x51\xf7\x0a\xa1\xfd\x59\x2b\xb4\x7a\xeb\xd0\x7d\xee\x10\x9b\x4f\x68\x0f\xcf\x2d\x21\x0b\x63\xdd\xa9\xfa\xc9\xc9\x93\x40\xb8\x59\x4d\x5b\xbd\xf5\x55\xa4\x54\x54\x8b\x60\xab\xfe\xe2\x43\x60\xbf\x31\x1c\xc2\x0f\xe4\x11\x74\x4f\x92\xb6\x95\x4e\x9b\x8a\x42\xc2\xc5\xad\xd2\x1c\x6e\xa8\xec\x13\x5c\x6c\xd5\x62\xbc\x0e\xd2\xaf\x0a\x5c\xe8\xb7\xaf\x6d\x5c\x14\x7a\xf0\x92\xd8\x64\xa8\x85\xc1\xc8\x8d\xb4\x11\xec\xdc\x6f\x04\x78\xde\xd3\xde\x69\x43\x7a\x9b\x01\xdb\xd2\x0f\x6b\x85\x83\xb5\x7c\x2c\xc3\xea\xd6\x6a\x94\x15\x7b\xd5\x26\x8a\x88\xf3\x83\x20\x88\x5b\xd9\x16\x88\xb2\x19\xb6\x66\x5f\x54\xa7\x8b\x15\xec\xef\x35\x26\xbe\xc3\x9a\x58\x16\x6d\x78\xd5\xef\xda\x9c\xbe\x79\x67\x30\x79\xbf\x6d\x43\x2f\xaa\x11\xce\xb9\xa8\x4e\xef\xf6\x08\x97\x8d\xf3\x8d\x8f\x70\x39\x11\xf6\x3f\xc2\x65\x34\xbb\xf7\x11\x2e\x17\x12\x78\xd4\xc5\x0d\x87\x9c\x0a\x19\x07\x0c\x1f\xe1\x72\xb5\xea\x79\x84\xcb\x68\x72\xd7\x23\x5c\x26\x06\xf5\xf6\x92\x51\xfc\xce\x8f\x70\xa1\x5d\xca\x47\xb8\xec\x8e\x1d\x8f\x70\xe1\x58\xf0\x13\x1f\x08\xd2\x3b\x1e\xe1\x32\xb0\xdc\xf0\x08\xd7\xa0\x0b\xb5\x66\xa7\x15\x05\xc5\xe6\x08\x3c\x1f\x6e\x47\x20\x47\x99\x05\x3d\x84\xa0\x5b\xed\xc0\xde\xd3\xf7\xed\x72\x6e\x77\xcf\xd0\x69\xb8\x63\x63\xc1\x1b\x02\x63\xf8\xde\xb8\xb7\x4b\xb8\x8f\xf8\xfd\x6e\x76\xc8\xb5\x79\x77\x5e\x01\xf8\xbe\xce\xd1\xe9\xc0\x53\x91\x44\x49\x6b\x13\xda\x11\xbf\x30\x40\x5a\xbd\xd4\x46\xab\xb9\xdd\xca\x58\x40\xd3\x97\xc6\x80\x47\x9e\x88\xec\xdf\x9f\xeb\x88\xec\xcf\xbb\xf6\x3e\x41\x05\xfc\x46\x68\x33\xbb\xb5\xdd\x21\x45\x22\x4e\x3c\xa7\x80\xb6\x81\xd1\x22\x58\xf6\xea\xc2\x6c\xf6\xbf\x4d\x24\xe6\x5c\xe8\x94\x19\x79\xe5\x45\x46\x94\x1f\xda\x7f\x30\xdd\xe4\xaa\xfd\x07\x5b\x83\x5d\x1a\x38\x96\xe0\x04\x04\x4b\x44\x1c\xc6\xfc\x9e\xcc\x3e\xdd\x0f\x9f\x43\xc0\xd0\x31\x19\x8e\x20\x4d\xad\x09\x6f\x80\x1d\x18\x09\x38\xf4\xa1\x25\x37\xb9\x6b\x24\x2d\x3a\xf3\xb3\xc2\x20\xd4\x08\x02\x7b\x3e\xe1\x23\x47\x28\xfa\xf5\x80\xe1\xd3\x73\x91\x8c\x83\x1b\x43\xa5\xeb\x1c\x0b\x3b\x01\x7a\x97\x5e\x18\xc7\xca\x64\xa2\x42\x71\x22\xc2\xdd\x60\x80\x56\x01\xe4\x4c\x9f\x38\x88\x5f\xdb\xd7\xdb\xcd\x1f\x1e\x1e\x9c\xcd\xad\x38\x2a\x04\x60\x5e\xf4\xa6\x69\xcd\x18\xaf\x9d\xec\x19\x80\x19\x23\x46\xe3\x1c\xd0\x18\x55\x1b\x5c\x95\x20\xdd\xf4\x6c\x6a\xc7\x4d\xee\xd1\xdb\xdb\xdb\xda\xbe\x9b\x19\xc0\xfb\x18\x65\x1b\x06\x9a\xde\x3b\xbe\x77\xb6\x22\x8e\x4e\xc6\x99\x96\xc1\xc6\x77\x0f\xf2\x6e\x23\xe4\x1c\x2b\xbb\x34\x3e\x42\x29\xed\x14\x99\xec\x4d\x30\x08\xad\xee\xa1\xf7\xa1\x84\x19\x44\x9d\xf5\xe3\xfb\xb3\xf3\x84\x8e\x80\x74\xe7\x0d\x1d\xa0\xf8\xa6\x26\x50\x50\x6a\x10\x69\xfe\x44\xab\x9a\x22\x66\x36\x8a\x60\x6a\xf4\xe0\xa1\xfd\x07\x9b\xe2\xeb\x9f\x75\xd2\xfe\xeb\x87\x05\x5c\xc2\x61\x86\x0f\xc9\x60\xe6\x02\xe2\xd2\xd7\x3f\x03\xa4\xc1\x25\xd0\x48\xf0\x81\xc1\xe0\xab\xa0\xbb\xc7\x83\xaf\x82\x7a\xa1\x46\x10\x78\xd3\xd1\xa4\x01\x6d\x70\xad\x82\x06\xe0\xc6\x50\xe9\x32\x40\xf3\xf9\xfc\x4e\xed\xc0\x56\x41\xfa\x54\xc7\x1b\x0c\xd0\x3a\xb0\x0a\x1a\xc6\xdf\xbb\x0a\x62\x49\xa3\x1d\xcd\xad\x55\x10\x04\x40\x56\x41\x61\xd0\xfe\xeb\x17\x27\x58\x05\xf5\xc0\x8c\x11\x23\xb6\x0a\xea\x55\xb5\xc1\x55\x10\xd2\x8d\xcb\x87\x81\x8c\x73\x37\x19\xba\x9e\x6f\x14\xe2\x78\xf6\x3d\xb3\x65\x78\xbd\x36\x6c\x89\x46\x2f\xd9\x6e\x6b\xfb\x6e\x36\x6b\xec\x92\xed\xf6\xa6\xf7\x8e\xef\x9d\x4d\xde\xf8\x25\xdb\x3d\x8d\xef\x1e\xe4\xdd\x16\xd3\x39\x56\x7d\x7d\x35\xa0\x97\xf6\x02\x04\xb5\x5d\x70\xd5\xe6\xc2\xea\x58\xb8\xd9\xf5\xe3\xbb\x74\x2e\xdc\xfa\x20\x07\x17\x6e\x2e\x8a\x6f\x6a\x02\xc5\x75\x9d\xee\x2a\x4a\x92\xb8\x3a\x9f\x76\xea\x2b\xdc\x03\xf8\x08\xa7\x1f\x1b\x1c\x91\x3b\x98\xe5\x79\x45\x1f\x98\x57\x5d\xe9\x87\x6c\xcd\x0f\x3b\x06\xcc\xe7\x2c\xdd\xec\xe8\xbe\xa8\xd4\x21\x2d\x9e\x69\x69\xab\x6d\x11\x54\x2e\xcb\x2f\x7f\x0b\x02\x12\x7c\x30\x50\xc8\x33\x99\xfa\xca\xbd\x24\x87\x34\x67\x07\x31\xf0\xcf\x1a\xe8\xcd\x27\x36\x78\x0f\x3d\x8c\xdb\xe1\xb3\x47\x05\x6a\xdb\xf9\x6a\x16\xd4\x25\xe9\xcf\xea\xb8\xb5\x2e\x04\xc3\xfb\x5a\x83\x69\x7f\x59\x9a\xdb\xb1\x49\x7a\x5d\xf7\x47\xc0\x48\x8c\x0b\x6b\x70\x54\x46\x25\x1b\x21\xf6\xb9\xa0\x2f\xb6\xee\x4a\x2c\x64\x76\xa3\xdd\x6c\xb3\x48\xd0\xea\x18\x05\x77\x06\xed\xed\x5e\x3b\xb3\x09\x8b\x45\x1e\x4c\x5b\xc0\x78\x03\x56\x03\xae\x0e\x6e\xcd\x97\x26\xc6\xdc\xe9\x82\xb2\xd1\xbc\x1f\x56\x8a\xd0\x02\x7c\x16\x5a\xd7\x12\xeb\xac\xe8\xc1\x89\x0c\x52\x69\x66\xab\x6b\x83\x17\x9a\xd0\xc7\x74\xe5\x35\x06\xbd\x43\xdd\x27\xe2\xe5\x18\x99\xd0\x93\x39\x6a\xed\xe1\x1b\x55\xbd\x98\x35\x16\x68\xd7\x04\xc7\xdc\x08\x44\x5e\x3a\x07\xd2\xf6\xb3\x83\x65\x56\x44\x19\xb7\x2c\x77\xa5\x8b\xb1\x7b\x70\x4f\x77\xa4\x1e\xce\x37\x7d\x16\x2f\x7b\xe7\xf8\xd2\x9c\x6d\x02\xb7\x6b\x9a\xdb\xd5\xbd\x33\xdd\xee\x1a\x56\x1b\x7d\xd7\x27\x9b\xb3\xbc\xcc\xe0\xec\xc8\xa4\x28\x36\xea\x1e\x96\xda\xf5\x7d\x2c\x9d\xf5\xb2\x74\x86\x0d\xcb\xcd\x52\xab\xba\x97\xa5\x76\xd7\xb0\x9a\xf5\x4d\x2b\xfc\x3a\xb1\x74\xaa\x76\xaa\x0a\xeb\x7a\x31\xc3\xe2\xe1\xfe\x95\x55\x88\x71\xf0\xdf\x8c\xe8\x3e\xe7\xce\xc5\x36\xc7\x4e\x20\x0e\x5f\xa1\x94\xe7\xfd\x16\x6a\x78\x1e\x70\x0b\xb2\xe4\xf6\x7b\xc9\xbc\xf1\x34\xa7\x2f\x4d\x37\x22\xfe\x27\x1b\x94\xf6\xfd\x52\x01\x97\x15\x7d\x4a\x8b\x73\xad\x35\x50\x45\x5a\x23\x7e\x58\x4b\x00\x00\x6b\x69\x16\x99\x23\xc1\x6d\xa4\x51\xc1\x7a\xb9\xc3\xb8\x5d\xa7\xfc\x7c\x87\x29\x2a\x25\xa4\x69\x44\x4f\xde\x74\xd9\xfe\xcf\x8c\x9e\xb4\x19\xb6\x5a\x7c\x6f\x24\x42\x59\xb9\x12\xa1\xa8\xe7\x00\x0c\xed\x1a\xce\xcf\xb2\x23\x35\xe5\x2f\x3a\x19\x22\x9f\x46\x0b\x7a\xba\x12\x4e\xb5\xe0\x92\xfc\x6b\xdc\x33\x04\x82\x33\x22\xbf\x9a\x18\xff\x86\x9e\xca\xe6\x15\x5c\x2d\x62\xb9\x4c\xc5\xf1\x17\x6b\x7d\x28\x6f\x0d\x09\x04\x3d\x5f\x9e\xd9\x92\xd7\x00\xfa\xf9\x58\xd1\xbd\xda\x99\x60\x55\xce\xa7\x5d\xd9\x17\x63\x89\x4e\xbc\x62\xef\x7c\x00\x1f\xc0\x61\xdd\x9a\x55\xae\x6e\xa3\x87\x65\xb0\x0e\x24\x3a\xe4\x05\x70\x49\x1e\x7b\x97\x1a\xc0\x61\xdd\x9a\x55\xae\x6e\xf9\xcb\xf0\x12\x1d\x7c\x05\x58\xf6\xc9\x5e\xae\xd5\x81\xb0\x0e\xb5\x72\x67\xf8\x8e\xbd\xf9\x2c\x11\x21\x6f\x7d\x4a\xcd\x62\x2f\x50\x02\x38\xac\x4f\xb3\xca\x99\x23\x80\xbd\x01\xab\x34\xc4\x7a\xcb\x4f\x2e\x3b\xd8\x5b\x73\x26\x18\xaa\x47\x7a\x8d\xab\x4f\xfe\xaa\xe3\x55\xbc\x66\x87\x1f\x2d\xeb\x5e\xbb\xd1\x8f\xe6\xcf\xca\x17\x6f\x65\xfb\xda\xdf\xd4\x14\xc8\xc7\x88\xb0\x99\x05\x3d\x02\x3b\x65\xc3\x86\xe5\x9e\xd2\x7c\xd4\x7d\x53\xda\x7a\xcc\x4c\x34\x92\x6f\x9a\x49\x1c\xc6\x49\x2d\xf9\xc0\xd9\x95\x08\x02\xa4\x75\xe2\x7f\xdd\x69\x9d\x5a\x37\xcc\x49\x49\x1b\x7a\x92\x8b\x7a\x49\x4e\x77\x0d\x59\x6d\x21\x1e\xcd\x37\x0a\xe5\xea\xdd\x61\xfc\x4d\xf4\xb2\xad\xe1\xe3\x70\x98\xcf\x02\xd4\x38\x89\xb3\x80\xd7\xa9\x3b\x72\xf4\xfd\x27\x5b\x95\xfc\x72\x3e\xed\x8a\xa6\xea\x12\x6e\xb1\x33\x4d\x33\xe4\x68\xfc\xcc\x3e\x4d\xc5\x8a\xf8\x40\xd2\xfc\x48\xab\x14\xdb\xb8\x30\x77\xae\xba\xf1\xa6\xc7\x70\xa2\xfd\x79\x0c\x2f\x06\x02\x1d\x14\x9e\x01\x04\x77\x51\xa2\x10\xa8\x7c\x14\x04\x5a\xf3\xc7\x63\xa5\xaf\xd7\xe4\x04\x5e\xb4\xff\xae\xfa\x9d\x11\xd5\xc2\xba\x17\xe5\x21\xec\xe9\xbd\x90\x89\x24\xe4\xd2\x46\xae\xb0\x5f\x40\x72\x6a\x11\x80\xaf\xe3\x8a\xd2\x9c\x5f\x76\xb3\x0f\x78\xe1\x92\x9a\x3f\xd8\x92\x9a\xb3\xa3\x71\x6f\x1c\xa1\xfe\xb8\x16\x1f\xe1\x32\x00\xe3\xb1\x24\xd9\xc9\x66\x39\x63\x47\xdb\x9b\xe3\xf9\xb4\xcb\x49\x9a\x39\x9e\x9e\xb1\x8f\xda\x45\xf0\x02\x86\x9e\xb5\xe4\xee\x25\xaa\xfe\x7a\x96\xf6\x88\x1f\x07\xf2\xa6\x51\xed\x51\x52\x53\x3f\xcd\xfd\xe2\xdc\x80\x87\x00\x1d\x40\x83\x10\xda\xe8\x3d\x96\x0d\x66\xd2\x15\x88\xe4\x30\xda\x9c\xd5\xef\x81\x74\x57\xf5\x49\xd7\x44\xbe\x23\xa2\x15\x29\xd3\xd6\x95\x60\x29\x59\xe4\x2a\xa4\x23\x67\x1a\x93\x92\xc5\xfd\xb4\xab\x4b\x5b\xfd\xf3\x19\xc9\x68\xd5\x5c\xf4\x8b\x60\xb7\xde\x9c\xc6\x02\x84\x0c\xab\x77\x9c\x9b\xc7\x5a\xc1\xf4\xe7\x40\xfc\x3f\xf6\xc3\xad\xab\x76\x8a\xb3\xba\xc7\x72\x22\x7e\x9c\xad\x73\xde\x0a\xe4\x73\x09\x1f\x9f\x14\x88\x93\xb4\x3e\xa5\x35\x5b\xb5\x4f\xcc\xa2\x74\x67\x3d\x1c\x30\xc3\x1b\x7a\xd3\x38\x2b\x6a\xac\xbd\xa8\x71\x39\xb7\xd6\x55\x8b\x23\x96\xcc\x86\x61\x1c\x50\xcb\x3b\x29\x96\x78\xb5\x9c\x61\xbb\x87\x64\xbf\x0f\x12\x78\xa2\x32\x59\xd2\x75\xbc\x04\xa8\x3c\xd4\x20\xc6\x6b\x1a\xed\x66\x10\x54\xe7\xbf\x5c\x7d\xee\x16\xf3\x76\xb5\xc2\x6b\xd8\x32\x50\x2d\xd9\x56\xc1\x03\xfe\xbe\x37\x4d\xf6\x30\x66\xb5\x8b\xe9\xc3\x3e\xd4\xf1\xe0\x84\x91\x25\x0d\xa9\xd1\x1f\x4a\xd5\x7c\x11\x2d\xd7\x12\x0a\x3c\xf7\xff\x40\x96\xc9\x6c\x87\xd9\x8d\x78\xff\x40\x67\x80\xb0\x3d\xa1\xbb\x38\x06\xa8\x70\xda\xf6\x2b\x1a\xee\x16\x10\x14\x21\x6f\xb9\x5c\x84\x1d\xd3\xcc\xa7\xbe\xc9\x7a\x3e\x9f\x47\x18\x75\x51\x42\x13\xeb\x4d\xf6\x5d\x1c\x27\xa1\x89\x09\x27\x8e\xce\x77\xeb\x38\x00\x90\x08\x6d\x0f\xf3\xd9\x62\x36\xbf\xfe\x51\x1a\xc6\x6f\xf4\x75\x5f\x91\x13\xad\xbd\xb2\x2a\x0e\x15\xad\x6b\x7f\xc7\xae\xd8\x56\x69\x49\xeb\xcb\xbe\x2a\x4e\xfa\x22\x56\x29\xf7\x9c\x05\x2f\xae\x4d\x81\xd6\x06\x5e\x70\xbd\xfe\xd1\x2f\x7e\x53\xf4\xbf\x21\xee\xa9\xc4\x78\xd1\x6e\xab\x61\xd6\x70\x38\x45\x98\xeb\x83\xd0\xd0\xc5\x2a\xeb\xd9\x46\xe7\xbd\x29\x1b\xb2\xa3\x9f\x9d\x77\xb0\x52\x7f\xab\xc7\x48\x59\x62\xab\x9e\xd8\x5d\xd4\xad\xf5\x1c\x5b\x08\x67\xcc\xba\x67\x78\xbe\x76\xa1\xcb\x78\xfb\x72\x2c\x24\xe2\xd5\xd9\xc0\xbc\xe9\x92\x7b\x63\xe0\xcd\x41\xa5\xb3\xc6\xe4\x9b\xd0\xa4\x64\xd2\x95\x8a\x12\xcf\xe4\xaf\x75\xdb\x50\xd2\xc7\x9f\x08\xf6\xd5\x63\x81\xf3\x45\x42\x0f\x13\xe4\x22\xdb\xe2\x93\x17\x2d\xbe\x9f\x68\xae\xd4\xfa\x7b\x11\x7c\xef\x68\xe9\xae\x59\x01\x1c\xe0\xef\x4f\xf6\x25\x63\xbf\xf8\x7f\x21\xd1\xff\xf4\x14\x23\x8f\xc9\xb2\xf9\xc6\x2c\xd1\x3c\x30\x03\xb1\x66\x8d\xa9\x92\xea\x5d\x39\x59\x28\x0a\x80\x42\xca\xfe\x48\x9e\x9e\xf8\x06\x17\xb3\x90\x5e\x24\x1f\xb1\xf6\xd2\x7c\x9f\xe6\x69\xc3\xe6\xcd\xed\x8d\x6e\x6e\x01\xe7\xd9\x60\x50\xab\x7f\x02\x62\x08\xfe\x35\x11\xff\x35\x11\x2d\x8a\x81\xde\x0d\x44\x35\x07\x94\x0e\xb6\xfe\x97\xc6\xfd\x4b\xe3\x86\x34\x6e\x38\xb2\x3d\xa0\x74\x08\x82\x7f\xe9\xdd\xbf\xf4\x6e\x48\xef\x06\x3f\x6d\x0c\xa8\x9d\xdd\xfe\x5f\x5a\xf7\x2f\xad\x43\xb4\x8e\xc5\xb4\xe1\x45\x69\x51\x8c\xbd\x8c\x2b\x9e\x99\x60\xf5\x13\xfe\x1f\x7f\x57\x24\xaf\x17\xb8\xb1\xfe\xb5\x28\x4e\x9b\xf0\xaa\x83\xa8\x58\x3a\xcb\xdb\x26\x6a\x8a\xdd\x2f\x34\x6e\x60\x1a\x4a\xbd\x6e\x9a\x9e\x0e\x7e\x17\xa3\x86\xd9\xba\x39\x28\x8b\xd4\x09\x82\x1e\xb5\x27\xc9\xcd\xa3\x1c\xa1\xd6\x71\x5b\x60\x36\x60\xb7\xb2\xc1\x17\x04\xad\x41\x3b\x86\x89\xdd\x58\xf4\x83\x24\x61\x05\x9f\xe6\x9a\xa2\x94\xa8\xf8\x57\xba\x8b\x23\xeb\x84\xec\x8e\xa5\x0e\x83\x9f\xfa\x59\xa9\x84\x39\x52\xd2\x12\x7b\xe9\xc9\x6d\xb1\xd0\x46\x9c\xd6\x0d\x3c\xda\x62\x1d\x5d\xe9\xbe\x60\xf5\x27\xd5\xe7\xe9\x80\xc0\xf7\xae\xdb\xd3\xe4\x23\xe9\xd2\x6f\x3b\xe7\x09\x28\x18\xf3\xc6\xe0\x40\x56\x04\x1b\xa7\x3b\x73\xc5\xdb\x5e\x9e\x24\xb0\xa7\x09\x7f\x19\xcf\x62\x6b\x77\x95\xd2\x6e\xe3\xc1\x02\xa9\x15\x0e\x64\x4e\x78\xfd\x96\x9f\xd5\x4b\x77\x7c\x04\x94\xf3\x33\x36\x78\x57\xa2\x91\xa3\xd2\xba\x25\x3a\xf6\xd0\x2f\x0b\xd0\x5d\x1d\xac\xd2\x5f\x80\xec\x82\x5f\xfc\x34\x11\xfc\x40\x2c\x4f\x04\x4d\x9c\x35\xea\xbc\x80\xab\xfe\xbe\x93\x92\xec\x6b\xab\x0b\xa7\x5b\xa0\x03\x64\xde\xd3\x90\xd1\x3f\xa4\x12\xea\xa3\xc7\x78\x92\x5b\xde\xdf\x4e\xef\x40\x2b\x07\xb1\x56\x7a\x07\xfc\x20\x80\x8d\x98\x97\xbb\x64\x2c\x6a\x1d\x67\x9e\xfb\x32\x96\x3b\xce\xff\xe2\x1d\xdc\x20\xb5\x81\x06\x8f\xd3\xfa\x44\xb2\xec\xf6\x76\xbd\xcd\x6e\xd6\xad\x51\xcd\x06\x68\x1d\x6a\xdd\xdf\xb8\x5f\xa9\xef\x6c\x36\x44\xf1\x40\x6b\xd6\x78\x68\x3e\xb9\x04\x85\xcf\x8b\x7e\x56\xf5\xb6\x19\x31\x93\xe2\x55\x92\x50\xfb\xd8\xcb\x8d\x9f\x3c\x6d\x4f\x22\x11\x38\xdc\x02\x8e\xdf\x8d\xe6\x56\xf7\x37\xd8\x0e\xc8\xc8\xd9\xb1\xcb\x23\xaa\xfa\x3e\xcf\x08\x90\x0c\x00\x99\x97\xf0\x9d\x0c\x0f\xd8\xe7\x64\x27\x41\xdd\xd9\x84\x7e\x80\xa1\x81\x19\x3a\x34\x40\xba\xec\x73\x14\xd4\x28\x66\x98\x66\xb9\xdf\x18\x0b\x4e\x99\xc6\x98\xeb\x93\x85\xfe\x86\x4f\xe5\x08\x8b\xdb\xd6\x2e\xc2\x6d\xcc\x0e\x04\x37\xab\x72\x6f\xa3\x41\x3d\x6e\x5b\x3b\x65\xcd\x2a\x7b\x25\xac\x35\xef\x83\x30\x75\xd7\xc5\xd8\x78\x4e\x67\x7b\x64\xd9\xc9\x70\xb8\x15\x57\xab\xed\x1d\xc9\x28\x95\xd5\xbb\x1a\x06\x19\x1e\xfa\x2d\x9a\x2a\xf8\x62\x6a\x2a\xd7\x15\x0b\xf7\x6d\xc7\x27\x10\x9e\x0a\x04\x2e\xda\x51\xfc\x6e\x34\x37\x6b\xed\x50\xbb\x41\xc5\x15\x08\x9c\x12\x97\xf5\xbd\xd2\x36\x91\x0c\x00\x19\x22\x74\x33\x9c\xec\xa3\x38\x76\x13\xec\xd6\x63\x13\x60\x68\x60\xa3\xb4\x19\xf4\x39\x0a\x6a\x14\x33\x6e\x50\x6b\xc9\x29\x43\xad\x85\x3e\x59\xe8\x6f\x3a\x76\x83\x30\x99\xb7\x77\x11\x8f\x61\x77\x22\xb9\x59\xa5\x07\x9a\x0d\x6a\x34\x6f\xef\x94\xbb\xa8\xee\x95\xb7\x81\xa2\x1f\xc6\x90\x9c\x93\xd1\x74\x17\xc7\xa8\x36\x73\x2c\x6e\x65\x36\xea\x07\xc6\x34\x4a\x95\xcd\x0e\xc7\x00\x8d\x61\xc3\x0d\x7a\x2c\x79\x64\xe8\xb1\xd0\x21\xa7\xcc\x87\x62\x72\xe8\xda\xdb\x7e\xb8\xd2\xb8\xf0\x78\x9d\x96\x24\xb7\x32\xe6\x46\xc1\xf8\xa0\x59\xef\xe1\x4f\xec\x0c\x10\x3f\xb2\x14\x82\x23\x4b\x81\x79\xfc\xc7\x09\x24\x08\xe6\xf1\x5f\xfd\xa4\xaa\xac\x90\xdc\xb2\x03\x83\x63\x72\x5d\x0f\xdf\x31\xc4\x2e\x01\x82\xbe\xd1\x67\xb9\x44\x52\x1c\xb0\x5f\xe3\xed\x9a\xb4\xc9\x68\x9f\x7c\x03\xfd\x88\xd6\xd2\x3e\x3b\xaa\xa1\x51\xbb\x4a\xbb\x8c\x5f\x76\xeb\x4a\xc1\x9f\x48\x3b\xd1\x0c\xa7\x7a\x5f\x14\x4d\x77\xb5\x51\x67\xf4\xc0\x11\x38\x33\x1d\x39\xf2\x3a\xe3\xc0\x25\x4b\xe4\x7e\x67\x4e\xb3\x47\x6d\x02\x4c\x64\x11\xa7\x54\x26\xf0\xd1\x41\xec\xc3\xc3\x16\x16\xcb\xfc\x8e\x40\x6b\xb5\xb9\xd8\x2f\x72\x6c\xad\xd7\xd5\xad\xae\xf5\xa8\xb3\x85\xd2\x7c\x5a\x7b\x90\xa4\xd1\xb8\x2e\xc6\x33\x4a\x6f\x9a\x0a\x46\xff\x5d\xb4\xdb\xee\x5e\x7f\x79\x7a\x78\x24\xe3\x30\xc1\xa7\xe6\xde\x47\xbb\x8c\x29\xfe\xf9\x16\x15\x18\xf3\x09\xa1\x37\x9d\x33\xe8\xfa\x8e\xae\xc4\xa9\x4f\xdd\x49\x7c\x36\xa7\x31\x06\xec\x10\x48\xc3\xcf\xcf\x8b\x5a\xe4\x2f\xbf\xa2\x75\x59\xe4\x35\xbb\x2c\xc5\x4a\x9c\xb3\x0d\xc5\xed\x89\x8b\x0a\x26\x56\xbc\xd4\xee\xcb\x03\xd7\x1c\x7a\xef\xee\x5c\x5d\xc8\xcc\x27\xa9\x59\x2d\x3a\xef\xac\x9a\x11\xf7\xd4\x7b\x27\xce\x8d\x84\x3c\x36\xad\x2b\x34\x4b\x2a\x37\xa5\xb7\x20\x6e\x15\x6e\x34\xe2\x37\xd0\x74\x53\xaf\xff\x6c\xec\xf5\x9a\xe4\x7d\xb8\x3d\xd8\xcf\xf1\x77\x91\xea\xbb\x8d\x67\xb0\x9f\x9e\xf1\xbc\x9f\x04\xde\x8f\xc7\xef\xc7\xc5\xb7\xf0\xa9\x47\xfd\x7f\x53\x15\x47\xfc\xf4\x6f\xa1\xe1\xef\xd1\xcd\x08\xd1\xfc\x2e\xdd\xb8\x47\xf3\x6e\xdc\x7f\x37\xfe\xbe\x1b\x07\xdf\xc0\xa3\xbe\x34\x2b\x6e\xdd\xd6\x72\xb5\x08\x0a\x9c\xa4\xb9\x56\x88\xf7\xae\x08\x6f\xa2\x45\x88\x47\x2f\xa8\x46\xe9\x61\x3f\xd2\x76\xf5\x36\x12\xe9\xbd\xc4\x8c\xef\xef\x9f\x8f\xad\xa3\xbd\xd9\x9b\xfa\x18\xe9\x99\xdf\x20\xc9\x77\x1a\xc7\x40\x1f\x7d\xde\xef\x7d\x58\xfe\x4e\x5c\x7d\x27\xc6\xdd\xcd\x9b\xcb\x3f\x46\x93\xdf\x6e\x2e\x06\x59\xfe\x1b\x5b\xa4\xf7\x19\xc5\xa0\xa8\xde\x6e\x02\x7b\x7d\xdc\xbb\x70\xf4\x7d\x98\x76\x2f\x5f\x06\x4c\x35\xd8\x9f\xb7\x83\xfc\x0c\x76\xfa\x56\x8d\x26\x3f\x93\xc6\xcf\x1a\xac\x53\xe4\x3a\xd0\xc5\x19\x28\x34\xa7\xd6\x98\xb5\xd3\xe4\xd6\x16\x47\x23\x0c\x06\xe6\x32\xaf\xa2\x6e\xcd\x85\x80\x17\xf9\x36\x98\x03\x11\x27\xe8\xb1\xa9\x1e\x7b\x2d\x3c\x02\xde\xe7\x74\x3a\xf0\x56\x25\x6e\xc0\xae\xc0\xc7\x61\x6f\xd7\x77\x37\x60\x57\xe0\xe3\x1c\xe6\x9d\xbc\x1a\x85\xe0\x5e\x0a\x46\xf1\x73\x14\x82\x7b\x29\x18\xc5\xf3\x51\x08\x50\x97\x26\x5f\xfc\x1b\xd6\x56\xa7\x1d\x47\xd9\x3d\x02\x5a\xe3\xed\x2d\xd0\xa3\x70\x6b\x5c\xbb\x05\x7a\x94\x43\xbc\x8f\x4b\x23\xd5\xf4\xae\xf6\x63\x38\x39\x52\x49\xef\x6a\x3f\x86\xdb\x23\x55\xd4\x76\x59\xf2\xdd\xb9\x01\xa5\x33\x8d\xfc\xa0\x8e\x82\x8d\xeb\x90\x6a\x8c\xc5\x8e\x82\x43\xec\x23\x54\xa1\xbf\xbf\x9b\x11\xdc\x42\xc1\xa8\x11\xdf\x8c\xe0\x08\xbf\xd2\x0c\xca\x53\x5f\xd8\x0c\x8b\x53\x87\x76\x4b\x53\x28\xfa\x48\xdc\x18\xf4\x3d\xb2\xec\xeb\xed\xd6\xf6\xb7\xf4\x3f\x66\xb4\xb7\xb6\x1f\x92\x63\x87\xcf\x71\xd5\xa5\xfb\xc0\x85\x7d\x97\xe5\xb7\x82\xb4\x7a\x8f\xff\xe1\xba\x37\x63\xe6\x2d\x87\xad\x3e\x9b\x8d\x55\x76\x28\x1b\x52\x9d\x23\xc0\x47\x87\xc2\xf6\x7d\x14\x9c\xdc\xd4\xee\x86\xa5\xb0\x89\xd1\xfa\x98\xe7\xa0\x98\xc3\xc1\x8e\x3d\xa4\x63\xeb\xb8\x84\xde\xb7\xca\xbb\x6a\xa6\xa1\xb2\x00\xc0\xd7\xd3\xde\xe7\xea\xc0\x61\x81\xb1\x38\x47\x32\x71\x34\x3e\xcf\xcc\xe4\x28\x89\xc2\xdf\x1d\x42\x11\xe1\x3c\xc6\xe8\x12\x9b\x30\x9b\x34\x95\x63\x16\xbd\xfe\x61\xc0\x38\x58\x7c\xc7\xad\x92\x3e\xb4\x37\x72\x79\x0c\x4a\xef\x96\x94\x99\x28\xa2\x7b\x19\x6d\x52\xa7\x52\x88\xa0\x29\xd5\x0c\x18\x97\x3a\xdf\x95\xac\xad\x0f\xf3\xad\x4a\x3d\x02\x25\x60\xb7\x24\xcd\x71\xd6\xdd\x81\xeb\x6e\xd5\x36\x08\xe4\xf9\x33\xd0\x2c\x71\x1d\x80\x8b\xd7\x77\x25\x9f\x73\xa2\xbd\x91\xd1\x83\xf8\x20\x97\x05\x51\x8e\x73\xda\x18\xa2\x7b\x59\x6c\x92\xa6\xb2\x45\xa0\x29\xef\x0c\x18\x07\xa3\xef\x4b\xa6\xd7\x87\xf9\x46\x5e\x8f\x41\x09\x8d\xb5\x20\xcd\x71\x7e\xd8\x81\xeb\x5e\x8e\x9b\x04\xca\x44\x09\x68\x16\x3f\x1d\xc4\xc1\xef\xfb\xd2\x03\xf6\x20\xbe\x91\xdd\x23\x30\x42\x6e\x0b\xc2\x1c\xa7\x5c\x71\x54\xf7\x32\x5b\x92\x47\x4f\x3b\x9a\xe8\x8b\xcb\xa1\x8b\xe2\xe2\xcc\x6b\x97\x30\x3a\x80\xa9\xfb\x6c\xa4\x9e\x55\x22\x8e\x01\x5a\x80\xac\x00\x29\x4f\x59\x7e\x42\xa4\x82\x67\x22\x40\x2a\x9e\xd2\x84\x16\xdd\x68\xc8\xae\x2e\xb2\x73\xc3\x53\x88\xb6\xab\x5c\x79\x8e\x97\x5f\xa1\xd7\x2e\x27\xeb\x09\xfd\xba\x95\xb5\x35\x80\x70\xb9\x7b\x5d\x5f\x40\xea\xe0\xc5\x72\x1a\x2d\xbe\x47\xa0\xe7\xbb\xd7\x19\x04\x5e\xb5\x90\xcf\x34\xcb\x2e\xa7\x34\x37\xf2\x04\xaa\xa3\xa0\x6b\x47\xe6\xd8\xfe\xb5\x9e\xbe\xc8\xa4\xb3\xf6\xdf\xed\x49\x13\x07\xce\x15\x0f\x80\xf2\x71\x79\x4c\x63\xfe\x7e\x2e\x1a\xe4\x29\x6f\x73\x02\x82\xbc\x84\xbc\xbd\x9f\x75\xc7\x8f\xa3\x39\x9e\x1f\x9a\xc1\xd5\x27\x23\x15\xaf\x09\xc6\xa2\xf0\x3c\xa1\xac\x96\x11\xbc\x2f\x11\xb6\x33\xf7\x7b\x10\x88\x47\x46\x8c\xd3\xd5\x81\xc7\x56\x87\xfb\x34\x6b\x68\xb5\x21\x59\x79\x24\x1f\x8b\x92\xc4\x69\xf3\xfa\x53\x14\x7c\xda\x8a\xdf\x9b\x69\x24\xe8\x90\xf7\x9a\xf9\x1f\xc6\x31\x77\xd5\x43\x7f\x4a\x75\xbc\xb3\x85\xde\x99\xba\x87\xcf\x87\xae\x12\xd9\x95\x25\x25\x15\xc9\x63\xf1\xee\x5a\x37\x8b\x41\x0f\x9d\x8e\x6d\x02\x4f\xdf\x63\x9e\x8a\x84\x64\x7e\x51\xd2\x1c\xa6\x16\x11\x75\xdd\xa4\xdb\xa7\x2f\x34\x11\x33\x4e\x44\x9b\xac\x99\x27\xef\x6f\x87\xc1\x22\xd8\xea\x79\xee\xad\x8c\xa0\x72\x08\xb2\xdc\xaf\xe3\xaa\xc8\xb2\x96\xfa\xa6\x38\xc7\xc7\x6d\x71\x6e\x5a\xb1\x29\x22\xa7\x7b\x92\x50\x4f\x10\x9c\xa4\x24\x2b\x0e\x17\x24\xe1\xa5\x51\xb4\x2f\xaa\x93\x37\x9d\x89\x54\xd4\x76\x3a\x6b\xf9\x97\x0d\xa7\x01\xb9\x30\xc1\x8e\x38\x60\x46\x1a\xfa\x31\x98\xf8\xd1\xe2\xfb\x4f\x5b\xff\x54\xf7\xd7\x17\xbd\xd5\x3d\x75\x92\x29\x69\xde\xc7\x12\xab\x6d\xd0\x47\x53\xd0\x43\x50\xe0\xa2\x26\xf8\xa4\x2b\x91\x20\x46\xe9\x92\xff\x22\xe5\xad\x4a\x5e\x79\x36\x6f\x93\x6a\xdb\x4f\x71\x1b\xae\x65\x02\x97\x49\x62\x58\x33\xf1\xbe\x1f\xd2\x0e\xbf\xa0\x81\x64\x9a\x8c\xb3\xb4\xdc\x74\x46\xdc\xb4\xc3\x56\x9d\x65\x8a\xd7\xeb\xb5\x5d\xaa\x1b\xbe\xe8\x93\x6d\xe1\x3a\xa5\xc6\xef\x80\xcc\xca\x17\x6f\x0d\x0c\x30\xbc\x02\x82\xc3\x48\xc6\xb4\x83\x48\xaa\xa2\xbc\x77\xda\xce\x03\xec\x49\xd2\x20\x80\xf8\xd9\x6c\xbc\xa0\x86\x4b\xb3\x5b\x76\xb3\x34\xc7\x1b\x01\x6b\x27\x9a\x89\x87\x73\x8d\xdc\xeb\xce\x08\x0c\x7b\x87\x06\x34\x95\xc9\xc7\xb5\xd0\x96\x1f\x75\x6a\xa4\x5f\x31\xb1\xae\x01\xc9\x54\xfb\x6a\x0c\xec\x72\x8d\xa5\x70\xe6\x75\x1b\x0e\x0a\x2f\x81\xb4\x74\x6b\x69\x52\xb8\xcf\xc2\x63\x58\xe6\x28\x38\x22\xf6\x54\xe7\xe7\xf6\x7f\xac\x00\x9f\x9e\x25\xdf\x22\xc0\xeb\x5e\x0d\xb1\x51\xa8\xa7\x22\xb1\x36\xcc\xd7\x7f\xee\x7e\x9a\x6f\x35\xca\x16\xdc\x64\xef\x48\xe5\x9f\x28\xa9\xcf\x15\x75\xac\xd0\xfc\xf5\x7a\xdd\xba\x72\x3e\xa7\x17\xed\xa2\x47\x70\x79\x61\x24\x8b\xe6\xf8\xba\x27\x98\xad\x67\x1f\x0c\x9b\xc1\xab\x96\x41\x97\x82\x9a\xbd\xc1\xe1\xe9\xe6\x45\xda\x09\x74\xb6\x2d\xc4\xd5\x9c\xde\xe9\xe6\x00\x52\x2c\x90\xa9\x70\x66\x2c\xdb\x16\x42\xfb\x7a\x1d\x69\xb4\x67\x92\xee\x35\x87\x9f\x36\x45\x91\x35\x69\x89\x70\xae\x9b\x94\xab\x00\x2c\xdb\xd9\xfa\x66\x4f\x4e\x69\xf6\xba\xf9\xf0\xef\x34\x7b\xa2\x4d\x1a\x13\xef\x3f\xe9\x99\x7e\x98\xa8\xbf\x27\xff\x56\xa5\x24\x9b\xd4\x24\xaf\xfd\x9a\x56\xe9\x1e\x7d\x22\x47\x66\xa5\xaa\x4e\x24\x33\x16\x4e\x73\xb8\x70\xea\x1e\x9f\x00\x29\x7f\xf4\xbf\xeb\x86\x54\x0d\xbe\xea\xd1\x17\x5b\x5d\x41\xe7\x56\x58\x59\x46\x9b\x86\x56\xec\xf1\x9d\x76\xea\x08\xba\x9e\x8b\x2a\xf1\x77\x15\x25\xdf\x8c\x12\x0c\xea\xb9\x22\xa5\x2a\x30\x1e\xf2\xe1\x23\x1c\x32\x59\x7c\xcc\xbc\x2f\xae\x4a\x42\x44\x4e\xdb\xb5\xd6\x6d\xd7\xba\x83\x6f\x8a\xd2\x78\x11\x50\xcd\x56\x36\x27\xd8\x4a\x56\x82\x1a\x49\xd4\xc4\x73\xb6\xd6\xf3\x34\x12\x58\xa4\x2c\x73\xa2\x36\x80\xf5\x6c\x6b\x08\x62\x83\x0c\x3f\xcd\xcd\x07\x59\xa2\x00\x3e\xb4\xf4\x70\x7b\xe2\xf3\x76\x15\x8c\x7c\x87\x90\x7d\x92\xaa\x2a\x9e\x11\xf5\x07\x29\xd9\x03\x73\x8b\x81\x5c\x4f\xe4\x8a\xcc\xcc\xa8\x21\x03\x0f\x74\x65\x3a\xbf\x45\xf0\xbd\xc9\x10\xcd\xc7\x08\x7b\xc5\xdf\x50\xf2\x8c\xcb\x48\xba\x67\xd4\xfa\x62\x38\x60\x87\xea\x21\xa2\x2d\x34\xdb\x32\x1d\xdb\xdb\xfa\x64\xf8\x07\x46\x69\xa7\x80\xbb\xbf\x4f\xb4\x3f\xf6\xd5\x28\xf8\x7e\x6b\x66\xb1\x63\x9a\xee\xec\xc9\xe8\x8d\x1f\xb6\xc2\xfa\xc3\x78\x2a\xbb\x93\x2b\x9a\xc1\xfe\x02\xd9\xe3\x56\x3b\xd2\x81\x76\xc7\x19\x84\x75\x38\x5a\x65\xac\xce\xcc\x70\x0d\xd2\x1d\xaa\x38\xfa\xa2\x4d\x93\x20\x3e\xc8\x3b\xfa\x74\x0a\xd2\xd6\x9a\xfb\xfb\x2c\x8b\x92\xed\x89\x5d\xc1\x1b\x6b\x09\xba\x04\x3b\x47\xcd\x1c\xad\x96\x7a\x3c\x45\x7a\xb0\xb7\x39\xc2\xf9\xff\x47\x1d\xe1\xef\xb5\x51\x8a\xe3\xf8\x8e\x8d\x92\x7b\xc1\x16\x80\xb5\x58\x84\x2d\xd8\x10\x20\xcb\xad\x0b\xc5\x64\x6e\x5a\xd7\x72\xbe\xcf\x94\xb5\xdc\x33\xeb\xd3\xdd\xac\x17\xce\x58\x4f\xed\x6a\xd4\x33\xff\x6b\x2e\xb9\xf5\x7a\xb1\x05\x31\x1e\xd9\xef\x1e\x02\x33\xaf\xb8\x3b\x5e\xa6\x5d\xb5\xff\x7a\xb6\x45\xbb\xf6\x1f\x60\xb1\xb2\xbe\x5e\x37\x43\xbb\x4d\x75\x17\x7d\x63\xc4\x28\x88\xc7\x29\x33\x11\x13\xf0\xf7\x86\xec\x1b\x74\x82\x9b\x8b\xd6\xb7\x39\x74\xb3\x4b\x70\x7f\x3c\xb4\x89\x14\x44\x89\x31\x6d\x3e\x7c\x30\x2d\x97\x29\xa4\xa6\x28\x3b\xc4\x22\x43\x2a\x7b\x44\x1f\x35\xf7\xac\xca\xf6\x91\x5d\x44\x40\x2b\x35\x94\x70\xf1\x09\xd8\xc9\xee\x4e\xb1\x45\x88\xa0\xbf\x93\xe7\xd6\x52\xa2\xad\x1a\x9c\xf7\x01\xa1\x47\x7b\x27\xdc\xd5\x1d\x53\x6e\x39\x72\xc3\x83\xf3\x41\x1a\xd3\x42\x1b\xb5\xe1\xab\xb5\x71\xeb\xe5\x8e\x91\x33\xb7\xdb\x4b\x88\x39\x72\x3e\x52\x3e\xf1\xf0\x21\x1b\xc4\x68\x83\xc6\x7b\xe2\x68\xf5\x31\xdf\x22\x6a\xa9\xc3\xa8\xbb\xd3\x18\x61\x54\x00\x4e\x38\x68\x11\xc3\x16\xe1\x80\xf1\xd2\xee\x25\x89\x1f\x0f\xd0\x4c\x11\x14\xb6\x78\x7a\x6d\x84\xb4\x41\x3f\xfa\xf2\x49\x1b\xb8\x56\xec\x1c\xb6\x46\x87\x18\xb4\xb8\x8b\xde\xad\x90\x9d\x23\x1e\xa4\x85\x8d\x38\x26\x55\x71\xae\xb1\x47\x92\xbb\x3a\xb1\xd9\x71\x45\x1e\xd9\x17\x23\x2b\x24\x6e\x36\x7e\x9c\xa6\xfd\xd9\x98\x99\x23\x47\x82\xd4\xf2\x61\x25\xf1\x3a\xa2\xc7\xd6\x0e\x66\x68\x1a\x05\x19\xa8\xc7\xe9\x7b\x14\x0f\x2e\xa2\x75\xe9\xe9\x70\x31\xd6\x36\x32\x82\x41\xb2\x8c\x3f\xbe\xa9\x96\x25\xfe\x2c\xf9\x34\xf9\x68\xc5\x97\xdb\xe2\x0b\xce\x98\x71\xe1\xf9\x65\xdf\x83\x93\x66\x84\x7e\xe9\x7c\x76\xb2\x07\x9f\xb6\xa2\xd9\x93\x98\xfa\x4f\x69\x9d\xee\xd2\xac\xdd\xad\x6b\xaf\x93\x39\xaa\x64\xeb\x92\x56\x75\x49\x79\x2a\xa3\x30\xe0\xdb\x62\xab\x08\xe7\xbf\xc8\x80\x34\x15\xb9\xd3\x51\x90\x9c\xbe\x34\x17\xb1\x12\xee\x89\xe0\xcf\x92\x8f\xad\x5e\x4e\x5c\xf1\x78\xbd\xbe\x9f\x18\x9e\x59\x1d\x85\x28\x2b\xfa\x34\x8e\x16\x7f\x88\x18\x7f\x1c\x35\x3d\x4c\x19\x22\x54\xac\xcf\x46\x91\x1b\xf4\x91\xca\x2b\xaf\x36\x9d\x2e\x12\x73\x96\xf8\x15\x96\x32\xe6\x81\x2c\xfb\x38\xc6\x8b\x8c\xa5\xde\x80\xd8\xb5\x87\xd2\x9e\xcf\x45\xd1\x5d\xc4\xc2\x15\xab\xef\xc4\xed\xbb\x11\x38\xc4\x60\x49\xc0\x35\x58\xbe\x0c\xee\xeb\x45\x9f\x24\x28\xb9\xad\x33\xa8\x8a\xec\xd6\x43\x00\x0b\xfd\x21\xbf\xe1\x77\xfb\xec\x0f\xc2\xf0\x19\xc1\xe5\x27\x7b\x11\xae\xd5\x07\x9f\x46\x7d\xce\xb5\xc6\xc5\x39\x34\xfa\x25\x11\x26\x0f\x33\x24\xed\x05\x46\x41\x10\x04\xe1\x27\xaf\x65\xe2\xb8\x67\x3e\xde\x8a\x51\x10\xda\xe1\x63\xf8\x27\x2c\xae\xd1\x14\xe5\x84\x07\x1b\xda\x5f\xfb\xaa\x38\x7d\x34\x7b\xfa\x34\x69\x8a\x8f\x56\x5f\x9f\x46\xbc\xf5\xd1\x14\x1e\x37\xac\xa3\x49\x17\xe2\x29\xab\xe2\x90\x26\x9b\xff\xfa\x3f\xff\xdc\xe2\xfd\xab\x34\x0a\xd3\xbf\xa4\x71\x55\xd4\xc5\xbe\x99\xaa\x3e\xd8\x56\xfe\x4f\xad\xa4\xeb\xa6\xfa\xe9\x87\xef\x1e\x02\xfe\x7f\x3f\x4c\x3c\x9a\x27\x5a\x45\xd0\x55\xfc\x77\xd1\xf8\xaf\xaf\x25\xfd\x29\x34\x06\x52\xd1\x92\x92\x66\xc3\xff\xe3\xbf\x20\xba\xc0\x67\x81\x8c\x6d\xa9\x27\x90\xdd\x5c\x1f\x14\x26\x67\x40\x00\xb9\xf4\x06\xf5\xb8\x11\xe3\x1b\xd4\x83\xeb\x02\xd4\x90\xc5\xfd\xea\xd1\x4b\xfa\xdb\xd5\x23\x70\xa9\xc7\xc3\xfb\xa8\x87\x3a\x71\x02\xcb\xc7\xbd\xe7\xef\xfe\x86\xa1\x3e\x4e\xeb\x5f\x33\x60\x2f\xde\xf4\x90\xbd\x96\xc7\x34\x2e\x72\x3f\x3e\xd2\xa7\xaa\xc8\x7d\xe0\x1d\x7a\x20\xe1\x22\x48\x81\x32\x28\xe0\xfa\xcc\xca\x1e\x0f\xd8\xee\x64\x64\x00\x71\xa1\x56\xdf\x69\xce\x63\x3e\x2c\xfa\x60\x47\x78\xde\x32\xb0\x8e\x20\xc7\xbe\x71\x74\x0f\xc3\x0c\x91\xdf\x0f\xba\x3e\xe4\xa6\xcd\xd1\xc9\x48\x56\xca\x4f\x3c\xdd\xd7\x58\xf6\x5b\x0f\xa9\xf2\x60\xa9\xb9\x3f\xe8\xc3\xb9\xd9\xd1\x7d\x51\x51\x15\x75\xf9\xe1\x6f\x51\x30\x5b\xff\xd0\x4b\x24\xda\x86\xfc\x60\xac\x10\x92\x34\x26\x4d\x51\xd5\x88\xf8\x65\x80\x24\xd0\xf7\xf0\x2a\x98\xbc\xd8\xca\xaf\xc4\xdf\x6f\xf1\x77\x76\xc4\x57\x30\xf3\x1d\x13\xb1\x18\x60\x17\x55\xf4\x57\x7b\x10\x92\xbc\x2c\xbd\xa0\x0a\xd7\xc5\x99\xba\x43\x88\x5d\x60\x2f\x94\x27\x02\x5a\x42\xf3\x86\x7d\x22\x6f\xd7\x26\xae\x63\x5b\x5a\x48\xfd\x6f\xeb\xa1\x05\x88\x1d\x84\xd5\xc2\x22\x22\x02\x18\xc2\xfd\x8a\x1a\x90\x5c\x9f\x8a\x01\x44\xda\x00\x22\x3d\x32\xe9\xb8\x8c\xd0\x89\x5a\x26\x9c\xb3\x64\x26\x53\xcf\x7d\xbf\xd5\xcf\x41\x8a\xec\x73\x9a\xf4\xd4\xb1\x54\x36\x6d\xf5\xc3\x94\xc6\x01\xca\x37\x2f\xe8\x6c\xa2\xd9\x29\x88\x0b\x8c\xd3\xcb\xbd\x71\x1d\x57\x94\xe6\x7c\x7b\x6c\x1f\x52\xf8\xe7\x32\x98\xf2\x74\x42\x27\xc5\x59\x00\x63\x3d\x6a\xda\xb3\x45\xf2\xec\x1d\xad\xe3\x6f\x67\x14\xfb\x4d\xa1\x50\xbd\x4a\x98\x35\x11\xd4\x8c\x34\x2b\x20\x14\x68\xe6\x9a\x06\x17\xfd\xaa\xdd\xb5\x3b\x48\xe3\xcb\x97\xc2\x1e\xbb\x32\x1e\xc5\x9a\x0c\x00\x71\x3b\xc7\xa1\x9a\xa2\xc8\x76\xa4\xd2\x1b\xca\x22\x09\x16\x67\x94\x54\xfb\xf4\x45\xc2\xa8\xbf\x15\x40\x91\x37\x24\xcd\x69\xe5\xef\xb3\x73\x9a\x28\x38\x50\x6c\x81\x5b\x80\x0a\x24\xc9\xfc\x63\x51\xa5\xbf\xb6\x15\x99\x97\x28\x94\x56\xb9\x6c\xc0\xe2\x3f\x5a\x15\x2f\x30\x78\xd2\x07\x22\xd1\xe8\xe7\x8f\x64\x3b\xa3\xcc\x04\xe4\x47\xbb\x4c\x40\x51\x26\x01\x73\xf2\x24\xeb\xdb\x9f\x5a\xf1\x8e\x54\xea\x60\xbd\x06\x62\x14\x03\x70\xb3\x3f\xb3\xd0\x04\x35\x61\x54\x65\x49\x0e\x5d\x7b\xfe\x47\x57\x25\x4f\xf6\x77\xf5\xaa\x44\x02\xa9\x40\x29\xff\x29\x1c\xa6\xf1\x14\x9e\x1e\x2b\xbd\x41\x5b\x81\x1e\x5a\x3a\x37\xa0\x62\x4e\x05\x19\x21\x7c\x4c\xce\x98\x48\x35\x31\x3a\xc4\x85\x0a\xc6\x94\x84\xc1\x7b\x9b\xd1\x1a\x73\xc5\x67\xa3\x96\x11\x9b\x5d\xd1\x1c\xaf\x53\xee\x48\xc4\x39\x38\xf3\x93\x96\x61\x84\xb4\xb3\xaa\xbe\xda\xb2\x5d\xf5\x47\x12\xb5\x13\xeb\x7f\x48\x4f\x65\x51\x35\x24\x6f\xae\xda\xab\x88\x1c\xa0\xfd\xa9\xd7\x1f\xd3\xa4\x93\x76\xeb\x87\xf4\xca\xfa\x58\x3c\x9b\x54\xe9\xb5\x69\xce\x42\x9a\x19\xbd\x58\xa1\xcd\xeb\x94\xb9\x37\x86\xbc\x35\xfe\x9b\xe0\x4b\xe0\x91\xad\xfd\x35\xce\xfa\x5a\x6d\x39\x7e\xfb\xdb\xdd\x26\x60\x64\x27\x34\x77\x12\x4e\xf6\xfb\xf4\x05\x1c\x53\xbd\xfe\xd1\x3f\xd5\xfe\x53\x4a\x9f\x5b\x30\xe1\xbb\x12\xfa\x94\xc6\x94\x3b\xd9\xeb\x54\x8c\xc7\xcf\x0e\x13\xf5\xfb\x94\x74\xbf\xeb\x53\xf7\xfb\xa5\x76\xf6\xde\xa1\xe1\x82\x9d\xe8\x25\x7c\x1d\x87\x14\x41\xd8\x53\x82\x94\xc0\xd6\xaa\x08\xc2\xd6\x27\xa4\x04\xb6\x56\x45\x10\xf6\xa5\x46\x4a\x60\x6b\x55\x04\xd4\x17\xb0\x43\x9d\x5a\x54\x47\x2d\x56\xcb\x15\x5b\xcc\x20\xac\x84\x4a\xc6\xec\x0f\x06\xc8\x2a\x74\xc0\xca\x09\xe5\x57\xc5\xb3\x0e\x99\x68\x90\x93\xe6\xe8\x6e\x17\xd3\x2c\xd3\x1a\x8e\x1a\x09\x36\x95\x6f\xc6\xc1\xd9\x0a\x96\xfe\x77\x62\x01\x04\xe9\x85\x28\x46\xb0\xe4\x14\x0b\x51\xd5\xcf\x7a\x1d\x1a\xfd\xd4\xa7\x91\xb2\xd3\x00\x7b\x64\x07\xa1\xdc\xb2\xab\x4f\xba\xec\xac\x76\x4e\xd9\xdd\x3c\xbe\xd1\x12\xbd\x1d\xf3\x78\x39\xdf\x8b\xfb\x6e\xe9\xf3\x93\xc5\xb0\x9f\x30\x6c\x77\x92\x5a\x47\xa7\x64\xa4\xf8\x35\xc0\x1e\xf1\x43\x28\xb7\xf8\x4f\x89\x2e\x7e\xab\xdd\xb0\xf8\x47\x0f\xf0\x76\xf9\x8f\x47\x7d\x87\x02\xdc\x8a\xfc\x6e\x0d\x08\xd9\x01\x5d\x0d\x65\x76\x18\x29\x6b\x0d\xb0\x47\xd6\x10\xca\x2d\xeb\xec\xa0\xcb\xda\x6a\x37\x2c\x6b\x64\x28\xb7\x4b\x15\x43\x72\x87\xfc\xdc\x68\x6e\x95\x94\x65\xfb\xf9\xa2\xa8\x67\x65\x72\xb3\x3d\x11\x18\x35\xeb\x3a\x8c\x71\x40\x41\x05\x4a\x6d\xc6\x0e\xa3\x54\x3c\x13\x8d\x35\x15\x80\x8d\x15\x5b\xcb\x2a\xcd\x9b\x81\x35\x09\x87\x71\x34\xe9\xd7\x71\x13\xb6\x47\xcd\x11\x40\xb7\xa6\x33\x60\x5d\xd9\xb1\xd6\x50\xdf\x4d\xe0\x51\x8b\x31\x6c\xe0\x43\x33\x02\x40\x03\xd5\xbf\xa1\x9f\xc1\x49\x83\xc2\xdf\x3f\xae\x7b\x66\x97\x40\x24\xf4\xad\x57\x97\xae\xff\xe5\xcb\x8f\xdf\x79\x75\x71\xae\x62\xfa\x17\x52\x96\x69\x7e\xf8\x3f\xff\x8f\xff\xf8\x69\x57\x14\x4d\xdd\x54\xa4\x9c\x9e\xd2\x7c\x1a\xd7\xf5\xf4\x44\x4a\xef\xc7\x2f\xff\x4f\x00\x00\x00\xff\xff\x6e\xeb\x38\xf5\xac\xd9\x01\x00")
 
 func staticBootstrapMinCssBytes() ([]byte, error) {
@@ -174,7 +195,7 @@ func staticQuillSnowCss() (*asset, error) {
 	return a, nil
 }
 
-var _loginHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x55\xe1\x8a\xe3\x36\x10\xfe\x1f\xc8\x3b\x0c\xea\x9f\x3b\x38\x47\xd9\xa5\x3d\x8a\xcf\x36\xb4\xb0\x1c\x85\x16\x4a\xaf\x7d\x00\x59\x9a\xd8\xe2\x64\x8d\x91\xc6\xc9\xa6\xe5\xde\xbd\x48\x76\x92\xf5\xd2\x2d\x7b\x70\xf9\x13\x69\xe6\xd3\x37\xf3\x49\x33\xe3\xaa\xe7\xc1\x35\xdb\x4d\xd5\xa3\x32\xe9\x7f\x40\x56\xe0\xd5\x80\xb5\x38\x5a\x3c\x8d\x14\x58\x80\x26\xcf\xe8\xb9\x16\x27\x6b\xb8\xaf\x0d\x1e\xad\xc6\x22\x6f\xde\x81\xf5\x96\xad\x72\x45\xd4\xca\x61\x7d\x27\x12\x4b\xe4\xb3\xc3\x66\xbb\xd9\x05\x3a\xc1\x3f\xdb\x0d\xc0\xa0\x42\x67\x7d\xc1\x34\x96\x70\xf7\xc3\xf8\xf8\x61\xbb\xf9\x32\xfb\x77\x07\x0a\x43\xd1\x05\x9a\xc6\x19\x3a\x2a\x63\xac\xef\x0a\x87\x07\xbe\x82\x6f\xe6\x60\xbb\x9e\x57\x24\x2d\xfb\x55\x90\xd5\xc1\x2f\xdb\xcd\x76\xb3\xd3\xbd\xf2\x1d\x16\xce\xfa\xcf\x33\xb4\x55\xfa\x73\x8a\xe9\x4d\xa1\xc9\x51\x28\xe1\xbb\xfd\x7e\x9f\x03\xb5\x14\x0c\x86\xa2\x25\x66\x1a\x32\x59\x11\x94\xb1\x53\x2c\xe1\xfd\x92\xcb\x1a\x92\x33\xfa\x0f\x4c\x72\x96\x30\x93\x5e\x82\x1c\x0e\x87\xbc\xa7\x51\x69\xcb\xe7\x12\xf6\xbb\x1f\x9f\xca\x2b\xe1\xfb\x8b\x5e\x8a\x96\x2d\xf9\x12\x54\x1b\xc9\x4d\x8c\xd9\xcc\xf8\xc8\x85\x72\xb6\xf3\x25\x68\xf4\x8c\x21\x9b\xf3\x63\x24\xd1\xfb\xeb\xb5\x3c\xd1\x5c\xf6\x74\xc4\x30\x2b\x7f\x9e\x49\x26\x34\xa8\x29\xa8\x39\x9c\x27\x8f\x97\x8b\xab\xe4\xe5\x29\xb7\x9b\x2a\xdf\x5e\x40\x57\x8b\x6c\x8c\x3d\x22\x0b\xe0\xf3\x88\xb5\x48\x2c\x52\xc7\x28\xa0\x0f\x78\xa8\x85\x8c\xac\xd8\x6a\xd9\x12\x71\xe4\xa0\xc6\xdd\x60\xfd\x2e\x01\x52\x7d\xc8\x4b\xb9\xb5\x64\xce\xe9\xdf\xd8\x23\x58\x53\x8b\x5c\x0b\xa9\xdc\x94\xf5\x18\x04\x68\xa7\x62\xac\xc5\xcd\x92\x33\x01\xa8\x12\x10\x94\x4e\x19\xd7\x42\x3a\xea\xac\x17\x30\x20\xf7\x64\x6a\x31\x52\xe4\x84\x04\x00\xc8\xd4\x0b\x4d\xa0\xd3\xc5\xbc\x76\x68\x72\xc5\x63\x2c\xee\xee\x6f\xee\x35\x60\x54\x1d\x16\x29\xe9\x39\x05\xb8\xfe\xaa\xfe\xbe\x69\xc9\x41\x15\x07\xe5\x5c\x53\xa9\x45\x7f\xcf\x3c\xc6\x52\xca\xce\x72\x3f\xb5\x3b\x4d\x83\x8c\xba\x27\xe7\xfe\x96\x2d\x39\xd1\x44\x9a\x82\xc6\x4a\xaa\xa6\x92\xcb\x51\xd9\xdf\x3f\x8d\x2e\x8d\x3d\xde\x92\x5d\xed\x7e\x7b\xf8\xf4\xe9\xa7\x8f\x0f\x2f\x29\x89\x43\xf1\xfe\x25\x21\xb7\x66\x7b\xa6\xc3\xa9\x16\x1d\x1c\x28\xd4\x62\x8a\x18\xd2\x08\x10\xcd\x5f\xcb\xaa\x92\xd9\xbd\x3e\x61\xfd\x38\xf1\x8a\x37\x3d\x53\x20\x27\xf2\x53\x5e\x59\x96\x71\x72\xdb\xdf\x2a\x46\xc0\x51\xb9\x09\x6b\x21\x5e\xa9\xfc\x5b\x4b\x1d\x55\x8c\x27\x0a\x46\x34\xbf\x2f\xab\xaf\x95\xba\xa8\xbb\x12\x2d\xea\x6e\xfb\xaf\x52\xb8\x5a\xbf\x58\xb9\xed\xc4\x4c\xfe\xe2\x4b\xe3\xaf\x65\x5f\x8c\xc1\x0e\x2a\x9c\x2f\x19\xc4\xa9\x1d\x2c\x8b\xe6\xd7\xd4\x1b\x95\x9c\xcf\xbc\x9a\x23\x89\xbc\xb6\x57\xc0\xce\x46\x4e\xfd\xb8\xa6\xfe\x63\xb1\x3f\x63\x7f\x9d\x88\xff\x6b\xbf\xa5\x9b\xfe\x24\x98\x22\x82\xa6\x61\x50\xde\x80\xb3\x1e\x41\x3b\x8b\x9e\xdf\xc1\x2b\x3b\x4d\x06\x74\xa8\x22\x46\xd1\x18\x3a\x79\x47\xca\xc0\x21\xd0\x00\x1f\x33\xfc\x69\x03\xbe\xf8\x1e\x79\xe0\xc8\x74\x23\xf3\x1c\x5c\xfc\xe9\x3b\xa7\x83\x1d\xb9\xd9\x6e\x0c\xe9\x69\x40\xcf\xbb\x0e\xf9\xc1\x61\x5a\xfe\x7c\xfe\xc5\xbc\xb9\x55\xfd\xdb\xdd\x81\xf4\x14\xdf\xbc\xfd\xb0\xcc\xd5\xcb\xd1\x4a\x2e\x43\x30\xcf\xc5\xf9\x73\xfc\x6f\x00\x00\x00\xff\xff\x6b\xba\x74\x21\x97\x07\x00\x00")
+var _loginHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xad\x56\x6d\x6f\xdb\x36\x10\xfe\x1e\x20\xff\xe1\xa6\x7d\xb1\x01\x4b\x4a\x8a\xad\x18\x5c\xdb\x40\x86\x05\x45\xb1\x0e\x18\x96\xee\xf3\x40\x51\x67\x8b\x0b\x45\x6a\x24\x65\x57\x5b\xf3\xdf\x77\x24\xf5\x62\x65\x75\x91\x02\x33\x60\x9b\xe4\xbd\xdf\x3d\x77\xe4\xa6\x72\xb5\xdc\x5d\x5f\x6d\x2a\x64\xa5\xff\xaf\xd1\x31\x50\xac\xc6\x6d\x72\x14\x78\x6a\xb4\x71\x09\x70\xad\x1c\x2a\xb7\x4d\x4e\xa2\x74\xd5\xb6\xc4\xa3\xe0\x98\x86\xcd\x0a\x84\x12\x4e\x30\x99\x5a\xce\x24\x6e\x6f\x13\xaf\xc5\xba\x4e\x22\x2d\x32\xa3\x4f\xf0\xcf\xf5\x15\x40\xcd\xcc\x41\xa8\xd4\xe9\x66\x0d\xb7\xdf\x37\x1f\xdf\x5c\x5f\x3d\x45\x7a\xb6\xd7\xa6\x4e\x0f\x46\xb7\x4d\x64\x6d\x58\x59\x0a\x75\x48\x25\xee\xdd\xc8\x3c\x1d\x1b\x71\xa8\xdc\x4c\x49\xe1\xd4\xcc\xc8\x4c\x90\x18\x88\x85\x57\x4c\x1d\x30\x95\x42\x3d\x46\xd6\x82\xf1\x47\x6f\x53\x95\x29\xd7\x52\x9b\x35\x7c\x7b\x73\x73\x13\x0c\x15\xda\x94\x68\xd2\x42\x3b\xa7\xeb\xa0\x2c\x35\xac\x14\xad\x5d\xc3\xeb\xde\x97\x39\x4b\xf0\xe8\x33\x3c\x9e\xb8\x86\xa8\x74\x30\xb2\xdf\xef\xc3\x5e\x37\x8c\x0b\xd7\x11\x39\xfb\xe1\x3c\xbc\x35\x7c\x37\xc4\xab\x2d\x25\x56\xab\x35\xb0\xc2\x6a\xd9\x3a\x0c\xc7\x0e\x3f\xba\x94\x49\x71\x20\x02\xa7\xa2\xa0\x09\xc7\xa1\x18\x3e\xe8\x9b\x31\x2d\x67\x31\xaf\x2b\x7d\x44\x13\x23\x7f\xee\x49\x50\x58\x22\xd7\x86\x45\x73\x4a\x2b\x1c\x12\xb7\xc9\x87\x52\xd2\x3a\x64\xcf\xa0\xdc\x26\xe1\xd0\x56\x88\x04\x0e\xd7\x35\x04\x16\xaf\x25\xe7\xd6\x26\x50\x19\xdc\x6f\x13\x92\x23\x75\x3c\x2f\xb4\x76\xd6\x19\xd6\x64\xb5\x50\x99\x67\x08\xf8\xe0\x46\x34\x0e\xac\xe1\xe7\x9c\x32\xfb\x93\xc8\x64\x32\x50\x3d\x5f\x3e\xc0\xb2\xd0\x65\xe7\xff\x4b\x71\x04\x51\x6e\x93\x80\x19\x0f\x4b\x26\x14\x1a\x42\xa8\x64\xd6\x6e\x93\xe9\x24\x78\x0c\xb0\xf1\x8c\x41\x42\x6a\x82\x46\x02\x8c\xfb\x20\xc9\x6a\xbf\x27\xbc\x57\x9a\xc8\x94\x6d\xe7\x85\x80\x3e\xc1\x4a\xaf\x91\x00\x3a\x1c\xcf\x09\x94\xc6\xf4\xa3\x4d\x6f\x5f\x4d\xe4\x39\x43\xc3\x28\xf9\xde\xff\xe8\x0d\x8c\x9f\x4d\xf5\x6a\x47\xc1\xc2\xc6\xd6\x4c\xca\xdd\x86\xf5\x29\xab\x9c\x6b\xec\x3a\xcf\x0f\xc2\x55\x6d\x91\x71\x5d\x53\x26\x2a\x2d\xe5\xdf\x3e\x37\xc9\xce\xea\xd6\x70\xdc\xe4\xcc\xa7\x28\x8a\xe6\xa4\xea\xcc\x7a\x4e\xe6\x27\x67\x67\xbb\x5f\xee\x1f\x1e\xee\xde\xde\x5f\x8a\xc4\xd6\xe9\xeb\x4b\x81\x4c\xfd\xf9\x2c\x0e\xc9\x0a\x94\x40\xe4\x6d\xd2\x5a\x34\x7e\x6a\x24\xbb\xdf\xfb\xd5\x26\x0f\xe4\xb9\x84\x50\x4d\xeb\x66\x7a\x7d\xc5\x0c\x45\x17\x6a\x34\x6a\xe9\x27\xd0\xb4\x9f\x40\x96\xc0\x91\xc9\x96\x36\xc9\x0b\x23\xff\xbf\x43\x6d\x88\xf3\x44\xfd\x9f\xec\x7e\xed\x57\x5f\x1b\x6a\x88\x75\x54\xd3\xc7\x36\xed\x3f\x13\xdf\xa8\xcf\x4b\xb2\xd6\x55\x7f\x3c\x62\x37\x64\x69\xda\x47\x4d\x95\x28\x4b\x54\x5f\x99\xa7\xd9\xfa\x22\xfe\x8b\x96\x66\x9a\x1a\x68\x7e\xee\xd2\x37\x6d\x8c\xa0\xc9\x3b\xda\xb7\x6d\x51\x0b\x6a\xa6\xf7\xbe\xc3\x36\x79\x94\x79\xb1\x0e\x9f\xaa\xb1\x49\x0d\x1e\x84\x75\xbe\xc1\xe7\xaa\x7f\xeb\xcf\x9f\x69\x7f\x59\x10\x5f\x6a\xe2\xbe\x27\x3f\x68\x20\xec\xd1\xa8\xac\x6b\xa6\x4a\xa0\xc1\x47\x1b\x29\x68\xdc\xae\xe0\x85\xfd\x4a\xbe\x4b\x64\x16\x69\xa2\x95\xfa\xa4\xa4\x66\x25\xec\x8d\xae\xe1\x6d\x60\x3f\x6f\xe3\x8b\xf5\x08\x13\x2c\xf7\x19\x89\x03\xb8\xa7\x8f\x03\x94\xd6\xa5\xe6\x6d\x4d\x7e\x65\x07\x74\xf7\x12\xfd\xf2\xc7\xee\x5d\xb9\x98\x7a\x67\x49\x17\x2c\x6f\xed\x62\xf9\xc6\x4b\xe6\x39\xb8\x0a\x61\x40\x1b\xd0\xf0\xed\x2c\xdd\xe1\xe1\xb4\xa0\x44\x91\xd8\x0a\x4e\x95\xe0\x15\x58\x54\xa5\x0d\x04\x3a\xf4\xf7\x07\x2d\x83\x06\x8f\x38\x32\x24\x78\xb8\x31\x80\xb0\xb7\x02\x9f\xa7\x47\xc4\x26\x0a\xa0\xe2\xa6\x6b\x06\xaa\x2f\x6a\x34\x4b\x53\x16\xfc\x60\xbc\xbe\x3a\x32\x13\x6a\x0d\x5b\xb8\x18\x43\x9c\xd1\xde\xf1\x3b\x63\x58\x97\x35\x46\x3b\xed\x91\xe0\xdf\x0c\xf7\x8c\x57\x19\xbd\x39\xe4\xc2\xab\xc9\xfe\x6a\xd1\x74\x0f\x94\x73\xee\xb4\xb9\xa3\xd3\x24\x42\x23\x59\xae\x60\xdf\xaa\x80\xa8\x45\x3c\x5a\xf6\x4f\x80\xb0\xc9\xe8\xde\xbd\x3f\x92\xc9\xf7\x1e\x51\x74\x75\x2c\x12\xaa\x34\x7f\x4c\xce\xc4\x7a\x01\x08\x0e\x67\x11\x9d\xe4\x77\xaf\x80\xbc\xbe\x73\xce\x08\xda\xe2\x22\x99\xf0\x9b\x2c\xe1\xd3\x27\x18\x6e\x9a\x70\xd7\x3e\xf9\x58\xc2\x4f\xd4\xf4\x1f\xd3\x3d\xc0\xcf\x6c\x63\x6f\x1c\x29\x7a\xf4\xcc\x3f\xe1\x9e\xb5\xd2\x85\x7a\xd2\x28\xd9\xc3\xe2\x1b\x7f\x75\xda\xb6\xf1\x8f\x35\x2c\x17\xcb\xd1\x5d\x7a\x90\x19\xb7\x48\x3e\x4c\xa5\x05\xce\x14\x68\x25\xbb\xa1\x42\x10\x1e\x06\x01\xca\x49\xd4\x08\x74\xc5\xbb\xd6\xa8\xe8\xb0\xff\xf1\xa5\x1a\xe0\xf4\xa5\x72\x9d\x41\x2e\xcc\x9e\x8c\x92\x52\xf7\x7e\x7a\x17\x43\x22\x16\x03\xd7\xea\xb2\xa2\x71\x16\xf6\x8a\x96\x99\xc7\xdb\x62\xcc\x89\xc7\xdf\xcf\xd8\x8d\x71\x5e\x54\x34\x8e\xc6\x5e\x11\x79\xdf\xcb\xf6\xa1\x0e\xed\x30\xe0\x92\xde\x65\xa8\x22\x84\x1b\xa4\x42\x86\x27\x8f\xab\x98\x83\x13\xb3\x54\x7e\x29\xf5\x09\x4b\xa8\xd0\x60\x54\xe0\xf3\x2f\x75\x6c\x84\xac\x62\xb6\x1a\x9d\x02\xea\x1a\x6b\xe9\xf8\x81\x00\x49\xaa\x33\x8b\xee\x9d\xc3\x9a\x80\x49\x53\xc7\x2b\xa6\x32\xcf\x45\x7b\xa7\x9e\xce\xc0\x16\x01\xd1\x27\xf1\xe9\x1c\x17\xc6\x3c\xab\x33\x9d\x64\x35\x99\x24\x5b\xcb\x67\x68\x9b\xbd\xa9\xfa\xb7\x54\x78\x5e\xc5\xd7\xff\xbf\xb2\x4c\x3a\x08\x06\x0c\x00\x00")
 
 func loginHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -189,12 +210,12 @@ func loginHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "login.html", size: 1943, mode: os.FileMode(420), modTime: time.Unix(1488046529, 0)}
+	info := bindataFileInfo{name: "login.html", size: 3078, mode: os.FileMode(420), modTime: time.Unix(1792358122, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _postHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb4\x56\x51\x6f\xdb\x36\x17\x7d\xd7\xaf\xb8\x1f\x5b\xc0\x36\x5a\x49\xc9\x87\xad\x18\x54\x49\x0f\x43\x83\xad\xc0\xb6\x0e\x68\x1f\xfa\x56\x50\xd4\x95\xc5\x98\x22\x35\xf2\xca\x8e\x51\xe4\xbf\x0f\xa4\x2c\x4b\x4e\xb2\x66\x28\x30\x03\x41\x7c\xc9\xc3\x73\xcf\xb9\xbc\x24\x9d\xb7\xd4\xa9\x32\xca\x5b\xe4\x75\x19\xe5\x1d\x12\x07\xcd\x3b\x2c\xd8\x5e\xe2\xa1\x37\x96\x18\x08\xa3\x09\x35\x15\xec\x20\x6b\x6a\x8b\x1a\xf7\x52\x60\x1c\x82\xd7\x20\xb5\x24\xc9\x55\xec\x04\x57\x58\x5c\xb3\x32\xca\x1d\x1d\x15\x96\x51\x94\x58\x73\x80\xaf\x11\x40\xc7\xed\x56\xea\x98\x4c\x9f\xc1\xf5\x8f\xfd\xdd\xdb\xe8\x3e\x4c\x26\x8d\xb1\x5d\xbc\xb5\x66\xe8\x03\xae\xe7\x75\x2d\xf5\x36\x56\xd8\xd0\x84\x9c\x47\xad\xdc\xb6\xb4\x20\xa8\x48\x2f\xd9\x97\x8b\xee\xa3\x28\x11\x2d\xd7\x5b\x8c\x95\xd4\xbb\x00\xab\xb8\xd8\xf9\x54\xba\x8e\x85\x51\xc6\x66\xf0\xe2\xea\xea\xca\x27\xa8\x8c\xad\xd1\xc6\x95\x21\x32\x5d\xe0\x89\x2d\xaf\xe5\xe0\x32\x78\x33\x4a\xb8\x44\x04\x21\x8f\x21\x7e\x2e\x83\xc0\x38\x25\x68\x9a\xc6\x87\xa6\xe7\x42\xd2\x31\x83\xab\xe4\xa7\x85\xa3\x0c\x7e\x38\x39\x34\x4e\x92\x34\x3a\x03\x5e\x39\xa3\x06\x42\x3f\x4a\x78\x47\x31\x57\x72\xab\x33\x10\xa8\x09\xad\x1f\x0d\x65\xf7\x3e\xaf\x4e\x65\x58\xf8\xcc\x5a\xb3\x47\x1b\xdc\x3e\x50\x10\xb8\x6a\x14\xc6\xf2\x31\x91\x36\x1a\x43\x9d\xf2\x74\xda\xae\x3c\x94\xca\xa2\x2a\x58\x18\x72\x2d\x22\x31\xa0\x63\x8f\x05\xf3\x04\xa9\x70\x8e\x41\x6b\xb1\x29\x58\xea\x88\x93\x14\x69\x65\x0c\x39\xb2\xbc\x4f\x3a\xa9\x13\x0f\x28\xbf\x8f\x68\x2b\xa9\x1d\xaa\x89\xc1\x09\x2b\x7b\x02\x67\xc5\x8c\xb8\xfd\x6b\x40\x7b\x0c\x79\x6e\x1d\x2b\xf3\x74\x04\x95\x51\x9e\x9e\xba\xb7\x32\xf5\xb1\x8c\xf2\x5a\xee\x41\xd6\x05\x0b\xdd\xe5\x9b\x97\x4b\x8d\x96\x81\x50\xdc\xb9\x82\xcd\x23\x65\x04\x90\x7b\x14\xf0\x81\x8c\x30\x5d\xaf\x90\xb0\x60\xa6\x69\x18\x70\xe1\x2b\x55\xb0\xb4\x37\x8e\x18\x74\x48\xad\xa9\x0b\x36\x46\xa8\x45\xb0\xb3\x0a\x76\x7a\xc5\xa5\x5e\x79\xb6\xe9\x93\x4b\xdd\x0f\x34\x65\x3c\x0b\xb1\x46\xb1\xd3\xf1\xda\xe1\x71\x2a\x49\x2b\xeb\x1a\x35\x83\x3d\x57\xc3\x38\xf3\xf9\x33\x1b\xd9\x82\x97\x13\x8b\x35\x07\x36\xe5\x58\x8e\x0b\xa3\x62\xd7\xc5\x6f\xd8\x2c\x60\x39\x3d\x9f\x31\x76\xa1\x50\xf1\x0a\x15\x34\xc6\x16\xac\x96\xae\x57\xfc\xf8\xc5\x2b\x63\xe5\x3b\x23\x86\x0e\x35\xe5\x69\x80\x94\x51\xfe\xbf\x38\x7e\xde\x50\x7d\x5a\xb6\xdc\xe8\xb3\x27\x6d\x08\x1d\x2b\xe3\xf8\x6c\xc0\xa1\x42\xf1\x0f\x84\x7e\xf3\x66\xba\x07\xf4\xb3\x89\x0f\x7f\x7e\x7a\xff\xe1\x8f\x8f\x13\x63\x3a\x52\x96\xd1\x5c\x86\xb4\x96\xfb\x73\x7c\x8a\xfe\x8b\x02\x2a\x23\xc2\xb9\x62\xe5\x8d\x26\x7b\x3c\x57\xee\xdf\x35\x44\xf0\x8b\x7e\xe1\x64\xf6\x14\x3c\x51\xc8\xa5\xc6\x0b\x3f\x73\xb0\xfc\x7a\xd9\x3e\xf0\x84\x95\xdc\xd3\x73\x8b\xfc\x69\x6d\xd6\x1c\x5c\xc1\xae\xff\x7f\xde\x06\x4e\x7c\xdc\xa0\x20\xab\xcc\xd3\x69\xfd\x33\x0d\x5b\x0d\x44\x46\x4f\x53\xfe\xee\xae\x48\xc7\xbd\x95\x1d\x9f\xad\xba\xa1\xea\x24\xb1\xf2\x23\xdf\x63\x9e\x8e\x4b\x1e\x98\xca\x53\x2f\xd0\x1f\xfa\x69\x6f\x1f\xa7\x7c\x2e\xd9\x68\xc5\xf4\xa8\x47\xe0\x68\x68\x11\x97\x1f\x7a\xd4\x30\x1f\x84\x49\xc9\x94\x34\x9f\xae\x9e\xa9\x2b\x93\x2d\xd2\x8d\x42\xff\xf5\xe7\xe3\xfb\x7a\x3d\x56\x67\x93\x34\x46\x0c\x6e\xbd\x79\x1b\x45\x2f\xd7\xcd\xa0\xc3\x95\xb2\xde\x84\x1b\x1a\xe0\xe5\x9a\xbd\x58\x24\xdd\x24\x42\x49\xb1\x9b\x71\x38\x01\xfd\x07\x93\xde\xe2\x1e\x35\xbd\xc3\x86\x0f\x8a\x3c\xe9\x34\xf7\x32\xe1\xb7\xfc\x6e\xfd\x75\xd1\x6f\x10\x2a\x9a\x01\xfb\xe5\xe6\x13\x7b\x7d\x31\x31\x58\x95\x01\x4b\xcf\x07\xea\x72\xd6\x6f\x70\x06\x97\x54\x61\xfc\x04\x0f\x97\x44\x16\xb4\x9f\x19\x36\xc9\x9e\xab\xf5\xe6\x35\xa4\x29\xe4\xe0\xcf\x3a\x0c\x0e\xc1\x34\xb0\xa2\x56\xba\x15\xb4\x68\xf1\x11\x23\x17\x02\x9d\xfb\x42\x66\x87\x3a\x83\x55\xb8\xf6\x56\x17\xa8\xfb\x4b\x69\x6e\x08\x2b\x32\x38\x57\xc8\xa2\x1b\x14\x6d\xbe\x21\xf7\xd1\xc6\x3c\x78\x14\x36\x89\xd4\x1a\xed\xaf\x9f\x7e\xff\xad\x58\xe5\xdc\x92\x14\x0a\xa7\xc6\xe9\xb8\xdd\xd5\xe6\xa0\x63\xff\xb0\xb0\x72\x05\xaf\x60\xcc\x08\xaf\x60\x95\xa7\x27\x74\xb9\x7a\xfb\x2d\xd5\x68\xad\x7f\x89\x9f\xd5\xfc\x3d\x8a\x59\xde\x5e\x97\x37\x3e\x41\x9e\xb6\xd7\x25\x7b\x35\x92\x3f\xd0\x73\x8e\xee\x4f\x3d\xe3\xff\xfb\xbf\xf9\x09\x9d\x3b\x3b\x1d\x7f\x10\xfe\x1d\x00\x00\xff\xff\x28\xc3\xb8\x6a\x18\x0a\x00\x00")
+var _postHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcd\x3c\x6b\x73\xdb\x46\x92\xdf\xf5\x2b\xc6\x70\x5d\x0c\x5e\xf8\x90\x64\xdf\xd6\x1d\x25\x2a\x75\xb1\x7d\x75\xbe\x4d\x9c\x5c\xec\xec\x6d\x95\x4e\x95\x1a\x02\x43\x11\x16\x08\x30\x00\x28\x99\xeb\xe5\x7f\xbf\xee\x9e\x9e\x17\x00\x52\x54\x92\xad\xba\x54\xc5\x02\xe6\xd1\xd3\xdd\xd3\xd3\xaf\x69\xf0\x72\xd9\xac\xf2\xab\x93\xcb\xa5\x92\x29\xfc\x59\xa9\x46\x8a\x42\xae\xd4\x2c\xba\xcf\xd4\xc3\xba\xac\x9a\x48\x24\x65\xd1\xa8\xa2\x99\x45\x0f\x59\xda\x2c\x67\xa9\xba\xcf\x12\x35\xa2\x97\xa1\xc8\x8a\xac\xc9\x64\x3e\xaa\x13\x99\xab\xd9\x59\x04\x40\xea\x66\x9b\xab\xab\x93\x93\x71\x55\x3e\x88\x2f\x27\x42\xac\x64\x75\x9b\x15\xa3\xa6\x5c\x4f\xc5\xd9\xbf\xac\x3f\x5f\x9c\xec\xa8\x73\xbc\x28\xab\xd5\xe8\xb6\x2a\x37\x6b\x1a\xb7\x96\x69\x9a\x15\xb7\xa3\x5c\x2d\x1a\x33\xd2\xb5\x56\xd9\xed\xb2\xf1\x00\xcc\x9b\xc2\x87\xee\x4f\xda\xc1\xe2\xc9\x52\x16\xb7\x6a\x94\x67\xc5\x1d\x0d\x9b\xcb\xe4\x0e\x97\x2a\xd2\x51\x52\xe6\x65\x35\x15\xcf\x4f\x4f\x4f\x71\x81\x79\x59\xa5\xaa\x1a\xcd\xcb\xa6\x29\x57\x04\x67\x54\xc9\x34\xdb\xd4\x53\xf1\x27\x8d\x42\x38\x82\x10\xe9\x0e\xc1\xbe\xa9\x20\x88\x66\x81\xc5\x62\x81\xaf\xe5\x5a\x26\x59\xb3\x85\xce\xf1\xbf\x7a\x14\x4d\xc5\x2b\xa6\xb0\xac\x81\x89\x65\x31\x15\x72\x5e\x97\xf9\xa6\x51\xd8\xda\xa8\xcf\xcd\x48\xe6\xd9\x2d\xb4\x27\xc0\x7f\x55\x61\x2b\xb1\x1d\xe9\x3c\x65\x36\x78\x74\x4e\x97\xe5\xbd\xaa\x88\xda\x16\x06\x04\x2b\x55\x49\x59\x49\xbd\x50\x51\x16\x8a\xf8\xf4\x5c\xa5\x59\x53\xea\x49\x4b\xa5\x59\x7c\x6e\x81\xc3\xb2\xd5\x76\x24\x13\x9c\x54\x8b\x7e\x96\x13\xc5\xdc\xc2\x7b\x64\x36\xe1\x72\x62\x64\xe1\x92\xf6\xa1\x52\xf9\x2c\xa2\xa6\x7a\xa9\x14\x88\x56\xb3\x5d\x83\xa8\x21\x76\x93\xa4\xae\x23\xb1\xac\xd4\x62\x16\xc1\x2c\x40\x33\x99\xcc\xcb\xb2\xa9\x9b\x4a\xae\xc7\xab\xac\x18\xe3\x80\xab\xdf\x06\xe8\x36\x6b\x96\x9b\xf9\xef\x81\xf0\xeb\x26\xcb\xf3\x71\x5d\x80\xd0\x32\x94\x3a\xa9\xb2\x75\x23\xea\x2a\x69\x8f\xfa\x04\xfd\x40\x39\xf5\xef\x19\x38\x2f\x3b\xc3\x26\x7c\x04\xe7\x65\xba\x85\x3f\x85\xbc\x17\x49\x2e\xeb\x7a\x16\xc1\xe3\x5c\x56\x42\xff\x81\x5d\x5c\xc8\x4d\xde\x00\x0a\x42\x5c\xa6\x99\x1d\x85\xa7\x54\x66\x85\xaa\xa8\x07\xfa\x64\x38\x7f\x34\xaf\x64\x91\x1a\xba\x9e\x47\x57\x80\xc3\xe5\x44\xf2\x60\x3c\x89\x22\x4b\x81\x25\x4a\x56\xc9\x32\x6a\xcd\xa5\x6e\x7e\xc6\x5d\x8f\x44\x55\xc2\x71\x37\xa3\x35\x90\x10\x1f\x77\xb6\x6d\x37\x0c\xc8\x8a\xf5\xa6\x09\x86\x20\xde\x00\x2c\xa2\xd5\x7f\xdd\xa8\x6a\x6b\xf6\xc2\xa0\xb2\xce\x65\xa2\x96\x65\x0e\x47\x70\x16\x7d\x68\xad\x38\x81\x25\x99\x86\x09\xc2\x6b\xd3\x93\x97\xb7\xe5\xa6\x39\x44\x0f\xc9\x6c\x24\xb4\x90\xc3\x16\x99\x19\xa0\x0b\x97\x25\x40\x80\xd3\xd9\xb8\xf5\x34\x01\x5a\x45\x26\x75\xb5\xf8\xa5\x29\xef\x54\x61\x50\x5e\x66\x69\x8a\x6f\xf7\x32\xdf\xf0\x80\xbf\xfe\xd5\x4d\x9e\x6f\x40\x49\x14\x06\x17\x3c\x4d\xf0\xbf\xdd\x51\x43\xf6\x66\xbe\xca\x60\xc5\xef\x08\x8f\xcb\x89\x9e\xd4\xa6\x91\x09\xbf\x9c\x00\x15\xf0\x67\xaf\x20\xf8\x3d\xa0\x72\x41\xe4\x82\xa1\xf9\xe8\x73\x3d\x3a\x3b\xd7\xdc\x5f\xa9\xba\x96\xb7\x0a\xc5\x12\x81\x5b\xde\x76\x60\x9c\xb4\x37\x1b\x01\xd5\xab\xd1\x4b\x47\xea\xf2\xd5\xd5\x9b\x32\xd9\xac\x40\x83\xd4\x20\xdb\xaf\xfa\x44\x24\xcf\xea\x86\x45\x84\xd6\x4f\x79\xc2\x08\x3b\xa2\xab\x60\x6f\xdd\x63\x77\xd9\x7f\x73\xcb\xda\x7d\xa7\x5d\x13\x72\xd3\x94\x49\xb9\x5a\xe7\xaa\x01\xce\x96\x8b\x85\x2f\x8b\xbd\x54\x75\xfb\x78\x91\x3f\x05\x03\x8e\x90\x75\x1e\x96\xcb\xb9\xca\x05\x8c\x70\xf4\x45\x96\x35\x97\x13\xea\xee\x4c\x7a\xe4\x98\x58\x40\x02\x39\xe5\xde\x6b\x5f\x8d\x59\x29\x2c\xca\x46\xd5\x5d\xc4\x52\xd9\x48\x9c\x1e\x40\x24\x9d\x64\x7a\x5a\x04\xbb\x3d\xd8\xd7\xf0\x0f\xe1\x1a\xd9\xa0\xe8\xea\x2d\xfe\xf9\x0d\xfc\x22\xf2\x34\x8c\x3e\xe6\x44\x4f\x23\xb2\xfd\x1a\xca\x90\xd8\x43\x12\x0d\x43\x3c\xaa\x0c\x75\x97\x7d\xd5\xb6\xb7\x73\xde\xec\x34\xc4\x54\x56\x4a\xf6\x53\x06\x0b\x42\x9b\x39\xbc\x9a\x2a\xb2\x68\xb0\x9d\x59\x0d\x5a\x73\xcb\x76\x1e\x17\x30\xa0\xf6\xee\xd7\x52\x25\x77\xf3\xf2\x73\x9b\x1f\x9a\xdf\xcc\x60\x52\x12\xb2\xba\x4b\xcb\x07\xab\xf2\xdc\x44\xf1\x3d\x77\xf5\xec\xd2\x93\xd8\x18\xf2\xae\x5f\x69\xae\xab\x0c\x30\xd9\xb6\x95\xe6\x07\x79\xaf\x42\x95\x79\x9c\xea\x45\xca\x0a\xf5\xa0\x47\x19\xa0\xfc\x76\xf5\x5e\x3d\x08\x16\xbf\x36\xe4\x80\x10\xa3\x9d\xcd\xab\xac\xc0\xd6\xe7\xca\x2c\x6a\x38\x37\x42\x13\xaf\x97\x44\x27\x1b\x77\x87\x47\x5a\x40\xa8\xd4\x2f\x37\xb9\x99\xb9\x06\xad\x5c\xe9\x19\xfa\x11\xa6\x6c\x60\x57\xb4\xf2\x0f\xf0\xb0\x76\x81\xfe\x18\xef\xe3\xea\x64\x32\x11\xcd\x52\x89\x5a\x55\xe8\x22\x96\x45\xbe\x15\x4b\x59\x53\x9b\x2a\x92\x6a\xbb\x6e\x54\x2a\x16\x19\xb8\x43\x43\xf1\xb0\x04\x31\x15\x20\x2d\x02\x5c\x46\xee\x5a\x2a\x78\x7d\x00\x3f\xca\x40\xba\x53\x5b\xf8\x1f\x1c\x1b\xd9\x08\x30\x99\x59\x21\xe2\x5a\x29\xa1\x3d\x9b\xc1\xc9\x3d\x78\x2c\x68\xfe\x3e\xa2\x79\x14\x33\x61\x6c\xe1\x05\xf5\xe0\x79\xcc\x54\x0d\xed\xd7\x37\x17\x44\x32\x40\x55\x80\xd9\x56\xc0\x3f\x35\x18\x62\x51\x2e\xb8\x81\xce\x2e\xcd\x92\x4d\x23\x93\x25\xa9\x29\x98\xf9\x65\x77\x41\xb3\x2c\xf6\x7e\xf7\x7c\x2b\xde\xbd\xa1\x49\x9b\x02\xa4\x3e\x95\x73\xd8\x07\x3d\x07\x27\x3d\x2c\xb7\x16\x87\xa4\xdc\xe4\x29\x1c\x94\x46\xcc\x3d\x82\x87\x02\xbc\x25\xb1\x84\x33\xbd\x92\x85\x5e\x1e\x37\x0b\xdc\x76\x00\x53\x6c\xf2\xfc\x42\x23\x8d\xac\x30\xca\x13\x5e\x80\x19\x59\x2d\x6a\x98\x57\xd0\x1c\xdc\xae\x0f\xd9\xdf\x70\xed\xb3\xd3\x0b\x43\x28\xaf\x0c\x54\x4a\x1a\x81\xc4\x4a\x07\x06\xdc\x71\xed\xfb\x68\x5e\x81\x92\xe8\x5d\x96\x18\x03\x01\x98\x7e\x21\x55\xa2\xb1\xc6\x77\xc3\xc6\xac\x11\x0f\xb2\x3e\x31\xbb\xc6\xde\x3e\x22\xa8\x37\xdf\x08\x25\xa1\x50\x68\x98\x46\x02\xb2\x5a\x0b\x0a\x68\x1c\x00\x87\x2c\xc6\x20\x0a\x41\x65\x0b\x06\x2c\x74\xf8\x91\x76\xd0\xa8\x89\xa3\x32\xcf\x11\xb0\x5d\x24\x81\x25\xe6\x7a\x94\x4a\x19\x27\x90\xab\x78\x53\x2b\x9a\x6e\x34\x08\x88\xd1\x67\x54\xa6\xd4\x58\xa9\xba\xd1\x02\x45\xfe\x35\x32\x02\x0e\xe4\x7f\xe3\x73\x1c\x71\xfc\x12\x0d\x29\x30\x81\xe1\x2b\x35\x15\x11\x3a\xea\xd1\x10\xe3\x92\x32\xdd\x80\x4c\x4f\xa9\x17\xfa\xcb\x32\x07\x9f\x6f\x2a\xae\xaf\xbf\xa0\xbf\xad\xf0\xf1\x6c\x28\xce\x87\xe2\xe5\x50\x2c\x64\x5e\xab\x9b\xdd\xcd\x50\x5c\x47\x20\xc7\x29\x00\x8d\x32\x34\x85\x09\x3e\x25\x65\xaa\xf0\x2f\x86\x0f\x11\x8e\xf9\x82\x36\x12\x16\xa3\xe0\x50\xa5\xd1\x0e\x70\xe0\xa6\x39\xec\x14\x04\x15\x1a\x14\x4e\x1c\xcd\xf3\x32\x81\x69\x37\x80\xc6\xee\x64\x37\xb8\xb0\x5b\x5b\x56\x1f\x41\x35\xe3\x09\x89\xfc\xc6\xd7\xcc\xd7\x99\xc6\xea\xe2\x44\xc7\x16\x65\x11\x93\xa6\x1f\x69\xbe\x03\x3e\x8b\x4d\x41\x9e\x6b\x9c\xaa\xbc\x91\x43\x01\x78\xbf\xd1\x4f\x75\xb9\xa9\x12\x35\x20\xd2\x61\xc3\x62\xfd\x2e\x66\x33\x58\x0b\x18\x5e\x45\x03\xe6\x4a\x7b\xc5\xa6\xda\x50\x04\xaa\x11\x3d\x31\x2b\x88\x5b\xd5\x20\xae\xb1\x03\x69\x44\x76\x0c\x5d\x6f\x73\x85\x8f\xdf\x6e\xdf\xa5\xb1\xb3\x12\x83\x31\x19\x08\x95\x9a\xc5\x2a\xd5\x6c\xaa\x42\xec\x9d\x49\x76\x6c\x30\x26\xf3\x3c\xae\x14\x39\xff\xf1\xe4\x7f\xab\xc9\x2d\xf0\x3e\x1a\x68\xbc\x2c\x98\x10\xf5\x6f\x48\xfd\x98\xb5\x7f\x58\xc4\x9a\x69\xb0\xc4\x6b\x9d\xc3\xa8\xe3\xc1\x40\x4c\x3d\xbe\x53\x98\x6a\x09\xac\x99\x40\xc4\x41\xe3\x1b\xec\x50\x43\x13\xc4\x71\xb8\x7b\xe3\x91\x53\xf8\x3c\xce\x55\x71\xdb\x2c\x69\x07\x4e\x0d\x3f\x38\xb2\xe4\x95\x0d\x85\x42\xc1\xa6\x07\x23\xd6\xb2\x6e\xd4\x7f\x7e\xfc\xfe\xbb\x18\x69\xac\x54\x01\x42\x47\x50\x87\xfb\xf1\xb1\x7e\xa2\xe1\x27\xe8\x9d\x55\x3c\x18\xd2\x0e\x0f\x2c\x2f\xf7\x48\x5c\xc0\x19\x50\x18\xdf\xeb\xf0\x20\xe6\x30\x61\x28\xf8\xe1\x23\x18\x4b\x4d\x0d\x8a\x2f\x9a\xf2\xd9\x7e\x94\x4c\x8c\x41\x8b\xc3\xd8\x71\x56\x40\xa8\x82\x74\xc1\xac\x17\xbe\x23\x20\x73\xd0\x39\x82\xfe\x1d\xbd\x10\x5f\xfb\xab\xc1\xdb\x0b\xe3\x35\xbd\x30\x80\x16\x59\x55\x37\xaf\x97\x59\x9e\x8e\x91\x2d\xbc\xe3\x00\x95\x27\x5e\x38\xa9\x09\x87\x13\xa5\xa0\x8b\x16\xaa\x49\x96\x6f\x59\x37\xeb\x91\xf5\x41\xa3\xa4\xf5\x3d\xeb\x34\x6b\x39\x1c\xd3\x7c\x80\xde\xa1\x79\x86\x1b\x08\xc6\xf7\xcf\x6a\x8b\xe2\x18\x1c\x8b\x1f\xab\x72\x95\xd5\x28\xf8\x9f\x54\xd2\xc4\xa8\xe8\xde\x56\x55\x59\xc5\x11\x84\x83\x02\xe2\x41\x52\xf0\xa0\x6c\xe5\xad\x44\x95\x5b\x9a\x75\xb5\x02\x06\x8d\xdd\xc8\x79\x34\x68\x1f\x13\xc2\x24\x8e\x26\x56\x20\x40\x55\x25\xa0\xb3\xe0\x31\x83\xcd\x46\x8d\x09\x11\xed\xa8\x84\x58\x38\x2b\xa2\xdd\x60\x0c\x8a\xb4\x88\xad\x6a\x01\x0d\xbc\x2e\x8b\x5a\x19\x64\x89\x0a\xd3\x38\x2e\xef\x4c\xbb\x5d\xcf\xf6\x35\xa4\x2f\x5a\xe0\xf8\x78\x01\xbe\xe8\x33\x3b\x1a\xa9\xfd\x42\xec\x08\x7b\x8d\x7f\x17\xe2\xa7\x1a\x20\x68\xfa\x7a\xd0\x04\x67\xce\x20\x13\x7a\x0b\xba\x6f\xec\x37\xfe\xfd\xef\xe8\x0f\xd0\xd8\xb6\x93\xd0\xb7\x25\x60\xcb\xe2\x1f\xe6\xb8\x2d\x63\xf0\x7d\x6a\x5e\x6d\x6c\x8c\x39\x41\x1b\x80\xf2\x59\x3b\x84\x30\x4d\xd0\xe1\x0d\xee\x3e\x6f\x1a\x1d\xf9\x10\xce\x35\xce\xb9\x61\xca\xfe\xeb\xc3\x0f\xef\xe1\xe0\x57\xc0\xf9\x71\x22\x71\x0b\x2d\x68\x55\x55\x0e\xb2\x4f\xc0\x35\xf4\x8c\x59\xe4\x6f\x80\x9a\x78\x5f\x17\x20\x0c\x4a\xe8\x6b\x71\x76\x61\xa1\x30\x86\xe4\x67\x70\xa3\xdd\x8c\x41\x3f\xcf\xad\xd8\xb7\xf5\xbb\x69\x87\x83\x96\x37\xa0\xaa\x1c\xe6\xb8\xf5\x46\x7d\x8b\x67\x33\xe3\xd7\xec\x78\x81\x50\xf1\xa0\x41\xb5\x89\x84\xd8\x53\x33\x65\x82\xdb\x4a\xcc\xb4\xdd\xcc\x43\x02\x74\x4f\x29\xb3\x15\x39\x99\xfe\xb6\xe1\xc4\x41\x07\x29\x7f\xa7\x9c\x85\xe2\xdd\x30\x7a\xfb\x4a\xe7\x3a\x81\x07\x75\x59\x35\xb1\x5d\x86\xa2\xe8\xd9\xe3\x6a\xb8\x8e\xbc\x29\xc5\x5d\x7d\xcc\x1c\x9d\x0b\xa1\x79\xf8\x14\xa8\x4b\xf4\x18\x84\x06\xd5\xd3\x4e\xc4\x63\x8a\xfd\xad\xf4\x05\xc7\xa7\x14\x31\x29\xd7\xc4\x67\x0f\x15\x50\x0c\xb2\x51\x8c\x4d\x1c\xe9\x01\x11\xcb\x81\x7e\xb3\x26\x0e\xa1\xe9\x0e\xc2\x4e\xae\xd7\x60\x98\x48\xab\xc6\x7a\x24\x4f\x23\xf7\xfd\xc0\x22\xd2\xc0\x97\x63\x52\xff\xef\x01\x2e\x52\xe2\x52\x44\x23\x70\x19\x57\x11\xc8\x2b\x51\x40\x36\xd4\x38\xe4\xdf\x08\x9d\xbe\xbb\x57\x11\x18\xf7\xc8\x81\xc2\x5c\x27\x0b\xc9\x5a\x55\x2b\x89\xac\xd2\x0c\x30\x23\x42\x4b\xe1\xa8\x41\x7c\xe7\x32\xbd\x55\x07\x70\xae\xd7\xd2\xb2\x85\xc6\x86\xa8\x53\x53\xe4\x77\x87\x8b\x75\xe4\xcb\xe0\xe4\x33\x91\x26\x0e\x0c\x87\x71\xa3\xfd\x5e\x69\x8f\x8c\x3b\xbb\xb8\xeb\xad\x23\x54\xca\x94\x4f\x8e\x6f\x0e\xac\x61\x6a\x9d\xe8\x05\x28\xa4\xa5\x75\x0d\x6d\xa0\x46\xcd\x8e\x39\xe6\x68\xb5\xce\xe7\x85\xb5\x0f\x5a\xfc\xdc\xc9\x11\x5f\x7d\xc5\x22\x99\x81\xeb\xf2\x19\x9c\x33\x4e\x4c\x0d\xc4\xa5\xf3\x86\xc4\xf1\xce\x0c\xef\x57\x7d\x7d\x7a\xe3\xdb\x0b\x0a\x3c\xcb\x0d\xb1\xf8\xd4\xe1\x0b\x5b\x57\xe3\x1d\x46\xa8\x0c\x9c\x6e\x6c\x69\x6e\x3d\xdc\x61\xa5\x21\x7e\x3d\xf3\x15\xad\x1e\x73\x73\xd1\x36\x7d\xd8\x0a\x72\x1a\x89\x18\xc5\xb5\x3b\x01\xfb\x06\x91\x51\xab\x8e\x63\x7a\x8d\x2b\x9f\x19\xbe\xdb\xc5\x28\x00\xdc\xc3\x91\x2b\x85\xea\x70\x0c\x60\x28\x13\x3d\xfe\x54\x66\x10\x37\x80\xff\x0c\xae\x5f\xf4\x20\xab\x02\x4e\x4d\x14\x58\x59\x0e\x0f\xed\x29\xd1\xae\x0d\x06\x78\x25\x88\x1b\x46\x7e\x0a\x34\x89\xc2\x48\xff\x16\x8f\x5c\x56\x58\xce\xd2\xf0\x19\xf8\xce\x35\xba\x48\x1f\xc0\x93\x04\x6c\x71\xf7\xde\xc1\x81\x8d\x31\x90\xa2\xcb\x29\xb3\x5e\x6b\x5c\xa5\x56\xe5\xbd\xea\x1f\x8a\x4c\x21\xe8\x20\x3a\xcf\x20\x7e\xa2\xbb\x2b\xf4\x9c\x96\x8e\x43\xe0\xf2\x00\xa4\xad\x89\x14\x3e\x34\x70\x42\x63\x34\x28\x18\x2d\x0c\x09\xb9\xd0\x9d\x00\x1f\x4a\x59\xff\x61\xbf\x51\xf5\x39\xef\x99\x4d\x80\x9a\xa2\x9b\x5c\x45\xbd\xd6\x0a\xbd\xa8\xbf\x68\x5f\x31\x86\x08\x6c\xee\x39\x7d\x12\x0d\xee\xb3\x79\xcb\xc8\x40\x33\x12\x37\x6f\xf9\x6b\x72\x0c\x81\x6b\xb6\xc8\x54\xfa\x4b\x93\xc1\xd2\x8d\x5c\xad\x49\xe5\xcd\xfb\x3a\x00\x82\xd6\x64\x3c\xa4\x31\x81\x0d\x66\x3a\x2a\xd0\x9b\x10\x80\xdf\x81\xbc\x48\xf2\xb5\x3c\x5f\xd6\x04\xfc\x94\xd8\xe1\x50\x3e\x4e\x36\x15\x44\x17\x0d\x88\xca\xa6\x80\xd0\x99\xd2\x04\x27\x9c\xb5\xd8\xda\x78\xbf\xce\x8a\x44\x05\x99\x06\x7c\xd6\xbd\x24\x37\x2b\x88\xae\xd1\x3f\x05\x15\x06\xee\x8b\x63\x11\x21\x64\x03\x47\x54\x96\x43\x0d\x5a\x3f\xe2\xe8\x21\xc3\x19\x62\x0a\xc0\x44\xb0\x7f\x8c\xea\xea\x55\x57\xa4\x32\x34\xd5\x6c\x2d\x72\x90\xa2\xba\xb1\xfe\xc4\xb5\x8f\x2e\x1e\xc1\x09\x9e\x2e\x8b\x35\xf9\x52\xce\x69\xa2\xed\x26\xcc\x71\x67\x0c\x60\xdc\x66\x5f\x3c\xb8\x5d\x53\x3c\x08\x8f\x3c\x18\x89\x45\x9e\x81\xff\x6f\x07\x59\x12\x7d\xa7\xef\x49\xac\xa4\x90\x4f\xf3\xab\x96\xf7\xe0\xaf\xb5\x3d\xbc\x1e\xf7\xcf\x69\x54\xd4\xb6\x2d\xf9\xf0\x88\xed\x3a\xb9\x6d\x18\xaa\x57\xa0\x2d\x73\x74\xe0\x5e\x80\xdc\x7e\xcf\xc3\xec\x32\x18\xae\x8f\xed\x9c\xc0\x33\xc7\x39\x98\xb2\x44\x72\x63\xd5\x16\x86\xbd\xce\x37\x67\x0c\xb5\x77\x5d\xc3\x0e\x17\xb7\xd9\x62\x1b\x03\x8f\xb4\xde\x38\x1f\x74\x9c\x5c\xd9\x48\x9f\xf1\xad\xa8\x8a\xee\x9a\x86\x5e\xbf\xe0\xcb\x43\xd0\xc2\x3f\xfe\xf0\xe1\x23\x65\xa2\xcc\x7f\xfb\xc3\x2e\x7f\x94\x4e\x4e\x61\xde\x2a\x62\x8f\x61\x84\x81\x6f\x04\x53\xc0\x01\x00\xd9\x20\x5d\x38\xc1\x60\x08\xf5\xfa\xb7\xa0\x39\x5f\x7f\xf8\xe9\x3f\x46\x94\x6e\x85\x51\x36\xf5\xba\xf3\xa1\x62\xe2\x79\x2a\x5a\x74\x7f\x41\x46\x4d\xc9\x96\x0e\x05\x52\x3a\xa5\x7f\x77\x03\x3b\x71\x37\xe8\x46\x03\x8f\x44\x88\x87\xe2\xb6\x47\x23\x37\x17\x60\x62\x60\x54\x6f\x92\x04\xd4\x90\xcf\xff\x76\xe0\xc8\x23\x59\x47\x3b\x6c\xf9\x2f\x1f\xe3\xf1\x7a\x53\x2f\x63\xd7\xdd\xab\x0b\x5c\x66\xc9\x37\xd0\x7f\x80\xa9\xe8\x1c\x0d\xb6\x1d\xa0\x59\xfd\x23\xef\xa5\x66\x9d\x62\x65\x53\x8c\xe7\x1d\x44\xc1\xd3\xbd\xac\xbd\x65\x8d\x60\xd0\xcf\xc3\xc4\x35\x28\x5d\xcc\xe8\x60\x86\x16\x33\xa8\x25\xdb\x00\xaa\x0d\xc9\x9a\x30\xa7\xd3\xd5\x34\x38\x8c\x26\xb8\x58\x4b\x67\x62\x66\xa1\x37\xa2\xc7\xdb\xe0\xcb\x6a\x45\xd3\xa1\x51\x43\x77\x25\x34\x19\x9c\x37\xd6\xc8\x6a\x17\xc9\x4c\xe9\x51\x11\xe8\x2a\x4d\xa3\xb6\xd7\x42\x99\xf5\xea\x90\x6f\x0e\xbd\x7a\x28\x3c\xb4\xfc\x6e\xb3\x9a\xc9\xce\x11\x75\x81\x5b\x0d\x73\xec\x32\x77\x4a\xad\x0f\xac\xc3\x57\x44\x34\x1c\x87\xb6\x42\x00\xbe\x66\x32\xc8\xdb\x51\x21\x46\x11\xde\x5a\x81\x31\xde\x3e\xc8\xad\x1b\x23\xd3\xf4\xed\x3d\x0c\xf8\x0e\xc4\x14\x5c\xb0\x2a\x8e\x12\xd8\xa9\xbb\xc8\xdf\xa2\x7e\xfc\x71\xfa\xc0\x24\x1e\x7d\x72\x29\xda\x8e\xb2\xdb\x02\x5d\x39\x7d\x27\xea\x87\x82\x20\x51\x59\x55\x1f\x49\xad\xe0\xe1\xfd\x14\x9b\x8b\xb5\x60\x64\x8b\xea\xb7\x28\xa4\x94\xb3\x62\x49\x0f\x47\xef\xa7\xbf\xc7\x18\xa2\xc0\xb7\x2c\x93\xd1\x55\x5d\xfe\xe8\x05\x38\x47\x16\xba\x6f\x60\x18\x63\x2f\x65\xfe\xac\x95\x14\x30\x6e\x1a\xf6\x29\xe7\x72\xf5\xb3\xd4\x3f\x2e\xd1\x1b\x85\x35\x08\x29\xb9\xe5\xaa\x7b\x6a\xd4\x98\x6f\x5e\x22\x56\x77\xbe\x0f\x6c\x6e\x80\xc0\x85\xe0\x47\x07\x00\x97\xf7\xe0\x79\x43\xf4\x01\xd4\xfd\xf4\xec\xb8\x05\x9a\x93\xee\x33\x63\xcf\x35\x0e\x52\xce\x01\xea\x28\x9d\x4f\x47\xdc\x5d\x5b\x7d\x31\x93\xa6\x1e\x00\xf6\x54\xa6\x0e\x00\xba\x2b\xf0\xba\x33\x61\x82\xce\x8e\x69\x2e\x33\x4c\xd6\x95\x9e\xea\xb3\xba\x91\xb6\xce\xba\xac\x19\x5d\xc3\xc9\x1c\x43\xaf\x2d\x5f\xc7\x59\x6e\x3a\x22\x9e\xb9\x24\x82\x63\x4e\x10\x68\x74\xb2\x07\x01\x05\xcc\xd7\xd0\x72\x78\x2c\x3d\xf1\xe2\x0d\x56\xf5\x78\xd2\xe9\xec\xd6\xfb\xee\xf0\xc0\x2e\xdd\x29\x51\xd7\x2a\x1d\xff\xbc\x06\x4b\x4c\x4e\x9d\x89\xed\x74\x18\x46\x57\x70\xfa\xa6\x11\x00\x84\xf2\x1b\xe6\xc8\x9c\xd7\x3a\x7b\xf2\x35\x81\x51\x81\xd6\xa5\x3c\x04\x83\x45\x3f\x00\x80\x6e\xb1\xf6\xeb\xb4\xb8\x91\x9f\x66\xc1\xea\xd3\xe3\x2e\x95\xbc\x55\xfd\xab\x25\x9c\x14\x5c\x9c\x20\xd4\xa0\xd3\xe6\x13\x26\x70\xf4\xae\xbc\xab\x95\x40\x8a\x3f\x02\x83\x29\x73\x04\x71\x4f\x70\x69\x8b\xf9\x7b\x8e\xa1\xd5\x6a\xdd\x6c\xd1\x68\x2e\x49\x21\x8b\x17\x93\x17\x74\x07\x58\x2c\xca\xd0\x88\xfb\x7a\x60\xef\xcd\x4e\xb0\xfc\x7b\x58\x41\x15\xe5\xe6\x76\xa9\x09\x07\xb3\x0c\x0a\x8e\x35\xc6\xc1\x35\x8e\x63\x39\x70\xd2\xee\x93\xe1\x30\x9e\xa8\xdf\x1f\xd0\x1c\xa3\x81\x02\x70\xfd\x3a\xc8\xc0\x36\xdc\x61\xe4\xcc\x48\x7c\x35\x14\x1f\x15\xd7\x58\x23\x60\x03\x85\x2f\x5e\x4a\xe2\x5e\x79\xd1\x85\x8b\xc2\xf6\x59\x41\x08\x40\xcc\xe0\x69\x2b\x92\x23\x58\x00\x01\xff\x3a\x8d\xef\x6e\x08\x3b\x3b\xfd\x56\x1f\xe9\x1a\x74\x11\x7b\x3e\xed\xfd\x7d\x34\xd2\xfa\x82\xe0\xa7\x42\xdf\xee\x59\x67\x68\xaa\x03\x9e\x12\x54\x51\xf3\x86\xd2\x1c\xe0\x00\xd3\xc3\x00\x82\xf5\xae\xf3\x34\xa5\x0c\x88\x53\xbe\x3d\x0c\x9d\x3a\xbe\xee\x42\x92\x3d\xd3\x3a\xf6\x23\x36\xcd\x86\x30\x1c\xa3\x31\xf2\xb6\xb6\xdd\xf0\xec\x7a\xc2\x7b\x18\x1a\xe0\x35\xf5\x5c\xf5\x28\xff\xa2\xc1\xc4\xaa\xbe\x99\x26\x63\xcf\x18\x3e\x25\x5d\xe8\x74\xf7\xc5\x93\xce\x95\x16\xe3\x8b\x93\xdf\x69\xd4\x7a\x4c\x5a\x28\x38\x0c\xfc\x51\x4b\xeb\x64\x09\x2c\x57\x5a\x3e\x8c\xeb\xa4\x2a\xf3\xfc\x63\x19\x9f\x0e\x41\xfd\x84\xcc\x72\xb6\xde\x5e\x68\x7b\xd5\x24\x4f\xe1\x83\xbe\x56\x08\x6e\xaa\xb5\x51\x4b\xc9\xb7\xd1\x72\xcf\xb6\xad\x75\xbc\x9c\x49\x7b\xe3\xc6\x3a\x1c\x3d\x00\x81\xf7\x95\x40\x74\x92\x55\x2b\xe3\x3d\x3d\xc2\x19\x7c\xff\x26\x1a\xf4\x7b\x6c\x5a\xa7\xf4\x58\x6f\x78\x38\xac\x4c\x16\x55\xb9\xf2\x94\x09\x68\x46\x15\xc4\x73\x7c\x58\x43\x8a\x83\x63\x8b\x10\xdc\x99\x39\xe6\xa8\x1e\x12\x29\x3c\x5d\x06\x26\x3c\x0e\xfd\x8b\x4e\x6e\xf7\x5a\x76\x7b\x0e\x13\x6c\x1b\xd6\xd5\x00\xc6\xc1\xbe\xa1\x65\x04\x7f\xbe\xb1\xc1\x27\x51\xcf\xc9\xdf\x20\xe2\xe4\x4d\xf3\xae\xbb\x7d\x78\xb1\x17\x3e\x32\x28\x73\x5d\xc7\x19\x5b\x63\x8b\x86\xa2\xcf\xa5\xea\x5c\xd0\x31\x90\x56\xfa\x94\x5b\xf7\xa9\x75\x77\x3b\x82\xe2\x64\xf0\x38\xc6\x58\xfb\x59\xd2\xc7\xa5\x0e\xad\x39\x53\x7f\xd8\x96\x73\xe0\x7a\x9f\x95\x1b\x64\x87\xc1\xe8\xba\x8d\xda\x48\x9c\xd1\xbd\xc2\x91\x52\xdb\xe2\x0a\x4b\xa4\x59\x68\xdc\xb1\x23\xae\xe7\x8f\x15\x4a\x07\xb7\x2b\x98\xb6\xef\x08\xe1\x0c\x52\x14\xff\x6e\xc7\xc7\x69\x76\xef\x43\xf5\x34\x45\xec\x5a\xc7\x99\x2e\x90\x70\xcb\x58\x95\x80\x75\x1f\xad\x20\xd4\x41\xd7\x57\x26\x1e\x9c\x82\x7d\x23\x9d\xa4\xf0\x3a\x6a\x2c\xe8\xc3\x8e\xf9\x16\xce\x8c\xb9\xca\x09\x77\xda\xbb\xe5\xf7\x70\xab\xaf\x03\x3c\x6f\x0e\x25\x1a\xa9\x78\xb1\xc2\x72\xb7\x9f\x7f\xfa\x8e\x23\x71\x7d\x77\x05\xef\x64\xfb\xbf\xcd\xcb\x79\x7c\x8d\x73\x6e\x86\xb0\xe9\xdb\x35\x98\x1a\x0f\x3e\x7f\xb7\xf5\x0b\x76\xf0\xf5\x3d\xbb\x18\x5a\xcb\x3b\x83\xbf\x67\x92\xf3\xac\xb3\x15\x9c\x0b\x74\xaf\x83\x33\x23\x0c\xa4\x03\x29\x83\x6c\x75\xeb\xdc\x1e\x1e\x3e\xae\xab\x04\xa6\x00\x71\xed\x0e\x99\x23\x2c\xf3\xd6\x64\x0d\x15\x64\xb4\xf6\xa4\x03\x0d\x6b\x9e\xc7\x2b\xf9\xf9\x7f\xf0\xdb\x28\xdc\xd4\xb3\xd3\xd3\x7f\x32\x17\x6c\x7e\x58\x7b\x0c\xc2\xb2\x8b\x2e\x5f\x23\xf7\xe0\x8b\x65\x66\x98\xc9\x7a\x1c\xc9\x7f\x98\xd8\xed\xac\x64\xfb\x29\x0e\x5e\x56\xe7\x38\x3c\x4d\xe1\xa5\x2d\xbb\x87\xa1\x07\x09\x8d\x9d\x97\xd5\xec\xbb\xf5\xd2\x69\x21\x2e\x46\xb3\xe9\xa0\xa1\x28\x8b\xd7\x98\xb1\x71\x96\x60\x7e\x64\x6e\x69\xde\x9f\x54\xfa\x5c\x13\x36\xb6\x4f\x0f\x0d\x69\x30\x59\xbd\xf9\x31\xf9\x23\x75\xef\xd9\x7a\x7a\x19\xa3\x9a\x82\xbf\x6f\x74\xfe\xca\x24\x48\x98\x92\xb8\x7b\xdf\x3e\xef\x2a\x2c\x6d\x00\x31\xaf\x00\x7a\xd2\x11\xbf\x3c\x3f\x40\xfd\xf2\x5c\x53\xbe\x3c\x47\xf5\x05\x34\xeb\x2f\xef\x3c\x3b\x63\x82\xba\x23\xaa\x28\xf6\x14\x3e\xec\x4b\x5d\xb4\xab\x20\xbc\x05\x01\x9d\x6e\xbd\x01\x52\x16\x34\x2f\xcf\x6d\x50\x5f\xaf\x24\x55\xe8\xee\xad\x99\xc0\x7e\x8d\x25\x3d\x76\x96\x0e\x42\x8b\xce\x4a\x34\xc7\x2e\x66\xbe\x4b\xdc\xbf\x1c\xc8\x38\xb3\x44\x0f\x0d\xe5\x2a\xf8\xba\x31\xf2\x87\x05\x25\x18\x5a\xba\xc9\x3d\x47\xeb\xee\xa7\x37\x43\x1b\x6c\x02\x93\x0b\x53\x2d\x75\x08\x9c\x76\x69\x2d\x40\x7d\x53\xd0\x82\x17\xfa\xc5\x16\x6c\x87\x2d\xbc\x8e\x65\x4c\xab\x06\x73\x1f\x53\x50\x03\x04\x0c\xc1\x6f\x2f\xfa\xea\x32\xbd\x8a\x53\xc5\xde\x84\x13\xa6\x7e\x94\x00\x04\x75\xc4\xaa\x5d\x73\x77\x0d\x56\xaf\x53\x95\xd4\x36\xea\x4f\xa2\xe3\x00\x25\x07\x30\x13\x8f\x79\x17\xdd\x4b\x9a\x1f\xf1\xe3\x0b\xef\x86\x46\x57\x71\x81\xf3\x47\xb5\x13\xd0\x59\x1b\x57\x19\xab\x34\xe5\x02\x3c\xd8\x50\x35\x10\x80\x78\x4d\xb7\x43\x34\x9e\x2b\x14\xac\x92\xa0\xcf\x3b\x0e\xe5\xd9\xf4\xf7\x1f\xfa\x6a\x03\x1f\x7b\x0a\xbf\xd0\xa2\x6b\x64\x2e\x67\xe2\xac\x3f\x1c\xba\xbe\x8e\x8c\x43\x16\x69\x54\xd0\xe7\x04\x61\x7c\xaf\x1e\x00\x3e\xd5\xad\xe3\x75\xac\xe9\xfc\x9a\x3a\x7f\xc0\x6f\x30\xa3\x9b\x9b\xee\xfe\xad\xc3\xf4\xcb\x81\x5d\xcb\xb3\xc8\xd6\x33\x05\x7b\xb6\xbe\x3e\xc5\xd2\x98\x78\x7d\x7d\x76\x23\x2e\xc5\x19\xca\x0a\x3d\x5f\x31\x6b\xb1\xcc\x2b\xcd\x6a\x2c\xa5\x49\x83\x42\xaf\x27\x14\x97\x85\xea\x66\x7d\x7d\x7e\xe3\xbc\x20\xbd\x18\xb0\x0c\xd3\x3d\x1a\x8b\x99\x5e\xda\x39\x3c\x56\xb5\x92\x46\xc5\x41\x41\x75\x09\x90\xd4\xd5\x97\x66\xa7\xfc\x9e\x3c\xf3\x4c\xc9\xd1\x3c\x03\xf0\x2d\x3f\x82\x0c\x22\xef\x50\x24\x26\xf6\xb5\xa6\xf7\xc8\x17\x93\x5a\x55\xcd\xb7\x24\x9d\xb0\xfa\x90\x9b\x31\xd6\x23\x8c\x02\x39\x37\xb7\xa2\x2c\xea\xee\x23\x15\x13\x01\xd2\xd7\x2b\xfe\x37\x2b\x43\xcc\x33\x80\xe2\x16\x54\x56\x0d\xa6\xbf\x32\x5f\x9c\xd0\x54\xaa\x2f\xb1\xe1\x63\x78\x26\xcc\x62\xfb\x13\x7e\x08\x82\x8f\x88\xfd\x04\xc7\x1f\x6c\x8a\x23\x5b\x97\xb9\x5e\x69\x66\xdc\x5f\x27\x1a\xa4\x41\x6f\x8c\x72\xaa\xc1\xd4\xab\x78\x30\x46\x4f\xa0\xaa\xbd\x8c\xb5\x66\xec\x4c\x7c\x2f\x9b\x25\xfa\x9b\x31\x9c\x09\x7a\x4e\x54\x96\xc7\x54\x00\xc9\xf1\xdb\xc4\x7e\xf8\x33\xb0\xb3\x33\xae\x96\x83\xd0\xb6\x48\xdf\xa1\x67\xbd\xa7\x26\xb6\x2f\x6b\x7a\xe1\xc5\xb1\x59\x90\xe4\x26\xfe\x32\x4e\x8b\xbc\x2c\x2b\xe8\xf7\x96\x37\x05\xbe\xbb\x93\x70\xe8\x2a\x2b\x62\x4b\x07\x75\x00\xf5\x40\xcf\xd9\x80\x35\x93\xc5\x1b\x79\x7e\x48\x23\xd1\x37\x6c\xce\x08\x04\xfa\xe8\x72\x79\x76\x75\x39\x81\x7f\x22\xdb\xbf\xb7\x4c\xbf\x6f\x47\x79\x2f\x62\xa3\xa0\x06\xe2\x9f\x2d\x69\xac\x99\x5c\x43\x8f\x59\x51\x7e\x78\x1f\xfa\x65\xe1\xf1\xe3\x64\x86\xa9\x28\xd6\x6f\x2e\x45\xe1\xe3\xe6\x6e\x28\xf4\xa8\x56\x8d\xa3\x66\xf2\xac\xa5\x39\xc8\x01\x7c\x79\xc8\x01\x7c\x69\x94\xd4\xf2\x65\xfb\x90\x9b\xbb\x43\x46\x67\x9f\x61\x5b\xbe\x34\xd6\x90\x11\xdb\xcf\x8e\x27\x9a\xd7\x1e\x03\xdb\xf5\x9d\xfa\xc2\x10\xe3\xec\xfc\xe4\x92\x23\x07\xdc\xa7\x56\x02\xc9\xfa\x3b\x7d\x90\x5b\x58\x63\x4a\xf2\x7d\x99\x62\xae\x44\x3b\xb6\x76\xe2\x01\xfb\xbf\xb3\x9f\x49\xec\xb1\xcd\x5d\xf3\xe6\x95\x11\x39\xc7\x3a\x90\x0e\xca\x42\xe2\xfc\x19\xe9\xe2\x50\xcc\x64\x91\x2c\xcb\xea\xd1\x7b\xb4\x51\x70\x1b\x63\x05\xce\x5d\xc4\x61\xc5\x1f\x81\xb2\x1f\x3b\xd0\x1b\x27\x82\xdf\x15\x4d\xf9\x17\x20\x3b\x76\x57\xdc\xac\xd8\xf5\xaf\x27\x1c\x54\xeb\x74\xa3\x4a\xf7\x5f\xfa\x4b\x97\x87\xb2\x4a\xcd\x18\xfa\x79\x86\x50\x7b\x6b\x88\x31\xf5\xf4\x6b\x6a\x93\x67\xde\xa3\xa1\x17\xf8\x93\x2c\x7c\xee\xf4\x57\x8f\xee\xd8\x11\xd4\x23\xd5\x2f\xc1\xd9\xa7\x7f\xff\x7f\x6b\xbe\x00\x75\xf0\x80\x82\x77\x54\x25\x67\xe4\xfb\x70\x2e\x7d\xea\x2a\x80\x23\x92\x36\x6d\x5d\x5f\xa0\xcc\x10\xc3\xb0\xed\x05\x2d\xab\x01\xfd\x51\x3a\x94\x74\xd8\xab\x43\x3a\xec\xd5\x6f\xf0\xc8\x9e\x14\xaa\xf6\x05\xab\xfb\x12\xb3\xac\x4d\x5f\xf5\x7a\x64\x5d\xe5\xf9\xca\x43\xfd\x09\x51\xec\x51\x71\xec\xc1\x48\x96\x85\xfb\x50\x31\x93\x59\x6a\xdd\x5d\xc6\xbb\x7c\xbe\x12\xe7\xa7\xa7\x20\x29\xdc\xaa\xb7\xfd\x74\x88\xad\x24\x27\xe3\xf1\x38\xd2\xd5\x94\x9c\x23\xe9\x41\x6b\xed\xd9\xc5\xa7\x2a\xc5\xe8\xf9\x37\xbf\xce\xb4\xe6\xc2\x4f\x5e\x7f\xfe\xe9\xdd\xeb\x72\xb5\x2e\x0b\xa4\x41\x9f\x64\xc4\xe2\xab\xae\x6e\xe4\xeb\x08\xac\x94\x60\xd5\xf4\x80\x4a\x08\x35\x8e\x4c\xd3\x8a\x0a\x3a\x6a\x1d\x4e\x51\xe3\xf3\xa9\xff\xb5\xb4\xac\xc5\xf3\x89\x51\x2c\x43\x84\x64\xcb\x9a\x83\xae\xc9\x5b\x9d\xaa\xa6\xd0\x8c\x3f\xb0\xa6\x11\x80\x35\x2a\xb9\xda\xbb\xc9\xd0\x45\x1b\x2e\x75\xa3\x2b\x41\x82\xca\x10\xe6\xef\x99\xa7\x9e\x2a\xb9\xaa\xed\xf7\x63\xc6\xe5\xa3\xb1\x36\x91\xfa\x4d\xb4\xc7\x8d\xf3\x40\x66\xe8\xb3\x81\x1b\xba\xce\x33\xd8\xfc\xaf\xa2\x9e\x33\x49\x6b\x85\xc6\xfc\x13\xc6\x33\xd8\xec\x16\x9b\x39\xfb\x8d\x0b\x7e\x0a\xbf\x2d\x10\x8c\xf1\xb5\x9e\x65\xc5\xe5\xd3\xe0\xc6\x82\xd2\x8d\x9f\x08\xa1\xb0\xaa\xd2\x54\x57\x31\x6b\x3c\xf4\x01\x44\x66\x0d\x2b\xee\x82\xd3\x1e\xac\x86\xe9\x8b\x36\x30\x52\x9a\x8c\x7a\xac\xc5\xeb\xec\x94\x0a\x46\xce\x5c\x40\x16\xfd\x1a\x61\x26\x5f\x8f\x3a\xe2\x0b\x11\xfd\xeb\x41\xee\xc2\x12\x7f\xf0\xaa\x25\x87\xbc\xe2\xaf\x96\x1a\xcf\x86\x1d\x1a\xce\xa6\x6d\x5f\xa5\x8f\x95\x00\xba\xe3\xd6\xcc\xd0\xdb\x37\x89\xf4\x97\x25\x5d\xe0\x5e\x81\x17\x4d\xb4\xc7\x78\x26\xce\xb5\x23\x09\x8d\x10\x60\x7a\xfe\x65\x58\x71\x60\x03\x27\x33\x72\xc8\x73\xce\x6f\x1e\x41\x77\x27\xa8\x9a\x55\xec\xab\x62\xa5\x6a\x15\xa9\xbf\xec\xc0\x42\x15\xef\x12\x08\x0f\x6e\x70\x0e\x82\xfb\xae\xdd\xb1\x96\xff\x11\x9b\xda\x93\xd5\x78\x2c\x23\xd2\x99\x02\x5a\x65\xef\x1c\xfe\x8d\xa7\x41\x4f\x92\x98\x7f\x49\xa4\x73\xbb\x46\x55\x34\x34\x8d\xaa\xb9\xe0\xff\xfd\x18\x61\x3d\xf8\x71\xb0\xd9\xb8\xaa\xde\xbc\xb3\x2e\xe7\x7a\x64\x31\xf7\xab\x25\x83\x03\x29\x6f\x53\x05\x70\x08\x92\xf7\xcd\x7e\x0f\xa0\xce\xef\x0d\x38\xf5\x68\x7f\xde\x61\x46\xe5\x9c\xe6\x8b\x7f\xa3\xe8\x4c\xb7\xbd\x78\x38\xf2\xf3\xf9\xdf\xff\x6d\x7f\xbb\xaa\x91\x4b\x17\x7e\xeb\x6f\x0f\x0c\x1e\x2d\xc9\x62\x00\xfa\x46\x8a\x7f\x84\x07\xbf\x40\x37\x0c\x02\x37\x8e\x1c\x38\xfc\x59\x9e\xc3\x52\x4d\xbf\x14\x74\x18\x12\x01\xa1\x3c\xd8\x23\x22\xc2\x3f\xa4\xf6\x07\x48\x64\xbb\x36\xf2\x90\xc9\x3f\x4e\x4d\x73\xd5\x1f\x4b\x39\xd7\xb3\x74\xf1\xc4\xf5\xac\x08\x92\x79\x86\xd1\xfa\xc3\x46\xf3\xd3\x18\x0b\x58\x8f\xb4\x8b\xff\xa3\x7a\xfa\xd7\xf4\xf0\xd7\xf5\xe8\x77\x2e\xff\x0f\xba\x3d\x36\x72\xef\x52\x00\x00")
 
 func postHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -209,7 +230,7 @@ func postHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "post.html", size: 2584, mode: os.FileMode(420), modTime: time.Unix(1490016992, 0)}
+	info := bindataFileInfo{name: "post.html", size: 21231, mode: os.FileMode(420), modTime: time.Unix(1792359264, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -266,6 +287,7 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"static/bol.js": staticBolJs,
 	"static/bootstrap.min.css": staticBootstrapMinCss,
 	"static/github.css": staticGithubCss,
 	"static/jquery.min.js": staticJqueryMinJs,
@@ -318,6 +340,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"login.html": &bintree{loginHtml, map[string]*bintree{}},
 	"post.html": &bintree{postHtml, map[string]*bintree{}},
 	"static": &bintree{nil, map[string]*bintree{
+		"bol.js": &bintree{staticBolJs, map[string]*bintree{}},
 		"bootstrap.min.css": &bintree{staticBootstrapMinCss, map[string]*bintree{}},
 		"github.css": &bintree{staticGithubCss, map[string]*bintree{}},
 		"jquery.min.js": &bintree{staticJqueryMinJs, map[string]*bintree{}},
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// TestXChaChaVector checks the vector that bol.js opens before decrypting
+// XChaCha20-Poly1305 in the browser
+func TestXChaChaVector(t *testing.T) {
+	js, err := Asset("static/bol.js")
+	if err != nil {
+		t.Fatal(err)
+	}
+	vector := regexp.MustCompile(`(?s)var xchachaVector = \{\s*key: "([0-9a-f]+)",\s*sealed: (.+?),\s*text: "([^"]+)"`).FindSubmatch(js)
+	if vector == nil {
+		t.Fatalf("No xchachaVector in bol.js, run go-bindata again")
+	}
+	sealed := strings.NewReplacer(`"`, "", "+", "", " ", "", "\n", "").Replace(string(vector[2]))
+	key, _ := hex.DecodeString(string(vector[1]))
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonce, _ := hex.DecodeString(sealed[:2*chacha20poly1305.NonceSizeX])
+	if want := hex.EncodeToString(aead.Seal(nonce, nonce, vector[3], nil)); sealed != want {
+		t.Errorf("bol.js has\n%s\ninstead of\n%s", sealed, want)
+	}
+}
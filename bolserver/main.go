@@ -5,6 +5,7 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"html/template"
 	"io"
 	"io/ioutil"
 	"log"
@@ -12,27 +13,20 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"path/filepath"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
-	"github.com/russross/blackfriday"
-	"github.com/schollz/bol/ssed"
-
 	"github.com/schollz/bol/utils"
-	"github.com/schollz/cryptopasta"
 )
 
 // https://gist.github.com/tristanwietsma/8444cf3cb5a1ac496203
 type handler func(w http.ResponseWriter, r *http.Request)
 
 var wd string
-var apikeys = struct {
-	sync.RWMutex
-	m map[string]string
-}{m: make(map[string]string)}
 
 var MaxArchiveBytes int
 var Port, Host string
@@ -44,11 +38,24 @@ func main() {
 	flag.IntVar(&MaxArchiveBytes, "limit", 10000000, "limit the max size (bytes) of archive with backups")
 	flag.StringVar(&Port, "port", "9095", "set port")
 	flag.StringVar(&Host, "host", "", "set hostname")
+	flag.Int64Var(&DefaultQuota, "quota", 10000000, "limit the size (bytes) of the archive of a user")
+	flag.Var(userQuotas, "user-quota", "set the quota (bytes) of a user as username=bytes, 0 for the default (repeatable)")
+	flag.DurationVar(&SessionTimeout, "session", 30*time.Minute, "log out of the website after this long without use")
 	flag.Parse()
 	wd, _ = os.Getwd()
+	var err error
+	users, err = openUserStore(path.Join(wd, "users.db"), path.Join(wd, "logins.json"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer users.Close()
+	if err = setUserQuotas(); err != nil {
+		log.Fatal(err)
+	}
 	http.HandleFunc("/", HandleLogin)
 	http.HandleFunc("/login", HandleLoginAttempt)
 	http.HandleFunc("/register", HandleRegisterAttempt)
+	http.HandleFunc("/logout", HandleLogout)
 	http.HandleFunc("/document", HandleDocument)
 	http.HandleFunc("/post", HandlePostAttempt)
 	http.HandleFunc("/static/", func(w http.ResponseWriter, r *http.Request) {
@@ -59,110 +66,153 @@ func main() {
 		w.Write(data)
 		// http.ServeFile(w, r, r.URL.Path[1:])
 	})
-	http.HandleFunc("/repo", HandleRepo)    // POST latest repo
-	http.HandleFunc("/md5", HandleCheckMD5) // GET latest MD5 for user
+	http.HandleFunc("/repo", HandleRepo)     // POST latest repo
+	http.HandleFunc("/md5", HandleCheckMD5)  // GET latest MD5 for user
+	http.HandleFunc("/key", HandleKey)       // GET/PUT public keys for sharing
+	http.HandleFunc("/share", HandleShare)   // GET/POST/PUT/DELETE a shared document
+	http.HandleFunc("/shares", HandleShares) // GET shared documents of a user
+	http.HandleFunc("/salt", HandleSalt)     // GET the salt of the key of a user
 	if Host == "" {
 		Host = GetLocalIP() + Port
 	}
 	fmt.Printf("Running on http://%s:%s, aliased as %s\n", GetLocalIP(), Port, Host)
 	fmt.Printf("Saving up to %d MB for archives\n", MaxArchiveBytes/1000000)
+	fmt.Printf("Archives of users can have up to %d MB\n", DefaultQuota/1000000)
 	log.Fatal(http.ListenAndServe(":"+Port, nil))
 }
 
+// HandleLogin shows the post page to users that are logged in
 func HandleLogin(w http.ResponseWriter, r *http.Request) {
-	ShowLoginPage(w, r, "", "")
+	s, ok := getSession(r)
+	if !ok {
+		ShowLoginPage(w, r, "", "")
+		return
+	}
+	page, err := Asset("post.html")
+	if err != nil {
+		log.Println("Error finding asset")
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	fmt.Fprintf(w, "%s", strings.Replace(string(page), "csrfXX", s.csrf, -1))
 }
 
+// HandleDocument returns the encrypted entries and attachments of the user
+// of the session, which the browser decrypts
 func HandleDocument(w http.ResponseWriter, r *http.Request) {
-	documentName := r.URL.Query().Get("document_name")
-	apikey := r.URL.Query().Get("access_token")
-	var username, password string
-	apikeys.Lock()
-	if val, ok := apikeys.m[apikey]; ok {
-		username = strings.Split(val, "=")[0]
-		password = strings.Split(val, "=")[1]
-	} else {
-		apikeys.Unlock()
-		ShowLoginPage(w, r, "Incorrect API key", "danger")
+	s, ok := getSession(r)
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		io.WriteString(w, "Session expired, log in again")
 		return
 	}
-	delete(apikeys.m, apikey)
-	apikeys.Unlock()
-
-	var fs ssed.Fs
-	fs.Init(username, Host)
-	fs.Open(password)
-	defer fs.Close()
-	html := fmt.Sprintf("<h1>%s</h1>", documentName)
-	for _, entry := range fs.GetDocument(documentName) {
-		html += fmt.Sprintf("<h2>%s</h2><small>%s</small>", entry.Entry, entry.Timestamp)
-		html += fmt.Sprintf(`<div class="row">%s</div>`, string(blackfriday.MarkdownBasic([]byte(entry.Text))))
+	files, err := readArchive(s.username)
+	if err != nil {
+		log.Printf("DOCUMENT: Could not read archive of '%s': %s", s.username, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, "Could not read archive")
+		return
 	}
-	io.WriteString(w, html)
+	response := webDocument{
+		Entries:     make(map[string]string),
+		Attachments: make(map[string]string),
+	}
+	for name, b := range files {
+		switch filepath.Ext(name) {
+		case ".json":
+			response.Entries[name] = string(b)
+		case ".attachment":
+			response.Attachments[strings.TrimSuffix(name, ".attachment")] = string(b)
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(response)
+}
+
+// webDocument is what the web interface gets of a repository
+type webDocument struct {
+	Entries     map[string]string `json:"entries"`     // encrypted, by file name
+	Attachments map[string]string `json:"attachments"` // encrypted, by ID
 }
 
-type loginInfo struct {
-	Username string `json:"username"`
-	Password string `json:"password"`
+// webPost is an entry the browser encrypted, named like ssed names its
+// files
+type webPost struct {
+	Name string `json:"name"`
+	Data string `json:"data"`
 }
 
+var (
+	entryFileRegex = regexp.MustCompile(`^[0-9a-f]{64}\.json$`)
+	hexRegex       = regexp.MustCompile(`^[0-9a-f]+$`)
+)
+
+// HandlePostAttempt adds an entry that was encrypted in the browser to the
+// archive of the user
 func HandlePostAttempt(w http.ResponseWriter, r *http.Request) {
-	body, err := ioutil.ReadAll(r.Body)
-	if err != nil {
-		ShowLoginPage(w, r, "Problem handling post", "danger")
+	w.Header().Set("Content-Type", "application/json")
+	s, ok := getSession(r)
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(map[string]interface{}{"success": false, "message": "Session expired, log in again"})
 		return
 	}
-	if !strings.Contains(string(body), "=") {
-		ShowLoginPage(w, r, "You need to login first", "info")
+	if r.Method != "POST" || !checkCSRF(r, s) {
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]interface{}{"success": false, "message": "Incorrect CSRF token, reload the page"})
 		return
 	}
-	lines := strings.Split(string(body), "=")
-	apikey := strings.TrimSpace(strings.Split(lines[1], "document")[0])
-	document := strings.TrimSpace(strings.Split(lines[2], "entry")[0])
-	entry := strings.TrimSpace(strings.Split(lines[3], "data")[0])
-	if len(entry) == 0 {
-		entry = utils.GetRandomMD5Hash()
+	if r.ContentLength > quotaOf(s.username) {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		json.NewEncoder(w).Encode(map[string]interface{}{"success": false, "message": "The entry is larger than your quota"})
+		return
 	}
-	text := strings.Join(strings.Split(strings.TrimSpace(lines[4]), "\r"), "")
-	var username, password string
-	apikeys.Lock()
-	if val, ok := apikeys.m[apikey]; ok {
-		username = strings.Split(val, "=")[0]
-		password = strings.Split(val, "=")[1]
-	} else {
-		apikeys.Unlock()
-		ShowLoginPage(w, r, "Incorrect API key", "danger")
+	r.Body = http.MaxBytesReader(w, r.Body, quotaOf(s.username))
+	var post webPost
+	if err := json.NewDecoder(r.Body).Decode(&post); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]interface{}{"success": false, "message": "Problem handling post"})
 		return
 	}
-	delete(apikeys.m, apikey)
-	apikeys.Unlock()
-	if len(strings.TrimSpace(text)) > 0 {
-		go updateRepo(username, password, text, document, entry, "")
-		ShowLoginPage(w, r, "Updated entry", "success")
-	} else {
-		ShowLoginPage(w, r, "Not enough text to add entry", "info")
+	if !entryFileRegex.MatchString(post.Name) || !hexRegex.MatchString(post.Data) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]interface{}{"success": false, "message": "Not an encrypted entry"})
+		return
 	}
+	err := addToArchive(s.username, post.Name, []byte(post.Data))
+	if err == ErrQuotaExceeded {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		json.NewEncoder(w).Encode(map[string]interface{}{"success": false, "message": fmt.Sprintf("Could not save entry, the archive would be over your quota of %d bytes", quotaOf(s.username))})
+		return
+	} else if err == ErrFileExists {
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(map[string]interface{}{"success": false, "message": "This version is already saved, reload the page"})
+		return
+	} else if err != nil {
+		log.Printf("POST: Could not add to archive of '%s': %s", s.username, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]interface{}{"success": false, "message": "Could not save entry"})
+		return
+	}
+	log.Printf("POST: Added entry for '%s'", s.username)
+	json.NewEncoder(w).Encode(map[string]interface{}{"success": true, "message": "Updated entry"})
 }
 
-func deleteApikeyDelay(apikey string) {
-	time.Sleep(30 * time.Minute)
-	log.Printf("Deleting apikey: %s\n", apikey)
-	apikeys.Lock()
-	delete(apikeys.m, apikey)
-	apikeys.Unlock()
-}
-
-func updateRepo(username, password, text, document, entry, date string) {
-	var fs ssed.Fs
-	fs.Init(username, Host)
-	fs.Open(password)
-	fs.Update(text, document, entry, date)
-	fs.Close()
+// HandleLogout ends the session of the website
+func HandleLogout(w http.ResponseWriter, r *http.Request) {
+	s, ok := getSession(r)
+	if r.Method != "POST" || (ok && !checkCSRF(r, s)) {
+		w.WriteHeader(http.StatusForbidden)
+		ShowLoginPage(w, r, "Incorrect CSRF token", "danger")
+		return
+	}
+	endSession(w, r)
+	ShowLoginPage(w, r, "Logged out", "success")
 }
 
 func ShowLoginPage(w http.ResponseWriter, r *http.Request, message string, messageType string) {
 	messageHTML := `<div class="col-xs-12"><div class="alert alert-` + messageType + `">
-  ` + message + `
+  ` + template.HTMLEscapeString(message) + `
 </div></div>`
 	if len(message) == 0 {
 		messageHTML = ""
@@ -176,113 +226,58 @@ func ShowLoginPage(w http.ResponseWriter, r *http.Request, message string, messa
 	fmt.Fprintf(w, "%s", pageS)
 }
 
+// HandleRegisterAttempt adds a user with the authentication key the
+// browser derived from the password
 func HandleRegisterAttempt(w http.ResponseWriter, r *http.Request) {
-	body, err := ioutil.ReadAll(r.Body)
-	if err != nil {
-		ShowLoginPage(w, r, "Error reading body", "danger")
-		return
-	}
-	if !strings.Contains(string(body), "&") {
-		ShowLoginPage(w, r, "Bad login attempt", "danger")
-		return
-	}
-	data := strings.Split(string(body), "&")
-	username := strings.TrimSpace(strings.Split(data[0], "=")[1])
-	password := strings.TrimSpace(strings.Split(data[1], "=")[1])
-	if len(username) == 0 && len(password) == 0 {
+	username := strings.TrimSpace(r.PostFormValue("username"))
+	authKey := r.PostFormValue("auth_key")
+	if len(username) == 0 || len(authKey) == 0 {
 		ShowLoginPage(w, r, "Username and password must not be empty", "danger")
 		return
 	}
 
-	hashedPassword, _ := cryptopasta.HashPassword([]byte(password))
-	creds := make(map[string]string)
-
-	if utils.Exists(path.Join(wd, "logins.json")) {
-		data, _ := ioutil.ReadFile(path.Join(wd, "logins.json"))
-		json.Unmarshal(data, &creds)
-		if _, ok := creds[username]; ok {
-			ShowLoginPage(w, r, "User '"+username+"' already exists", "info")
-			return
-		}
+	if !addLogin(username, authKey, true) {
+		ShowLoginPage(w, r, "User '"+username+"' already exists", "info")
+		return
 	}
-	creds[username] = string(hashedPassword)
-	b, _ := json.MarshalIndent(creds, "", "  ")
-	ioutil.WriteFile(path.Join(wd, "logins.json"), b, 0644)
 	ShowLoginPage(w, r, "Added user '"+username+"'", "success")
 }
 
+// HandleLoginAttempt checks the authentication key the browser derived
+// from the password, and starts a session with a cookie. The browser keeps
+// the encryption key, so the password never reaches the server.
 func HandleLoginAttempt(w http.ResponseWriter, r *http.Request) {
-	body, err := ioutil.ReadAll(r.Body)
-	if err != nil {
-		ShowLoginPage(w, r, "Error reading body", "danger")
-		return
-	}
-	if !strings.Contains(string(body), "&") {
+	username := strings.TrimSpace(r.PostFormValue("username"))
+	authKey := r.PostFormValue("auth_key")
+	if len(username) == 0 || len(authKey) == 0 {
 		ShowLoginPage(w, r, "Bad login attempt", "danger")
 		return
 	}
-	data := strings.Split(string(body), "&")
-	username := strings.TrimSpace(strings.Split(data[0], "=")[1])
-	password := strings.TrimSpace(strings.Split(data[1], "=")[1])
-	creds := make(map[string]string)
-	loginData, _ := ioutil.ReadFile(path.Join(wd, "logins.json"))
-	json.Unmarshal(loginData, &creds)
-	authenticated := false
-
-	if passwordHash, ok := creds[username]; ok {
-		if cryptopasta.CheckPasswordHash([]byte(passwordHash), []byte(password)) == nil {
-			authenticated = true
-		}
-	} else {
+	exists, authenticated := checkLogin(username, authKey)
+	if !exists {
 		ShowLoginPage(w, r, "User "+username+" does not exist", "info")
 		return
 	}
+	if !authenticated && legacyLogin(username) {
+		ShowLoginPage(w, r, "Open bol once to upgrade the login of "+username, "info")
+		return
+	}
 
 	if authenticated {
-		page, err := Asset("post.html")
-		if err != nil {
-			log.Println("Error finding asset")
-		}
-		pageS := string(page)
-		apikey := utils.RandStringBytesMaskImprSrc(30)
-		apikeys.Lock()
-		apikeys.m[apikey] = username + "=" + password
-		apikeys.Unlock()
-		pageS = strings.Replace(pageS, "keyXX", apikey, -1)
-		var fs ssed.Fs
-		fs.Init(username, Host)
-		fs.Open(password)
-		documentList := fs.ListDocuments()
-		if len(documentList) == 0 {
-			documentList = append(documentList, "notes")
-		}
-		newHTML := "<option>" + strings.Join(documentList, "</option><option>") + "</option>"
-		fs.Close()
-		pageS = strings.Replace(pageS, "OPTIONS", newHTML, -1)
-
-		fmt.Fprintf(w, "%s", pageS)
-		go deleteApikeyDelay(apikey)
+		newSession(w, r, username)
+		http.Redirect(w, r, "/", http.StatusSeeOther)
 	} else {
 		ShowLoginPage(w, r, "Incorrect password", "info")
 	}
 }
 
 func HandlePush(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusOK)
 	username, password, _ := r.BasicAuth()
 	log.Printf("Got repo request for %s\n", username)
-	creds := make(map[string]string)
-	data, _ := ioutil.ReadFile(path.Join(wd, "logins.json"))
-	json.Unmarshal(data, &creds)
-
-	authenticated := false
-
-	if passwordHash, ok := creds[username]; ok {
-		if cryptopasta.CheckPasswordHash([]byte(passwordHash), []byte(password)) == nil {
-			authenticated = true
-			log.Printf("Authentication success for %s\n", username)
-		}
-	} else {
+	exists, authenticated := checkLogin(username, password)
+	if authenticated {
+		log.Printf("Authentication success for %s\n", username)
+	} else if !exists {
 		log.Printf("PUSH: User '%s' does not exist\n", username)
 		w.WriteHeader(http.StatusNetworkAuthenticationRequired)
 		io.WriteString(w, username+" does not exist, goto "+Host+" to register user")
@@ -290,19 +285,44 @@ func HandlePush(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if authenticated {
+		// the archive replaces the latest one, next to the shares
+		quota := quotaOf(username) - sharesUsed(username, "")
+		if r.ContentLength > quota {
+			quotaExceeded(w, username)
+			return
+		}
+		archivesLock.Lock()
+		defer archivesLock.Unlock()
 		initializeUser(username)
 		fileName := path.Join(wd, "archive", username, username+"."+utils.GetUnixTimestamp()+".tar.bz2")
 
-		outFile, err := os.Create(fileName)
+		// the upload is only moved to the archives of the user once it is
+		// whole, so it is never pulled half written
+		tempFile, err := ioutil.TempFile(path.Join(wd, "archive"), "upload")
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		tempFile.Close()
+		err = writeWithQuota(tempFile.Name(), r.Body, quota)
+		if err == ErrQuotaExceeded {
+			quotaExceeded(w, username)
+			return
+		} else if err == nil {
+			err = os.Rename(tempFile.Name(), fileName)
+		}
 		if err != nil {
+			log.Printf("PUSH: Could not write file for '%s': %s\n", username, err.Error())
 			w.WriteHeader(http.StatusInternalServerError)
 			return
 		}
-		// handle err
-		defer outFile.Close()
-		_, err = io.Copy(outFile, r.Body)
 		go cleanFiles(username)
+		users.Update(username, func(user *User) error {
+			user.LastPush = time.Now()
+			return nil
+		})
 		log.Printf("PUSH: Wrote file '%s' for '%s'\n", fileName, username)
+		setQuotaHeaders(w, username)
 		io.WriteString(w, string(fmt.Sprintf("Wrote file for '%s' on %s\n", username, Host)))
 	} else {
 		log.Println("Incorect password for" + username)
@@ -313,19 +333,15 @@ func HandlePush(w http.ResponseWriter, r *http.Request) {
 }
 
 func HandleDelete(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("keep") == "latest" {
+		HandleDeleteOlder(w, r)
+		return
+	}
 	w.WriteHeader(http.StatusOK)
 	log.Println("Erasing repo")
 	username, password, _ := r.BasicAuth()
-	creds := make(map[string]string)
-	data, _ := ioutil.ReadFile(path.Join(wd, "logins.json"))
-	json.Unmarshal(data, &creds)
-	authenticated := false
-
-	if passwordHash, ok := creds[username]; ok {
-		if cryptopasta.CheckPasswordHash([]byte(passwordHash), []byte(password)) == nil {
-			authenticated = true
-		}
-	} else {
+	exists, authenticated := checkLogin(username, password)
+	if !exists {
 		log.Printf("DELETE: User '%s' does not exist", username)
 		w.WriteHeader(http.StatusNetworkAuthenticationRequired)
 		io.WriteString(w, username+" does not exist")
@@ -339,6 +355,39 @@ func HandleDelete(w http.ResponseWriter, r *http.Request) {
 
 }
 
+// HandleDeleteOlder removes the archives of the user other than the latest
+// one, as they can have documents from before they were put in a vault
+func HandleDeleteOlder(w http.ResponseWriter, r *http.Request) {
+	username, ok := authenticate(r)
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		io.WriteString(w, "incorrect password")
+		return
+	}
+	archivesLock.Lock()
+	defer archivesLock.Unlock()
+	latestFileName, err := getLatestFileName(username)
+	if err != nil {
+		io.WriteString(w, "no archives")
+		return
+	}
+	removed := 0
+	files, _ := ioutil.ReadDir(path.Join(wd, "archive", username))
+	for _, f := range files {
+		if f.Name() == latestFileName {
+			continue
+		}
+		if err = os.Remove(path.Join(wd, "archive", username, f.Name())); err != nil {
+			log.Printf("DELETE: Could not remove %s: %s", f.Name(), err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		removed++
+	}
+	log.Printf("DELETE: Removed %d older archives of '%s'", removed, username)
+	io.WriteString(w, fmt.Sprintf("removed %d older archives", removed))
+}
+
 func HandleCheckMD5(w http.ResponseWriter, r *http.Request) {
 	if r.Method != "GET" {
 		w.WriteHeader(http.StatusNotImplemented)
@@ -347,6 +396,12 @@ func HandleCheckMD5(w http.ResponseWriter, r *http.Request) {
 	}
 	username, _, _ := r.BasicAuth()
 	log.Println("Got md5 request from " + username)
+	if legacyLogin(username) {
+		// tells the client to replace its password with its authentication key
+		w.Header().Set("Bol-Authentication", "password")
+	}
+	// not protected, like GET /repo, as the size of the archive is public
+	setQuotaHeaders(w, username)
 	latestFileName, err := getLatestFileName(username)
 	if err == nil {
 		md5, err2 := utils.ComputeMd5(path.Join(wd, "archive", username, latestFileName))
@@ -384,23 +439,69 @@ func HandlePull(w http.ResponseWriter, r *http.Request) {
 func HandleNew(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	username, password, _ := r.BasicAuth()
-	hashedPassword, _ := cryptopasta.HashPassword([]byte(password))
-	creds := make(map[string]string)
-
-	if utils.Exists(path.Join(wd, "logins.json")) {
-		data, _ := ioutil.ReadFile(path.Join(wd, "logins.json"))
-		json.Unmarshal(data, &creds)
-		if _, ok := creds[username]; ok {
-			io.WriteString(w, username+" already exists")
-			return
-		}
+	if !addLogin(username, password, isAuthKey(r)) {
+		io.WriteString(w, username+" already exists")
+		return
 	}
-	creds[username] = string(hashedPassword)
-	b, _ := json.MarshalIndent(creds, "", "  ")
-	ioutil.WriteFile(path.Join(wd, "logins.json"), b, 0644)
 	io.WriteString(w, "inserted new user, "+username)
 }
 
+// HandleChangePassword changes the authentication key of a user to the
+// body of the request, after a client encrypted the repository with a new
+// password or to replace the password an older client gave
+func HandleChangePassword(w http.ResponseWriter, r *http.Request) {
+	username, password, _ := r.BasicAuth()
+	newAuthKey, err := ioutil.ReadAll(r.Body)
+	if err != nil || len(newAuthKey) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, "new password is empty")
+		return
+	}
+	exists, authenticated := checkLogin(username, password)
+	if !exists {
+		log.Printf("PASSWORD: User '%s' does not exist\n", username)
+		w.WriteHeader(http.StatusNetworkAuthenticationRequired)
+		io.WriteString(w, username+" does not exist")
+		return
+	}
+	if !authenticated {
+		log.Println("Incorect password for " + username)
+		w.WriteHeader(http.StatusUnauthorized)
+		io.WriteString(w, "incorrect password")
+		return
+	}
+
+	if err = setLogin(username, string(newAuthKey), isAuthKey(r)); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, err.Error())
+		return
+	}
+	log.Printf("PASSWORD: Changed password for '%s'\n", username)
+	io.WriteString(w, "changed password for "+username)
+}
+
+// HandleSalt returns the salt file of the repository of a user, which the
+// website derives the key of the repository with. Repositories from before
+// salts, and users that do not exist, get 204.
+func HandleSalt(w http.ResponseWriter, r *http.Request) {
+	username := r.URL.Query().Get("username")
+	if _, err := users.Get(username); err != nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	salt, err := readSalt(username)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, "Could not read archive")
+		return
+	} else if salt == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(salt)
+}
+
 func HandleRepo(w http.ResponseWriter, r *http.Request) {
 	if r.Method == "GET" {
 		HandlePull(w, r)
@@ -410,6 +511,8 @@ func HandleRepo(w http.ResponseWriter, r *http.Request) {
 		HandleDelete(w, r)
 	} else if r.Method == "PUT" {
 		HandleNew(w, r)
+	} else if r.Method == "PATCH" {
+		HandleChangePassword(w, r)
 	}
 }
 
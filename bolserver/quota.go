@@ -0,0 +1,146 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// DefaultQuota is the number of bytes the archive of a user can have,
+// unless the user has its own quota
+var DefaultQuota int64
+
+// ErrQuotaExceeded is returned for archives that are over the quota
+var ErrQuotaExceeded = errors.New("The archive is over the quota")
+
+// quotaFlags are the quotas of the -user-quota flags, as username=bytes
+type quotaFlags map[string]int64
+
+func (q quotaFlags) String() string {
+	s := []string{}
+	for username, quota := range q {
+		s = append(s, username+"="+strconv.FormatInt(quota, 10))
+	}
+	return strings.Join(s, ",")
+}
+
+func (q quotaFlags) Set(value string) error {
+	i := strings.LastIndex(value, "=")
+	if i < 1 {
+		return errors.New("The quota must be username=bytes")
+	}
+	quota, err := strconv.ParseInt(value[i+1:], 10, 64)
+	if err != nil || quota < 0 {
+		return errors.New("The quota must be username=bytes")
+	}
+	q[value[:i]] = quota
+	return nil
+}
+
+// userQuotas are kept in the UserStore when the server starts
+var userQuotas = make(quotaFlags)
+
+// setUserQuotas keeps the quotas of the flags, where 0 is the default
+func setUserQuotas() error {
+	for username, quota := range userQuotas {
+		err := users.Update(username, func(user *User) error {
+			user.Quota = quota
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Could not set the quota of '%s': %s", username, err.Error())
+		}
+	}
+	return nil
+}
+
+// quotaOf returns the number of bytes the archive of the user can have
+func quotaOf(username string) int64 {
+	if user, err := users.Get(username); err == nil && user.Quota > 0 {
+		return user.Quota
+	}
+	return DefaultQuota
+}
+
+// quotaUsed returns the size of the latest archive of the user and of the
+// shares they own, as older archives are only kept while they fit in -limit
+func quotaUsed(username string) int64 {
+	return archiveUsed(username) + sharesUsed(username, "")
+}
+
+// archiveUsed returns the size of the latest archive of the user
+func archiveUsed(username string) int64 {
+	latestFileName, err := getLatestFileName(username)
+	if err != nil {
+		return 0
+	}
+	fi, err := os.Stat(path.Join(wd, "archive", username, latestFileName))
+	if err != nil {
+		return 0
+	}
+	return fi.Size()
+}
+
+// sharesUsed returns the size of the archives of the shares the user owns,
+// other than the share except. Writers of a share use the quota of its
+// owner.
+func sharesUsed(username, except string) int64 {
+	var used int64
+	folders, _ := filepath.Glob(path.Join(wd, "shares", "*"))
+	for _, folder := range folders {
+		id := filepath.Base(folder)
+		if id == except {
+			continue
+		}
+		if members, err := readShareMembers(id); err != nil || members.Owner != username {
+			continue
+		}
+		if fi, err := os.Stat(path.Join(folder, "archive.tar.bz2")); err == nil {
+			used += fi.Size()
+		}
+	}
+	return used
+}
+
+// setQuotaHeaders tells the client the quota of the user and how much of it
+// is used, in all and by the shares they own, so it can warn before pushing
+// an archive that is too large
+func setQuotaHeaders(w http.ResponseWriter, username string) {
+	shares := sharesUsed(username, "")
+	w.Header().Set("Bol-Quota", strconv.FormatInt(quotaOf(username), 10))
+	w.Header().Set("Bol-Quota-Used", strconv.FormatInt(archiveUsed(username)+shares, 10))
+	w.Header().Set("Bol-Quota-Shares", strconv.FormatInt(shares, 10))
+}
+
+// writeWithQuota writes the file from r, stopping at the quota without
+// reading the rest. Files over the quota are removed.
+func writeWithQuota(fileName string, r io.Reader, quota int64) error {
+	outFile, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	n, err := io.Copy(outFile, io.LimitReader(r, quota+1))
+	outFile.Close()
+	if err == nil && n > quota {
+		err = ErrQuotaExceeded
+	}
+	if err != nil {
+		os.Remove(fileName)
+	}
+	return err
+}
+
+// quotaExceeded answers 413 with the quota of the user
+func quotaExceeded(w http.ResponseWriter, username string) {
+	log.Printf("QUOTA: '%s' is over the quota of %d bytes\n", username, quotaOf(username))
+	setQuotaHeaders(w, username)
+	w.WriteHeader(http.StatusRequestEntityTooLarge)
+	io.WriteString(w, fmt.Sprintf("the archive is over the quota of %d bytes for %s", quotaOf(username), username))
+}
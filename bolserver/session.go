@@ -0,0 +1,123 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// sessionCookie is the cookie of a website session, which only has its ID
+const sessionCookie = "bol_session"
+
+// SessionTimeout is how long a website session lasts without being used
+var SessionTimeout time.Duration
+
+// session is a login to the website. It only knows the user, as the
+// browser keeps the key that decrypts the entries. Every form and request
+// that changes something sends the CSRF token of the session.
+type session struct {
+	username string
+	csrf     string
+	expires  time.Time
+}
+
+// sessions are kept by the hash of their ID, so the table does not have
+// the IDs of the cookies
+var sessions = struct {
+	sync.Mutex
+	m map[string]*session
+}{m: make(map[string]*session)}
+
+func randomToken() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+func hashSessionID(id string) string {
+	h := sha256.Sum256([]byte(id))
+	return hex.EncodeToString(h[:])
+}
+
+// secureRequest returns whether the browser reached the server over https,
+// possibly through a proxy, so the cookie is only sent over https
+func secureRequest(r *http.Request) bool {
+	return r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https"
+}
+
+// setSessionCookie sets the cookie, which scripts can not read and other
+// sites can not send
+func setSessionCookie(w http.ResponseWriter, r *http.Request, value string, maxAge int) {
+	cookie := &http.Cookie{
+		Name:     sessionCookie,
+		Value:    value,
+		Path:     "/",
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   secureRequest(r),
+	}
+	w.Header().Add("Set-Cookie", cookie.String()+"; SameSite=Strict")
+}
+
+// newSession logs the user in to the website
+func newSession(w http.ResponseWriter, r *http.Request, username string) {
+	id := randomToken()
+	sessions.Lock()
+	now := time.Now()
+	for key, s := range sessions.m {
+		if now.After(s.expires) {
+			delete(sessions.m, key)
+		}
+	}
+	sessions.m[hashSessionID(id)] = &session{username: username, csrf: randomToken(), expires: now.Add(SessionTimeout)}
+	sessions.Unlock()
+	setSessionCookie(w, r, id, 0)
+}
+
+// getSession returns the session of the cookie, if it has not expired, and
+// keeps it for another SessionTimeout
+func getSession(r *http.Request) (session, bool) {
+	cookie, err := r.Cookie(sessionCookie)
+	if err != nil {
+		return session{}, false
+	}
+	sessions.Lock()
+	defer sessions.Unlock()
+	key := hashSessionID(cookie.Value)
+	s, ok := sessions.m[key]
+	if !ok {
+		return session{}, false
+	}
+	if time.Now().After(s.expires) {
+		delete(sessions.m, key)
+		return session{}, false
+	}
+	s.expires = time.Now().Add(SessionTimeout)
+	return *s, true
+}
+
+// checkCSRF returns whether the request has the CSRF token of the session,
+// in the Bol-CSRF-Token header or the csrf_token form value
+func checkCSRF(r *http.Request, s session) bool {
+	token := r.Header.Get("Bol-CSRF-Token")
+	if len(token) == 0 {
+		token = r.PostFormValue("csrf_token")
+	}
+	return len(token) > 0 && subtle.ConstantTimeCompare([]byte(token), []byte(s.csrf)) == 1
+}
+
+// endSession logs out of the website
+func endSession(w http.ResponseWriter, r *http.Request) {
+	if cookie, err := r.Cookie(sessionCookie); err == nil {
+		sessions.Lock()
+		delete(sessions.m, hashSessionID(cookie.Value))
+		sessions.Unlock()
+	}
+	setSessionCookie(w, r, "", -1)
+}
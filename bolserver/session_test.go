@@ -0,0 +1,165 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// login logs alice in to the website and returns the response
+func login(authKey string, header http.Header) *httptest.ResponseRecorder {
+	form := url.Values{"username": {"alice"}, "auth_key": {authKey}}
+	r := httptest.NewRequest("POST", "/login", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	for key, values := range header {
+		r.Header[key] = values
+	}
+	w := httptest.NewRecorder()
+	HandleLoginAttempt(w, r)
+	return w
+}
+
+// withCookie returns a request with the session cookie of a login
+func withCookie(method, target string, w *httptest.ResponseRecorder) *http.Request {
+	r := httptest.NewRequest(method, target, nil)
+	for _, cookie := range (&http.Response{Header: w.Header()}).Cookies() {
+		r.AddCookie(cookie)
+	}
+	return r
+}
+
+func TestSession(t *testing.T) {
+	s, folder := tempUserStore(t)
+	defer os.RemoveAll(folder)
+	defer s.Close()
+	users, SessionTimeout = s, time.Hour
+	s.Add(User{Username: "alice", Login: hashLogin("key", true)})
+
+	if w := login("wrong", nil); len(w.Header().Get("Set-Cookie")) > 0 {
+		t.Errorf("Logged in with an incorrect key: %s", w.Header().Get("Set-Cookie"))
+	}
+	w := login("key", nil)
+	cookie := w.Header().Get("Set-Cookie")
+	if w.Code != http.StatusSeeOther || !strings.HasPrefix(cookie, sessionCookie+"=") {
+		t.Fatalf("Problem logging in: %d %s", w.Code, cookie)
+	}
+	for _, flag := range []string{"Path=/", "HttpOnly", "SameSite=Strict"} {
+		if !strings.Contains(cookie, flag) {
+			t.Errorf("The cookie does not have %s: %s", flag, cookie)
+		}
+	}
+	if strings.Contains(cookie, "Secure") {
+		t.Errorf("The cookie is only sent over https, but the login was not: %s", cookie)
+	}
+	if cookie := login("key", http.Header{"X-Forwarded-Proto": {"https"}}).Header().Get("Set-Cookie"); !strings.Contains(cookie, "Secure") {
+		t.Errorf("The cookie of a login over https is not Secure: %s", cookie)
+	}
+
+	session, ok := getSession(withCookie("GET", "/", w))
+	if !ok || session.username != "alice" || len(session.csrf) == 0 {
+		t.Fatalf("Problem getting the session: %+v %v", session, ok)
+	}
+	if _, ok := getSession(httptest.NewRequest("GET", "/", nil)); ok {
+		t.Errorf("Got a session without a cookie")
+	}
+
+	// using the session keeps it for another SessionTimeout
+	sessions.Lock()
+	for _, stored := range sessions.m {
+		if stored.csrf == session.csrf {
+			stored.expires = time.Now().Add(time.Second)
+		}
+	}
+	sessions.Unlock()
+	getSession(withCookie("GET", "/", w))
+	sessions.Lock()
+	for _, stored := range sessions.m {
+		if stored.csrf == session.csrf && stored.expires.Sub(time.Now()) < 59*time.Minute {
+			t.Errorf("The session was not refreshed, it expires in %s", stored.expires.Sub(time.Now()))
+		}
+		if stored.csrf == session.csrf {
+			stored.expires = time.Now().Add(-time.Second)
+		}
+	}
+	sessions.Unlock()
+	if _, ok := getSession(withCookie("GET", "/", w)); ok {
+		t.Errorf("Got a session that expired")
+	}
+}
+
+func TestCheckCSRF(t *testing.T) {
+	s := session{username: "alice", csrf: "token"}
+	form := func(value string) *http.Request {
+		r := httptest.NewRequest("POST", "/logout", strings.NewReader(url.Values{"csrf_token": {value}}.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return r
+	}
+	header := func(value string) *http.Request {
+		r := httptest.NewRequest("POST", "/post", nil)
+		r.Header.Set("Bol-CSRF-Token", value)
+		return r
+	}
+	tests := []struct {
+		name string
+		r    *http.Request
+		s    session
+		ok   bool
+	}{
+		{"header", header("token"), s, true},
+		{"form", form("token"), s, true},
+		{"wrong header", header("other"), s, false},
+		{"wrong form", form("other"), s, false},
+		{"no token", httptest.NewRequest("POST", "/post", nil), s, false},
+		{"session without a token", httptest.NewRequest("POST", "/post", nil), session{}, false},
+	}
+	for _, test := range tests {
+		if ok := checkCSRF(test.r, test.s); ok != test.ok {
+			t.Errorf("%s: expected %v, got %v", test.name, test.ok, ok)
+		}
+	}
+}
+
+func TestLogout(t *testing.T) {
+	s, folder := tempUserStore(t)
+	defer os.RemoveAll(folder)
+	defer s.Close()
+	users, SessionTimeout = s, time.Hour
+	s.Add(User{Username: "alice", Login: hashLogin("key", true)})
+	w := login("key", nil)
+	session, _ := getSession(withCookie("GET", "/", w))
+
+	// the entries can not be changed without the CSRF token either
+	post := httptest.NewRecorder()
+	HandlePostAttempt(post, withCookie("POST", "/post", w))
+	if post.Code != http.StatusForbidden {
+		t.Errorf("Posted without the CSRF token: %d", post.Code)
+	}
+
+	for _, method := range []string{"GET", "POST"} {
+		logout := httptest.NewRecorder()
+		HandleLogout(logout, withCookie(method, "/logout", w))
+		if _, ok := getSession(withCookie("GET", "/", w)); logout.Code != http.StatusForbidden || !ok {
+			t.Errorf("%s logged out without the CSRF token: %d", method, logout.Code)
+		}
+	}
+
+	r := withCookie("POST", "/logout", w)
+	r.Header.Set("Bol-CSRF-Token", session.csrf)
+	logout := httptest.NewRecorder()
+	HandleLogout(logout, r)
+	if cookie := logout.Header().Get("Set-Cookie"); !strings.Contains(cookie, sessionCookie+"=;") || !strings.Contains(cookie, "Max-Age=0") {
+		t.Errorf("The cookie was not removed: %s", cookie)
+	}
+	if _, ok := getSession(withCookie("GET", "/", w)); ok {
+		t.Errorf("The session is still there after logging out")
+	}
+	post = httptest.NewRecorder()
+	HandlePostAttempt(post, r)
+	if post.Code != http.StatusUnauthorized {
+		t.Errorf("Posted after logging out: %d", post.Code)
+	}
+}
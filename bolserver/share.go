@@ -0,0 +1,278 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+
+	"github.com/schollz/bol/utils"
+)
+
+// sharesLock guards keys.json and the members of the shares
+var sharesLock sync.Mutex
+
+// shareMembers is kept as shares/ID/members.json. The key of the share is
+// sealed to the public key of each member, so the server can't read it.
+type shareMembers struct {
+	Owner   string                 `json:"owner"`
+	Members map[string]shareMember `json:"members"`
+}
+
+type shareMember struct {
+	Key      string `json:"key"`
+	Writable bool   `json:"writable"`
+}
+
+// sharedDocument is a share of a user, as listed by GET /shares
+type sharedDocument struct {
+	ID       string `json:"id"`
+	Owner    string `json:"owner"`
+	Key      string `json:"key"`
+	Writable bool   `json:"writable"`
+}
+
+// authenticate checks the basic authorization of the request against the
+// users and returns the user
+func authenticate(r *http.Request) (string, bool) {
+	username, authKey, ok := r.BasicAuth()
+	if !ok {
+		return "", false
+	}
+	_, ok = checkLogin(username, authKey)
+	return username, ok
+}
+
+// HandleKey publishes the public key of the authorized user with PUT, and
+// returns the public key of ?user= with GET
+func HandleKey(w http.ResponseWriter, r *http.Request) {
+	keys := make(map[string]string)
+	sharesLock.Lock()
+	defer sharesLock.Unlock()
+	data, _ := ioutil.ReadFile(path.Join(wd, "keys.json"))
+	json.Unmarshal(data, &keys)
+
+	if r.Method == "GET" {
+		key, ok := keys[r.URL.Query().Get("user")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, r.URL.Query().Get("user")+" has no public key")
+			return
+		}
+		io.WriteString(w, key)
+		return
+	}
+	if r.Method != "PUT" {
+		http.Error(w, "GET or PUT only", http.StatusMethodNotAllowed)
+		return
+	}
+	username, ok := authenticate(r)
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		io.WriteString(w, "incorrect password")
+		return
+	}
+	key, err := ioutil.ReadAll(r.Body)
+	if err != nil || len(key) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, "public key is empty")
+		return
+	}
+	keys[username] = string(key)
+	b, _ := json.MarshalIndent(keys, "", "  ")
+	ioutil.WriteFile(path.Join(wd, "keys.json"), b, 0644)
+	log.Printf("KEY: Published public key of '%s'\n", username)
+	io.WriteString(w, "published public key of "+username)
+}
+
+func readShareMembers(id string) (shareMembers, error) {
+	var members shareMembers
+	data, err := ioutil.ReadFile(path.Join(wd, "shares", filepath.Base(id), "members.json"))
+	if err != nil {
+		return members, err
+	}
+	err = json.Unmarshal(data, &members)
+	return members, err
+}
+
+// writeShareMembers writes members.json whole, as the quotas read it
+// without sharesLock
+func writeShareMembers(id string, members shareMembers) error {
+	folder := path.Join(wd, "shares", filepath.Base(id))
+	b, _ := json.MarshalIndent(members, "", "  ")
+	if err := ioutil.WriteFile(path.Join(folder, "members.json.new"), b, 0644); err != nil {
+		return err
+	}
+	return os.Rename(path.Join(folder, "members.json.new"), path.Join(folder, "members.json"))
+}
+
+// HandleShare handles the shared document ?id=. GET returns its archive,
+// POST replaces the archive for the owner and members that can write, when
+// ?md5= is the md5 of the archive it replaces, PUT
+// adds the member in the body, for the owner of the share or anyone when it
+// is new, and DELETE removes the member ?user= for the owner, returning the
+// members that are left.
+func HandleShare(w http.ResponseWriter, r *http.Request) {
+	id := filepath.Base(r.URL.Query().Get("id"))
+	if len(id) < 2 {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, "need ?id= of the share")
+		return
+	}
+	folder := path.Join(wd, "shares", id)
+	if r.Method == "GET" {
+		// not protected, like GET /repo, since it is encrypted
+		file, err := os.Open(path.Join(folder, "archive.tar.bz2"))
+		if err != nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		defer file.Close()
+		w.Header().Set("Content-Type", "octet-stream")
+		io.Copy(w, file)
+		return
+	}
+
+	username, ok := authenticate(r)
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		io.WriteString(w, "incorrect password")
+		return
+	}
+	sharesLock.Lock()
+	defer sharesLock.Unlock()
+	members, err := readShareMembers(id)
+	exists := err == nil
+
+	switch r.Method {
+	case "POST":
+		member, isMember := members.Members[username]
+		if !exists || (members.Owner != username && !(isMember && member.Writable)) {
+			log.Printf("SHARE: '%s' can not write to %s\n", username, id)
+			w.WriteHeader(http.StatusForbidden)
+			io.WriteString(w, "not allowed to change this document")
+			return
+		}
+		// an archive that was not made from the latest one would drop
+		// what the others wrote since, so the writer merges it first
+		if current, _ := utils.ComputeMd5(path.Join(folder, "archive.tar.bz2")); r.URL.Query().Get("md5") != current {
+			log.Printf("SHARE: '%s' wrote %s from an old archive\n", username, id)
+			w.WriteHeader(http.StatusConflict)
+			io.WriteString(w, "the shared document changed since it was downloaded")
+			return
+		}
+		// shared documents count against the quota of the owner, with
+		// the archive they replace left out
+		quota := quotaOf(members.Owner) - archiveUsed(members.Owner) - sharesUsed(members.Owner, id)
+		if r.ContentLength > quota {
+			quotaExceeded(w, members.Owner)
+			return
+		}
+		err = writeWithQuota(path.Join(folder, "archive.tar.bz2.new"), r.Body, quota)
+		if err == ErrQuotaExceeded {
+			quotaExceeded(w, members.Owner)
+			return
+		} else if err == nil {
+			err = os.Rename(path.Join(folder, "archive.tar.bz2.new"), path.Join(folder, "archive.tar.bz2"))
+		}
+		if err != nil {
+			log.Printf("SHARE: Could not write %s for '%s': %s\n", id, username, err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		log.Printf("SHARE: '%s' wrote %s\n", username, id)
+		io.WriteString(w, "wrote shared document "+id)
+	case "PUT":
+		if exists && members.Owner != username {
+			w.WriteHeader(http.StatusForbidden)
+			io.WriteString(w, "only "+members.Owner+" can share this document")
+			return
+		}
+		var member struct {
+			User     string `json:"user"`
+			Key      string `json:"key"`
+			Writable bool   `json:"writable"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&member); err != nil || len(member.User) == 0 || len(member.Key) == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			io.WriteString(w, "need the user and the sealed key")
+			return
+		}
+		if !exists {
+			members = shareMembers{Owner: username, Members: make(map[string]shareMember)}
+			os.MkdirAll(folder, 0755)
+		}
+		members.Members[member.User] = shareMember{Key: member.Key, Writable: member.Writable}
+		if err := writeShareMembers(id, members); err != nil {
+			log.Printf("SHARE: Could not share %s with '%s': %s\n", id, member.User, err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		log.Printf("SHARE: '%s' shared %s with '%s'\n", username, id, member.User)
+		io.WriteString(w, "shared with "+member.User)
+	case "DELETE":
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, "no shared document "+id)
+			return
+		}
+		if members.Owner != username {
+			w.WriteHeader(http.StatusForbidden)
+			io.WriteString(w, "only "+members.Owner+" can unshare this document")
+			return
+		}
+		// removing a user that is not a member does nothing, so the owner
+		// can try again when giving the others a new key failed
+		removed := r.URL.Query().Get("user")
+		if _, ok := members.Members[removed]; ok {
+			delete(members.Members, removed)
+			if err := writeShareMembers(id, members); err != nil {
+				log.Printf("SHARE: Could not unshare %s with '%s': %s\n", id, removed, err.Error())
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			log.Printf("SHARE: '%s' unshared %s with '%s'\n", username, id, removed)
+		}
+		remaining := make(map[string]bool)
+		for member, m := range members.Members {
+			remaining[member] = m.Writable
+		}
+		b, _ := json.Marshal(remaining)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	default:
+		http.Error(w, "GET, POST, PUT or DELETE only", http.StatusMethodNotAllowed)
+	}
+}
+
+// HandleShares returns the documents that are shared with the authorized
+// user
+func HandleShares(w http.ResponseWriter, r *http.Request) {
+	username, ok := authenticate(r)
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		io.WriteString(w, "incorrect password")
+		return
+	}
+	sharesLock.Lock()
+	defer sharesLock.Unlock()
+	shared := []sharedDocument{}
+	folders, _ := filepath.Glob(path.Join(wd, "shares", "*"))
+	for _, folder := range folders {
+		members, err := readShareMembers(filepath.Base(folder))
+		if err != nil {
+			continue
+		}
+		if member, ok := members.Members[username]; ok {
+			shared = append(shared, sharedDocument{filepath.Base(folder), members.Owner, member.Key, member.Writable})
+		}
+	}
+	b, _ := json.Marshal(shared)
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(b)
+}
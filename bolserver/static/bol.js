@@ -0,0 +1,639 @@
+// bol.js encrypts and decrypts entries in the browser, so the server only
+// has the encrypted files of the repository. It mirrors the utils package:
+// files are AES-256-GCM, keyed with the SHA-256 of the key of the
+// repository, with the 12-byte nonce before the ciphertext, written in hex
+// (see cryptopasta), or XChaCha20-Poly1305 after an "xchacha20poly1305:"
+// header. The key is derived from the password with the salt of
+// the repository (see ssed/salt.go), or is the password in repositories
+// from before salts.
+var bol = (function() {
+  "use strict";
+
+  var subtle = window.crypto && window.crypto.subtle;
+  var encoder = new TextEncoder();
+  var decoder = new TextDecoder();
+  var KEY = "bol-key";
+
+  function toHex(buffer) {
+    var bytes = new Uint8Array(buffer);
+    var hex = "";
+    for (var i = 0; i < bytes.length; i++) {
+      hex += (bytes[i] < 16 ? "0" : "") + bytes[i].toString(16);
+    }
+    return hex;
+  }
+
+  function fromHex(hex) {
+    if (hex.length % 2 !== 0 || /[^0-9a-f]/i.test(hex)) {
+      throw new Error("Not hex");
+    }
+    var bytes = new Uint8Array(hex.length / 2);
+    for (var i = 0; i < bytes.length; i++) {
+      bytes[i] = parseInt(hex.substr(2 * i, 2), 16);
+    }
+    return bytes;
+  }
+
+  function sha256Hex(text) {
+    return subtle.digest("SHA-256", encoder.encode(text)).then(toHex);
+  }
+
+  // supported returns whether the browser can encrypt, which it only can
+  // over https or on localhost
+  function supported() {
+    return !!subtle;
+  }
+
+  function pbkdf2Hex(password, salt, iterations) {
+    return subtle.importKey("raw", encoder.encode(password), "PBKDF2", false, ["deriveBits"]).then(function(key) {
+      return subtle.deriveBits({name: "PBKDF2", salt: salt, iterations: iterations, hash: "SHA-256"}, key, 256);
+    }).then(toHex);
+  }
+
+  // authKey mirrors utils.AuthKey, so the server never gets the password
+  function authKey(username, password) {
+    return pbkdf2Hex(password, encoder.encode("bol authentication " + username), 100000);
+  }
+
+  // repositoryKey mirrors the key of ssed, derived with the salt of the
+  // repository of the user, if it has one
+  function repositoryKey(username, password) {
+    return fetch("/salt?username=" + encodeURIComponent(username)).then(function(response) {
+      if (response.status === 204) {
+        return password;
+      } else if (!response.ok) {
+        throw new Error("Could not get the salt of the repository");
+      }
+      return response.json().then(function(salt) {
+        if (salt.kdf !== "pbkdf2-sha256") {
+          throw new Error("Browsers can not derive keys with " + salt.kdf);
+        }
+        return pbkdf2Hex(password, fromHex(salt.salt), salt.iterations);
+      });
+    });
+  }
+
+  // login keeps the encryption key in the tab until it is closed, and
+  // returns the authentication key
+  function login(username, password) {
+    return repositoryKey(username, password).then(sha256Hex).then(function(key) {
+      sessionStorage.setItem(KEY, key);
+      return authKey(username, password);
+    });
+  }
+
+  function logout() {
+    sessionStorage.removeItem(KEY);
+  }
+
+  // hasKey returns whether this tab has the encryption key, which other
+  // tabs of the same session do not
+  function hasKey() {
+    return sessionStorage.getItem(KEY) !== null;
+  }
+
+  function key() {
+    var hex = sessionStorage.getItem(KEY);
+    if (!hex) {
+      return Promise.reject(new Error("Log in again to decrypt"));
+    }
+    return subtle.importKey("raw", fromHex(hex), "AES-GCM", false, ["encrypt", "decrypt"]);
+  }
+
+  // Browsers do not have XChaCha20-Poly1305 (see "bol -cipher"), so it is
+  // done here as in RFC 8439, with HChaCha20 for the 24-byte nonce. Only
+  // decrypting is needed, as the website writes AES-256-GCM.
+  function rotl(x, n) {
+    return (x << n) | (x >>> (32 - n));
+  }
+
+  function quarterRound(s, a, b, c, d) {
+    s[a] = (s[a] + s[b]) | 0; s[d] = rotl(s[d] ^ s[a], 16);
+    s[c] = (s[c] + s[d]) | 0; s[b] = rotl(s[b] ^ s[c], 12);
+    s[a] = (s[a] + s[b]) | 0; s[d] = rotl(s[d] ^ s[a], 8);
+    s[c] = (s[c] + s[d]) | 0; s[b] = rotl(s[b] ^ s[c], 7);
+  }
+
+  // chachaState is the state of a block, which ends with the counter and
+  // nonce, or the nonce of HChaCha20
+  function chachaState(k, last) {
+    var s = new Uint32Array(16);
+    s.set([0x61707865, 0x3320646e, 0x79622d32, 0x6b206574]);
+    s.set(words(k), 4);
+    s.set(last, 12);
+    return s;
+  }
+
+  function chachaRounds(s) {
+    for (var i = 0; i < 10; i++) {
+      quarterRound(s, 0, 4, 8, 12); quarterRound(s, 1, 5, 9, 13);
+      quarterRound(s, 2, 6, 10, 14); quarterRound(s, 3, 7, 11, 15);
+      quarterRound(s, 0, 5, 10, 15); quarterRound(s, 1, 6, 11, 12);
+      quarterRound(s, 2, 7, 8, 13); quarterRound(s, 3, 4, 9, 14);
+    }
+  }
+
+  // words reads little-endian 32-bit words, as the typed arrays do on
+  // every browser
+  function words(bytes) {
+    return new Uint32Array(bytes.slice().buffer);
+  }
+
+  function hchacha20(k, nonce) {
+    var s = chachaState(k, words(nonce));
+    chachaRounds(s);
+    var subkey = new Uint32Array(8);
+    subkey.set(s.subarray(0, 4));
+    subkey.set(s.subarray(12, 16), 4);
+    return new Uint8Array(subkey.buffer);
+  }
+
+  // chacha20 encrypts or decrypts the data, from the block counter
+  function chacha20(k, nonce, counter, data) {
+    var out = new Uint8Array(data.length);
+    var n = words(nonce);
+    for (var offset = 0; offset < data.length; offset += 64, counter++) {
+      var initial = chachaState(k, [counter, n[0], n[1], n[2]]);
+      var s = initial.slice();
+      chachaRounds(s);
+      for (var i = 0; i < 16; i++) {
+        s[i] = (s[i] + initial[i]) | 0;
+      }
+      var stream = new Uint8Array(s.buffer);
+      for (var j = 0; j < 64 && offset + j < data.length; j++) {
+        out[offset + j] = data[offset + j] ^ stream[j];
+      }
+    }
+    return out;
+  }
+
+  function littleEndian(bytes) {
+    var x = BigInt(0);
+    for (var i = bytes.length - 1; i >= 0; i--) {
+      x = (x << BigInt(8)) | BigInt(bytes[i]);
+    }
+    return x;
+  }
+
+  function poly1305(k, message) {
+    var r = littleEndian(k.subarray(0, 16)) & BigInt("0x0ffffffc0ffffffc0ffffffc0fffffff");
+    var p = (BigInt(1) << BigInt(130)) - BigInt(5);
+    var h = BigInt(0);
+    for (var i = 0; i < message.length; i += 16) {
+      var block = message.subarray(i, i + 16);
+      h = ((h + littleEndian(block) + (BigInt(1) << BigInt(8 * block.length))) * r) % p;
+    }
+    h = (h + littleEndian(k.subarray(16, 32))) & ((BigInt(1) << BigInt(128)) - BigInt(1));
+    var tag = new Uint8Array(16);
+    for (var j = 0; j < 16; j++) {
+      tag[j] = Number(h & BigInt(255));
+      h >>= BigInt(8);
+    }
+    return tag;
+  }
+
+  function xchacha20poly1305Open(k, data) {
+    if (typeof BigInt === "undefined") {
+      throw new Error("This browser can not decrypt xchacha20poly1305");
+    }
+    if (data.length < 24 + 16) {
+      throw new Error("Malformed ciphertext");
+    }
+    var subkey = hchacha20(k, data.subarray(0, 16));
+    var nonce = new Uint8Array(12);
+    nonce.set(data.subarray(16, 24), 4);
+    var ciphertext = data.subarray(24, data.length - 16);
+    // the tag covers the ciphertext padded to 16 bytes and its length
+    var padded = Math.ceil(ciphertext.length / 16) * 16;
+    var macData = new Uint8Array(padded + 16);
+    macData.set(ciphertext);
+    new DataView(macData.buffer).setUint32(padded + 8, ciphertext.length, true);
+    var tag = poly1305(chacha20(subkey, nonce, 0, new Uint8Array(32)), macData);
+    var diff = 0;
+    for (var i = 0; i < 16; i++) {
+      diff |= tag[i] ^ data[data.length - 16 + i];
+    }
+    if (diff !== 0) {
+      throw new Error("Incorrect key or corrupted file");
+    }
+    return chacha20(subkey, nonce, 1, ciphertext).buffer;
+  }
+
+  // xchachaVector is the text of RFC 8439 section 2.8.2 sealed with the key
+  // and nonce of draft-irtf-cfrg-xchacha A.3.1, without its additional
+  // data, which bol does not use. The ciphertext is the one of the draft,
+  // and bolserver/bindata_test.go checks the tag against
+  // golang.org/x/crypto.
+  var xchachaVector = {
+    key: "808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9f",
+    sealed: "404142434445464748494a4b4c4d4e4f5051525354555657" +
+      "bd6d179d3e83d43b9576579493c0e939572a1700252bfaccbed2902c21396cbb" +
+      "731c7f1b0b4aa6440bf3a82f4eda7e39ae64c6708c54c216cb96b72e1213b452" +
+      "2f8c9ba40db5d945b11b69b982c1bb9e3f3fac2bc369488f76b2383565d3fff9" +
+      "21f9664c97637da9768812f615c68b13b52ef7e62efbf45089db18f9c8a3f0e41e5f",
+    text: "Ladies and Gentlemen of the class of '99: If I could offer you only one tip for the future, sunscreen would be it."
+  };
+  var xchachaChecked = false;
+
+  // checkXChaCha opens xchachaVector once, before the first file, so a
+  // browser that gets it wrong says so instead of showing garbage
+  function checkXChaCha() {
+    if (xchachaChecked) {
+      return;
+    }
+    var text;
+    try {
+      text = decoder.decode(xchacha20poly1305Open(fromHex(xchachaVector.key), fromHex(xchachaVector.sealed)));
+    } catch (err) {
+      text = null;
+    }
+    if (text !== xchachaVector.text) {
+      throw new Error("This browser does not decrypt xchacha20poly1305 correctly");
+    }
+    xchachaChecked = true;
+  }
+
+  // decrypt returns the bytes of a file from utils.EncryptToHex
+  function decrypt(encrypted) {
+    encrypted = encrypted.trim();
+    var cipher = "aes256gcm";
+    var i = encrypted.indexOf(":");
+    if (i >= 0) {
+      cipher = encrypted.slice(0, i);
+      encrypted = encrypted.slice(i + 1);
+    }
+    if (cipher === "xchacha20poly1305") {
+      var hex = sessionStorage.getItem(KEY);
+      if (!hex) {
+        return Promise.reject(new Error("Log in again to decrypt"));
+      }
+      return new Promise(function(resolve) {
+        checkXChaCha();
+        resolve(xchacha20poly1305Open(fromHex(hex), fromHex(encrypted)));
+      });
+    } else if (cipher !== "aes256gcm") {
+      return Promise.reject(new Error("Browsers can not decrypt " + cipher));
+    }
+    return key().then(function(k) {
+      var data = fromHex(encrypted);
+      return subtle.decrypt({name: "AES-GCM", iv: data.slice(0, 12)}, k, data.slice(12));
+    }).catch(function(err) {
+      // WebCrypto only says the operation failed
+      throw err.name === "OperationError" ? new Error("Incorrect key or corrupted file") : err;
+    });
+  }
+
+  // encrypt returns the text encrypted like utils.EncryptToHex
+  function encrypt(text) {
+    return key().then(function(k) {
+      var iv = window.crypto.getRandomValues(new Uint8Array(12));
+      return subtle.encrypt({name: "AES-GCM", iv: iv}, k, encoder.encode(text)).then(function(data) {
+        return toHex(iv) + toHex(data);
+      });
+    });
+  }
+
+  function pad(n) {
+    return (n < 10 ? "0" : "") + n;
+  }
+
+  // formatDate mirrors utils.GetCurrentDate
+  function formatDate(d) {
+    return d.getFullYear() + "-" + pad(d.getMonth() + 1) + "-" + pad(d.getDate()) + " " +
+      pad(d.getHours()) + ":" + pad(d.getMinutes()) + ":" + pad(d.getSeconds());
+  }
+
+  function parseDate(s) {
+    var m = /^(\d{4})-(\d\d)-(\d\d) (\d\d):(\d\d):(\d\d)$/.exec(s || "");
+    if (m) {
+      return new Date(m[1], m[2] - 1, m[3], m[4], m[5], m[6]).getTime();
+    }
+    return Date.parse(s) || 0;
+  }
+
+  // newEntryName mirrors utils.NewULID
+  function newEntryName() {
+    var alphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ";
+    var bytes = new Uint8Array(16);
+    window.crypto.getRandomValues(bytes.subarray(6));
+    var ms = Date.now();
+    for (var i = 5; i >= 0; i--) {
+      bytes[i] = ms % 256;
+      ms = Math.floor(ms / 256);
+    }
+    // 128 bits are 26 characters of 5 bits, with the first holding 3 bits
+    var name = "";
+    for (var c = 0; c < 26; c++) {
+      var v = 0;
+      for (var j = 0; j < 5; j++) {
+        var pos = 125 - 5 * c + j; // counted from the lowest bit
+        if (pos < 128 && bytes[15 - (pos >> 3)] & (1 << (pos & 7))) {
+          v |= 1 << j;
+        }
+      }
+      name += alphabet[v];
+    }
+    return name;
+  }
+
+  // fileName mirrors the name ssed gives the file of an entry
+  function fileName(e) {
+    var content = e.text + e.document + "/" + e.entry + e.timestamp + e.modified_timestamp;
+    if (e.tags && e.tags.length > 0) {
+      content += "#" + e.tags.join(",");
+    }
+    (e.attachments || []).forEach(function(a) {
+      content += a.id;
+    });
+    return sha256Hex(content).then(function(hash) {
+      return hash + ".json";
+    });
+  }
+
+  function modified(e) {
+    return parseDate(e.modified_timestamp || e.timestamp);
+  }
+
+  // nextModified returns the modified timestamp of a new version, which is
+  // after the version it replaces, as versions are ordered by the second
+  function nextModified(previous) {
+    var now = Date.now();
+    if (previous && modified(previous) >= now - 1000) {
+      now = modified(previous) + 1000;
+    }
+    return formatDate(new Date(now));
+  }
+
+  // latest mirrors parseArchive: the latest version of every entry, by
+  // "Document/Entry", including the ones that were deleted
+  function latest(entries) {
+    var versions = {};
+    entries.forEach(function(e) {
+      var name = e.document + "/" + e.entry;
+      if (!(name in versions) || modified(e) > modified(versions[name])) {
+        versions[name] = e;
+      }
+    });
+    return versions;
+  }
+
+  function byTimestamp(a, b) {
+    return parseDate(a.timestamp) - parseDate(b.timestamp);
+  }
+
+  // documents mirrors GetDocument: the entries in the order they were
+  // made, without deleted entries and documents
+  function documents(entries) {
+    var versions = latest(entries);
+    var docs = {};
+    Object.keys(versions).forEach(function(name) {
+      var e = versions[name];
+      (docs[e.document] = docs[e.document] || []).push(e);
+    });
+    Object.keys(docs).forEach(function(name) {
+      var list = docs[name];
+      if (list.some(function(e) { return e.text === "ignore document"; })) {
+        delete docs[name];
+        return;
+      }
+      docs[name] = list.filter(function(e) {
+        return e.text !== "ignore entry";
+      }).sort(byTimestamp);
+    });
+    return docs;
+  }
+
+  // deleted returns the entries of a document that were deleted
+  function deleted(entries, documentName) {
+    var versions = latest(entries);
+    return Object.keys(versions).map(function(name) {
+      return versions[name];
+    }).filter(function(e) {
+      return e.document === documentName && e.text === "ignore entry";
+    }).sort(byTimestamp);
+  }
+
+  // history mirrors GetHistory: every version of an entry, oldest first
+  function history(entries, documentName, entryName) {
+    return entries.filter(function(e) {
+      return e.document === documentName && e.entry === entryName;
+    }).sort(function(a, b) {
+      return modified(a) - modified(b);
+    });
+  }
+
+  function escapeHTML(s) {
+    return s.replace(/&/g, "&amp;").replace(/</g, "&lt;").replace(/>/g, "&gt;").replace(/"/g, "&quot;");
+  }
+
+  function unescapeHTML(s) {
+    return s.replace(/&quot;/g, '"').replace(/&gt;/g, ">").replace(/&lt;/g, "<").replace(/&amp;/g, "&");
+  }
+
+  // inline renders the markdown of a line, which is already escaped. Links
+  // between entries are written [[Document/Entry]] or [[Entry]], like
+  // ssed.ReplaceLinks.
+  function inline(s, documentName, editing) {
+    var codes = [];
+    s = s.replace(/`([^`]+)`/g, function(_, code) {
+      codes.push(code);
+      return "\u0000" + (codes.length - 1) + "\u0000";
+    });
+    s = editing ? s : s.replace(/\[\[([^\[\]]+)\]\]/g, function(_, link) {
+      var path = unescapeHTML(link.trim());
+      var i = path.lastIndexOf("/");
+      var doc = i < 0 ? documentName : path.slice(0, i);
+      return '<a href="' + permalink(doc, path.slice(i + 1).trim()) + '">' + link + "</a>";
+    });
+    s = s.replace(/\[([^\]]+)\]\((https?:\/\/[^)\s]+)\)/g, '<a href="$2" rel="noopener noreferrer">$1</a>');
+    s = s.replace(/\*\*([^*]+)\*\*/g, "<strong>$1</strong>");
+    s = s.replace(/\*([^*]+)\*/g, "<em>$1</em>");
+    return s.replace(/\u0000(\d+)\u0000/g, function(_, i) {
+      return "<code>" + codes[i] + "</code>";
+    });
+  }
+
+  // render returns the HTML of the markdown of an entry. For the editor,
+  // links between entries are kept as they are written, and headers are not
+  // made smaller than the header of the entry.
+  function render(text, documentName, editing) {
+    var shift = editing ? 0 : 2;
+    var html = "";
+    var paragraph = [];
+    var list = null;
+    function flush() {
+      if (paragraph.length > 0) {
+        html += "<p>" + paragraph.join(" ") + "</p>";
+        paragraph = [];
+      }
+      if (list) {
+        html += "</" + list + ">";
+        list = null;
+      }
+    }
+    var lines = escapeHTML(text).split("\n");
+    for (var i = 0; i < lines.length; i++) {
+      var line = lines[i];
+      var m;
+      if (/^```/.test(line)) {
+        flush();
+        var code = [];
+        for (i++; i < lines.length && !/^```/.test(lines[i]); i++) {
+          code.push(lines[i]);
+        }
+        html += "<pre><code>" + code.join("\n") + "</code></pre>";
+      } else if ((m = /^(#{1,6})\s+(.*)$/.exec(line))) {
+        flush();
+        html += "<h" + (m[1].length + shift) + ">" + inline(m[2], documentName, editing) + "</h" + (m[1].length + shift) + ">";
+      } else if ((m = /^\s*(?:[-*+]|(\d+)\.)\s+(.*)$/.exec(line))) {
+        var tag = m[1] ? "ol" : "ul";
+        if (paragraph.length > 0 || list !== tag) {
+          flush();
+          list = tag;
+          html += "<" + tag + ">";
+        }
+        html += "<li>" + inline(m[2], documentName, editing) + "</li>";
+      } else if (line.trim() === "") {
+        flush();
+      } else {
+        if (list) {
+          flush();
+        }
+        paragraph.push(inline(line, documentName, editing));
+      }
+    }
+    flush();
+    return html;
+  }
+
+  // search returns the entries with every word of the query in their
+  // document, name, text or tags, newest first
+  function search(entries, query) {
+    var words = query.toLowerCase().split(/\s+/).filter(function(word) {
+      return word.length > 0;
+    });
+    if (words.length === 0) {
+      return [];
+    }
+    var docs = documents(entries);
+    var found = [];
+    Object.keys(docs).forEach(function(name) {
+      docs[name].forEach(function(e) {
+        var text = [e.document, e.entry, e.text].concat(e.tags || []).join("\n").toLowerCase();
+        if (words.every(function(word) { return text.indexOf(word) >= 0; })) {
+          found.push(e);
+        }
+      });
+    });
+    return found.sort(byTimestamp).reverse();
+  }
+
+  // permalink returns the address of a document or entry in the page, which
+  // stays in the browser as it is after the #
+  function permalink(documentName, entryName) {
+    var link = "#/" + encodeURIComponent(documentName);
+    if (entryName) {
+      link += "/" + encodeURIComponent(entryName);
+    }
+    return link;
+  }
+
+  // markdownOf returns the markdown of the contents of the editor (a Quill
+  // delta), for the formats that render makes
+  function markdownOf(delta) {
+    var lines = [];
+    var line = "";
+    delta.ops.forEach(function(op) {
+      if (typeof op.insert !== "string") {
+        return;
+      }
+      var attributes = op.attributes || {};
+      op.insert.split("\n").forEach(function(part, i) {
+        if (i > 0) {
+          // the newline has the format of the line
+          var type = "p";
+          if (attributes.header) {
+            type = "h";
+            line = new Array(attributes.header + 1).join("#") + " " + line;
+          } else if (attributes.list) {
+            type = attributes.list;
+            line = (type === "ordered" ? "1. " : "- ") + line;
+          } else if (attributes["code-block"]) {
+            type = "code";
+          }
+          lines.push({type: type, text: line});
+          line = "";
+        }
+        if (part.length === 0) {
+          return;
+        }
+        if (attributes.code) {
+          part = "`" + part + "`";
+        }
+        if (attributes.bold) {
+          part = "**" + part + "**";
+        }
+        if (attributes.italic) {
+          part = "*" + part + "*";
+        }
+        if (attributes.link) {
+          part = "[" + part + "](" + attributes.link + ")";
+        }
+        line += part;
+      });
+    });
+    if (line.length > 0) {
+      lines.push({type: "p", text: line});
+    }
+
+    var markdown = "";
+    var previous = null;
+    lines.forEach(function(l) {
+      if (l.type === "p" && l.text.trim().length === 0) {
+        previous = null;
+        return;
+      }
+      var together = previous !== null && previous === l.type && l.type !== "p" && l.type !== "h";
+      if (previous === "code" && !together) {
+        markdown += "\n```";
+      }
+      if (markdown.length > 0) {
+        markdown += together ? "\n" : "\n\n";
+      }
+      if (l.type === "code" && !together) {
+        markdown += "```\n";
+      }
+      markdown += l.text;
+      previous = l.type;
+    });
+    if (previous === "code") {
+      markdown += "\n```";
+    }
+    return markdown;
+  }
+
+  return {
+    supported: supported,
+    login: login,
+    logout: logout,
+    hasKey: hasKey,
+    decrypt: decrypt,
+    encrypt: encrypt,
+    decryptText: function(encrypted) {
+      return decrypt(encrypted).then(function(b) { return decoder.decode(b); });
+    },
+    formatDate: formatDate,
+    newEntryName: newEntryName,
+    fileName: fileName,
+    nextModified: nextModified,
+    latest: latest,
+    documents: documents,
+    deleted: deleted,
+    history: history,
+    markdownOf: markdownOf,
+    search: search,
+    permalink: permalink,
+    escapeHTML: escapeHTML,
+    render: render
+  };
+})();
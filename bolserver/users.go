@@ -0,0 +1,172 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// ErrUserNotFound is returned for users that are not registered
+var ErrUserNotFound = errors.New("User does not exist")
+
+// ErrUserExists is returned when registering a user twice
+var ErrUserExists = errors.New("User already exists")
+
+// User is what the server knows about a user
+type User struct {
+	Username string `json:"username"`
+	// Login is the hash of the authentication key, or of the password of
+	// older clients (see authPrefix)
+	Login   string    `json:"login"`
+	Created time.Time `json:"created"`
+	// Quota is the number of bytes the user can keep, or 0 for the default
+	Quota    int64     `json:"quota"`
+	LastPush time.Time `json:"last_push"`
+}
+
+// UserStore keeps the users. It is safe to use from many requests at once,
+// and every change is made whole or not at all.
+type UserStore interface {
+	// Get returns the user, or ErrUserNotFound
+	Get(username string) (User, error)
+	// Add registers a new user, or returns ErrUserExists
+	Add(user User) error
+	// Update changes a user with f, which can stop the change by returning
+	// an error
+	Update(username string, f func(*User) error) error
+	Close() error
+}
+
+// users is the UserStore of the server
+var users UserStore
+
+var usersBucket = []byte("users")
+
+// boltUserStore keeps the users in a bbolt database
+type boltUserStore struct {
+	db *bolt.DB
+}
+
+// openUserStore opens the database of users, adding the users of an older
+// logins.json, which is then renamed to logins.json.migrated
+func openUserStore(fileName, loginsFileName string) (UserStore, error) {
+	db, err := bolt.Open(fileName, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(usersBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	s := &boltUserStore{db: db}
+	if err = s.migrate(loginsFileName); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// migrate adds the users of logins.json, which maps usernames to logins,
+// unless they are already in the database
+func (s *boltUserStore) migrate(loginsFileName string) error {
+	data, err := ioutil.ReadFile(loginsFileName)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	logins := make(map[string]string)
+	if err = json.Unmarshal(data, &logins); err != nil {
+		return errors.New("Could not read " + loginsFileName + ": " + err.Error())
+	}
+	// the users were made some time before the file was last written
+	created := time.Now()
+	if fi, err := os.Stat(loginsFileName); err == nil {
+		created = fi.ModTime()
+	}
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(usersBucket)
+		for username, login := range logins {
+			if b.Get([]byte(username)) != nil {
+				continue
+			}
+			if err := putUser(b, User{Username: username, Login: login, Created: created}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("Moved %d users from %s to the database\n", len(logins), loginsFileName)
+	return os.Rename(loginsFileName, loginsFileName+".migrated")
+}
+
+func putUser(b *bolt.Bucket, user User) error {
+	data, err := json.Marshal(user)
+	if err != nil {
+		return err
+	}
+	return b.Put([]byte(user.Username), data)
+}
+
+func getUser(b *bolt.Bucket, username string) (User, error) {
+	var user User
+	data := b.Get([]byte(username))
+	if data == nil {
+		return user, ErrUserNotFound
+	}
+	err := json.Unmarshal(data, &user)
+	return user, err
+}
+
+func (s *boltUserStore) Get(username string) (user User, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		user, err = getUser(tx.Bucket(usersBucket), username)
+		return err
+	})
+	return
+}
+
+func (s *boltUserStore) Add(user User) error {
+	if len(user.Username) == 0 {
+		return errors.New("The username can not be empty")
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(usersBucket)
+		if b.Get([]byte(user.Username)) != nil {
+			return ErrUserExists
+		}
+		return putUser(b, user)
+	})
+}
+
+func (s *boltUserStore) Update(username string, f func(*User) error) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(usersBucket)
+		user, err := getUser(b, username)
+		if err != nil {
+			return err
+		}
+		if err = f(&user); err != nil {
+			return err
+		}
+		// the username is the key, so it can not change
+		user.Username = username
+		return putUser(b, user)
+	})
+}
+
+func (s *boltUserStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sync"
+	"testing"
+)
+
+// tempUserStore opens a user store in a new folder, with the logins.json
+// of the folder
+func tempUserStore(t *testing.T) (UserStore, string) {
+	folder, err := ioutil.TempDir("", "bolserver")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := openUserStore(path.Join(folder, "users.db"), path.Join(folder, "logins.json"))
+	if err != nil {
+		os.RemoveAll(folder)
+		t.Fatal(err)
+	}
+	return s, folder
+}
+
+func TestUserStore(t *testing.T) {
+	s, folder := tempUserStore(t)
+	defer os.RemoveAll(folder)
+	defer s.Close()
+
+	if err := s.Add(User{}); err == nil {
+		t.Errorf("Added a user without a username")
+	}
+	if err := s.Add(User{Username: "alice", Login: "hash"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Add(User{Username: "alice", Login: "other"}); err != ErrUserExists {
+		t.Errorf("Added a user twice: %v", err)
+	}
+	if _, err := s.Get("bob"); err != ErrUserNotFound {
+		t.Errorf("Got a user that does not exist: %v", err)
+	}
+	if err := s.Update("bob", func(user *User) error { return nil }); err != ErrUserNotFound {
+		t.Errorf("Updated a user that does not exist: %v", err)
+	}
+
+	stop := errors.New("stop")
+	err := s.Update("alice", func(user *User) error {
+		user.Quota = 100
+		return stop
+	})
+	if user, _ := s.Get("alice"); err != stop || user.Quota != 0 {
+		t.Errorf("An update that returned an error was kept: %+v %v", user, err)
+	}
+	s.Update("alice", func(user *User) error {
+		user.Username = "mallory"
+		user.Quota = 100
+		return nil
+	})
+	if user, err := s.Get("alice"); err != nil || user.Username != "alice" || user.Quota != 100 || user.Login != "hash" {
+		t.Errorf("Problem updating the user: %+v %v", user, err)
+	}
+	if _, err := s.Get("mallory"); err != ErrUserNotFound {
+		t.Errorf("The username was changed by an update: %v", err)
+	}
+}
+
+func TestUserStoreConcurrent(t *testing.T) {
+	s, folder := tempUserStore(t)
+	defer os.RemoveAll(folder)
+	defer s.Close()
+
+	// only one of the users that register the same name gets it
+	var wg sync.WaitGroup
+	added := make(chan string, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			login := fmt.Sprintf("login%d", i)
+			if err := s.Add(User{Username: "alice", Login: login}); err == nil {
+				added <- login
+			} else if err != ErrUserExists {
+				t.Errorf("Problem adding a user: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(added)
+	var logins []string
+	for login := range added {
+		logins = append(logins, login)
+	}
+	if user, err := s.Get("alice"); len(logins) != 1 || err != nil || user.Login != logins[0] {
+		t.Errorf("Expected one user to be added, got %v and %+v %v", logins, user, err)
+	}
+
+	// no update is lost
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := s.Update("alice", func(user *User) error {
+				user.Quota++
+				return nil
+			})
+			if err != nil {
+				t.Errorf("Problem updating a user: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if user, _ := s.Get("alice"); user.Quota != 50 {
+		t.Errorf("Expected 50 updates, got %d", user.Quota)
+	}
+}
+
+func TestUserStoreMigrate(t *testing.T) {
+	folder, err := ioutil.TempDir("", "bolserver")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(folder)
+	dbFileName, loginsFileName := path.Join(folder, "users.db"), path.Join(folder, "logins.json")
+
+	// alice registered again after the database was made
+	s, err := openUserStore(dbFileName, loginsFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Add(User{Username: "alice", Login: "new"})
+	s.Close()
+
+	ioutil.WriteFile(loginsFileName, []byte(`{"alice": "old", "bob": "bob's"}`), 0644)
+	for i := 0; i < 2; i++ {
+		// the second time, logins.json was moved aside and is not read
+		s, err = openUserStore(dbFileName, loginsFileName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if user, err := s.Get("alice"); err != nil || user.Login != "new" {
+			t.Errorf("Migrating replaced a user in the database: %+v %v", user, err)
+		}
+		if user, err := s.Get("bob"); err != nil || user.Login != "bob's" || user.Created.IsZero() {
+			t.Errorf("Problem migrating a user: %+v %v", user, err)
+		}
+		if _, err := os.Stat(loginsFileName); !os.IsNotExist(err) {
+			t.Errorf("logins.json was not moved aside: %v", err)
+		}
+		if b, err := ioutil.ReadFile(loginsFileName + ".migrated"); err != nil || string(b) != `{"alice": "old", "bob": "bob's"}` {
+			t.Errorf("Problem with logins.json.migrated: %s %v", b, err)
+		}
+		s.Close()
+	}
+
+	// a logins.json that comes back, like from a backup, does not replace
+	// the users either
+	s, _ = openUserStore(dbFileName, loginsFileName)
+	s.Update("bob", func(user *User) error {
+		user.Login = "changed"
+		return nil
+	})
+	s.Close()
+	ioutil.WriteFile(loginsFileName, []byte(`{"bob": "bob's", "carol": "carol's"}`), 0644)
+	s, err = openUserStore(dbFileName, loginsFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if user, _ := s.Get("bob"); user.Login != "changed" {
+		t.Errorf("Migrating again replaced a user: %+v", user)
+	}
+	if user, err := s.Get("carol"); err != nil || user.Login != "carol's" {
+		t.Errorf("Problem migrating again: %+v %v", user, err)
+	}
+
+	ioutil.WriteFile(loginsFileName, []byte(`not json`), 0644)
+	if s, err := openUserStore(path.Join(folder, "other.db"), loginsFileName); err == nil {
+		s.Close()
+		t.Errorf("Opened the users with a logins.json that can not be read")
+	}
+}
@@ -0,0 +1,260 @@
+package ssed
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/schollz/bol/utils"
+)
+
+// ErrNoAgent is returned when the password is asked from an agent, but no
+// agent is running
+var ErrNoAgent = errors.New("No agent is running")
+
+var useAgent bool
+
+// UseAgent makes Open get the keys of a repository from the agent when the
+// password is empty, and give the agent the keys of the repositories it
+// opens. It is off by default, so that servers never share keys with an
+// agent.
+func UseAgent() {
+	useAgent = true
+}
+
+// agentRequest is sent to the agent, which answers with an agentResponse.
+// Commands are "get", "set", "ping" and "stop". The agent only gets the
+// keys that are derived from the password, never the password itself.
+type agentRequest struct {
+	Command  string `json:"command"`
+	Username string `json:"username,omitempty"`
+	Key      []byte `json:"key,omitempty"`      // the key of the repository
+	AuthKey  []byte `json:"auth_key,omitempty"` // see utils.AuthKey
+}
+
+type agentResponse struct {
+	Key     []byte `json:"key,omitempty"`
+	AuthKey []byte `json:"auth_key,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// maxAgentRequest is the most the agent reads of a request
+const maxAgentRequest = 4096
+
+// AgentSocket returns the path of the Unix socket of the agent, which is in
+// $XDG_RUNTIME_DIR when it exists. It is in a folder of its own, which only
+// the user can open.
+func AgentSocket() string {
+	if runtimeDir := os.Getenv("XDG_RUNTIME_DIR"); len(runtimeDir) > 0 && utils.Exists(runtimeDir) {
+		return path.Join(runtimeDir, "bol-agent", "agent.sock")
+	}
+	if len(pathToConfigFolder) == 0 {
+		createDirs()
+	}
+	return path.Join(pathToConfigFolder, "agent", "agent.sock")
+}
+
+func askAgent(request agentRequest) (agentResponse, error) {
+	var response agentResponse
+	conn, err := net.DialTimeout("unix", AgentSocket(), time.Second)
+	if err != nil {
+		return response, ErrNoAgent
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	if err = json.NewEncoder(conn).Encode(request); err != nil {
+		return response, err
+	}
+	if err = json.NewDecoder(conn).Decode(&response); err != nil {
+		return response, err
+	}
+	if len(response.Error) > 0 {
+		return response, errors.New(response.Error)
+	}
+	return response, nil
+}
+
+// setAgentKeys gives the agent the keys of the open repository
+func (ssed *Fs) setAgentKeys() {
+	askAgent(agentRequest{Command: "set", Username: ssed.username, Key: []byte(ssed.password), AuthKey: []byte(ssed.authKey)})
+}
+
+// AgentRunning returns whether an agent answers on AgentSocket
+func AgentRunning() bool {
+	_, err := askAgent(agentRequest{Command: "ping"})
+	return err == nil
+}
+
+// StopAgent makes the agent forget its passwords and stop
+func StopAgent() error {
+	_, err := askAgent(agentRequest{Command: "stop"})
+	return err
+}
+
+// keyring holds the keys of the agent in locked memory, so that they are
+// never swapped to disk, and zeroes them when they are forgotten. The keys
+// of each user are kept as the response to "get", so they are written to
+// the socket without being copied.
+type keyring struct {
+	sync.Mutex
+	responses map[string][]byte // username -> agentResponse in JSON
+}
+
+func (k *keyring) set(username string, key, authKey []byte) {
+	k.Lock()
+	defer k.Unlock()
+	if old, ok := k.responses[username]; ok {
+		zero(old)
+	}
+	// like json.Marshal of an agentResponse, which would copy the keys
+	parts := [][]byte{[]byte(`{"key":"`), key, []byte(`","auth_key":"`), authKey, []byte("\"}\n")}
+	size := 0
+	for i, part := range parts {
+		if i%2 == 1 {
+			size += base64.StdEncoding.EncodedLen(len(part))
+		} else {
+			size += len(part)
+		}
+	}
+	b := make([]byte, size)
+	if err := lockMemory(b); err != nil {
+		logger.Warn("Could not lock the memory of the keys: %s", err.Error())
+	}
+	n := 0
+	for i, part := range parts {
+		if i%2 == 1 {
+			base64.StdEncoding.Encode(b[n:], part)
+			n += base64.StdEncoding.EncodedLen(len(part))
+		} else {
+			n += copy(b[n:], part)
+		}
+	}
+	k.responses[username] = b
+}
+
+// writeKeys writes the response to "get" for the user, and returns whether
+// the agent has keys for the user
+func (k *keyring) writeKeys(w io.Writer, username string) bool {
+	k.Lock()
+	defer k.Unlock()
+	b, ok := k.responses[username]
+	if ok {
+		w.Write(b)
+	}
+	return ok
+}
+
+func (k *keyring) wipe() {
+	k.Lock()
+	defer k.Unlock()
+	for username, b := range k.responses {
+		zero(b)
+		delete(k.responses, username)
+	}
+}
+
+func zero(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
+
+// RunAgent keeps the keys of opened repositories, so that other bol
+// commands do not need to ask for their passwords. It returns once StopAgent is
+// called, or when no command used it for idleTimeout.
+func RunAgent(idleTimeout time.Duration) error {
+	socket := AgentSocket()
+	if AgentRunning() {
+		return errors.New("An agent is already running at " + socket)
+	}
+	// the socket is made in a folder that only the user can open, as it
+	// can be connected to before its own mode is changed
+	folder := path.Dir(socket)
+	if err := os.MkdirAll(folder, 0700); err != nil {
+		return err
+	}
+	if err := os.Chmod(folder, 0700); err != nil {
+		return err
+	}
+	// left over from an agent that did not stop
+	os.Remove(socket)
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+	if err = os.Chmod(socket, 0600); err != nil {
+		return err
+	}
+	logger.Debug("Agent listening on %s", socket)
+
+	keys := keyring{responses: make(map[string][]byte)}
+	defer keys.wipe()
+	idle := time.AfterFunc(idleTimeout, func() {
+		logger.Debug("Agent was idle for %s, stopping", idleTimeout.String())
+		listener.Close()
+	})
+	defer idle.Stop()
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			// closed by the idle timer
+			return nil
+		}
+		idle.Reset(idleTimeout)
+		if stop := keys.serve(conn); stop {
+			return nil
+		}
+	}
+}
+
+// serve answers one request, and returns whether the agent should stop
+func (k *keyring) serve(conn net.Conn) bool {
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	// the request is read into locked memory, and the keys it has are
+	// zeroed once they are kept
+	b := make([]byte, maxAgentRequest)
+	lockMemory(b)
+	defer zero(b)
+	n := 0
+	for n < len(b) && bytes.IndexByte(b[:n], '\n') < 0 {
+		read, err := conn.Read(b[n:])
+		n += read
+		if err != nil {
+			break
+		}
+	}
+	var request agentRequest
+	var response agentResponse
+	err := json.Unmarshal(bytes.TrimSpace(b[:n]), &request)
+	defer zero(request.Key)
+	defer zero(request.AuthKey)
+	if err != nil {
+		return false
+	}
+	stop := false
+	switch request.Command {
+	case "get":
+		if k.writeKeys(conn, request.Username) {
+			return false
+		}
+		response.Error = "The agent has no keys for " + request.Username
+	case "set":
+		k.set(request.Username, request.Key, request.AuthKey)
+	case "ping":
+	case "stop":
+		stop = true
+	default:
+		response.Error = "Unknown command " + request.Command
+	}
+	json.NewEncoder(conn).Encode(response)
+	return stop
+}
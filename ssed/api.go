@@ -0,0 +1,296 @@
+package ssed
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// apiPrefix is the path of version 1 of the JSON API
+const apiPrefix = "/api/v1"
+
+// API serves the documents of an open repository as JSON, for clients
+// other than bol, like editors and phones. It runs where the repository is
+// decrypted, as the server only has encrypted files. Every request needs
+// the token in an "Authorization: Bearer" header, except for the OpenAPI
+// description at /api/v1/openapi.json. The archive on the server is pulled
+// before each change, and changes are uploaded as they are made.
+type API struct {
+	fs    *Fs
+	token string
+	lock  sync.Mutex
+}
+
+// NewAPI returns the API of an open repository
+func NewAPI(fs *Fs, token string) *API {
+	return &API{fs: fs, token: token}
+}
+
+// APIDocument is a document as it is listed by the API
+type APIDocument struct {
+	Document string `json:"document"`
+	Entries  int    `json:"entries"`
+}
+
+// apiEntry is the body of requests that write an entry
+type apiEntry struct {
+	Entry     string   `json:"entry"`
+	Text      string   `json:"text"`
+	Timestamp string   `json:"timestamp"`
+	Tags      []string `json:"tags"`
+}
+
+func (api *API) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.URL.EscapedPath(), apiPrefix+"/") {
+		apiError(w, http.StatusNotFound, "Not found")
+		return
+	}
+	// documents like work/notes are written as work%2Fnotes, so the path is
+	// split before it is unescaped
+	route := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.EscapedPath(), apiPrefix), "/"), "/")
+	for i := range route {
+		segment, err := url.PathUnescape(route[i])
+		if err != nil {
+			apiError(w, http.StatusBadRequest, "Could not read the path")
+			return
+		}
+		route[i] = segment
+	}
+	if len(route) == 1 && route[0] == "openapi.json" {
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, openAPI)
+		return
+	}
+	const bearer = "Bearer "
+	token := r.Header.Get("Authorization")
+	if len(api.token) == 0 || !strings.HasPrefix(token, bearer) ||
+		subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(token, bearer)), []byte(api.token)) != 1 {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="bol"`)
+		apiError(w, http.StatusUnauthorized, "Incorrect token")
+		return
+	}
+	if route[0] != "documents" || len(route) > 5 || (len(route) > 2 && route[2] != "entries") || (len(route) == 5 && route[4] != "history") {
+		apiError(w, http.StatusNotFound, "Not found")
+		return
+	}
+
+	// the repository is not safe to use from more than one request
+	api.lock.Lock()
+	defer api.lock.Unlock()
+	if r.Method != "GET" && !api.pull(w) {
+		return
+	}
+	switch len(route) {
+	case 1:
+		if allowMethods(w, r, "GET") {
+			api.listDocuments(w)
+		}
+	case 2:
+		if allowMethods(w, r, "GET", "DELETE") {
+			api.document(w, r, route[1])
+		}
+	case 3:
+		if allowMethods(w, r, "GET", "POST") {
+			api.entries(w, r, route[1])
+		}
+	case 4:
+		if allowMethods(w, r, "GET", "PUT", "DELETE") {
+			api.entry(w, r, route[1], route[3])
+		}
+	case 5:
+		if allowMethods(w, r, "GET") {
+			api.history(w, route[1], route[3])
+		}
+	}
+}
+
+func (api *API) listDocuments(w http.ResponseWriter) {
+	documents := []APIDocument{}
+	for _, document := range api.fs.ListDocuments() {
+		documents = append(documents, APIDocument{document, len(api.fs.GetDocument(document))})
+	}
+	apiJSON(w, http.StatusOK, documents)
+}
+
+func (api *API) document(w http.ResponseWriter, r *http.Request, documentName string) {
+	entries := api.fs.GetDocument(documentName)
+	if len(entries) == 0 {
+		apiError(w, http.StatusNotFound, "No document named '"+documentName+"'")
+		return
+	}
+	if r.Method == "DELETE" {
+		api.fs.DeleteDocument(documentName)
+		api.sync()
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	apiJSON(w, http.StatusOK, APIDocument{documentName, len(entries)})
+}
+
+func (api *API) entries(w http.ResponseWriter, r *http.Request, documentName string) {
+	if r.Method == "GET" {
+		entries := api.fs.GetDocument(documentName)
+		if len(entries) == 0 {
+			apiError(w, http.StatusNotFound, "No document named '"+documentName+"'")
+			return
+		}
+		apiJSON(w, http.StatusOK, entries)
+		return
+	}
+
+	e, ok := readAPIEntry(w, r)
+	if !ok {
+		return
+	}
+	if len(e.Entry) > 0 {
+		if _, err := api.fs.GetEntry(documentName, e.Entry); err == nil {
+			apiError(w, http.StatusConflict, documentName+"/"+e.Entry+" already exists")
+			return
+		}
+	}
+	api.write(w, documentName, e, http.StatusCreated)
+}
+
+func (api *API) entry(w http.ResponseWriter, r *http.Request, documentName, entryName string) {
+	current, err := api.fs.GetEntry(documentName, entryName)
+	switch r.Method {
+	case "GET":
+		if err != nil {
+			apiError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		apiJSON(w, http.StatusOK, current)
+	case "PUT":
+		e, ok := readAPIEntry(w, r)
+		if !ok {
+			return
+		}
+		e.Entry = entryName
+		status := http.StatusOK
+		if err != nil {
+			status = http.StatusCreated
+		} else if e.Tags == nil {
+			// like Update, the tags are kept unless they are given
+			e.Tags = current.Tags
+		}
+		api.write(w, documentName, e, status)
+	case "DELETE":
+		if err != nil {
+			apiError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		api.fs.DeleteEntry(documentName, entryName)
+		api.sync()
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (api *API) history(w http.ResponseWriter, documentName, entryName string) {
+	versions := api.fs.GetHistory(documentName, entryName)
+	if len(versions) == 0 {
+		apiError(w, http.StatusNotFound, "Entry not found")
+		return
+	}
+	apiJSON(w, http.StatusOK, versions)
+}
+
+// write makes a new version of the entry and returns it
+func (api *API) write(w http.ResponseWriter, documentName string, e apiEntry, status int) {
+	if len(documentName) == 0 {
+		apiError(w, http.StatusBadRequest, "The name of the document can not be empty")
+		return
+	}
+	var err error
+	if len(e.Entry) == 0 {
+		if e.Entry, err = api.fs.NewEntryName(); err != nil {
+			apiError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+	err = api.fs.UpdateEntry(Entry{
+		Text:      e.Text,
+		Document:  documentName,
+		Entry:     e.Entry,
+		Timestamp: e.Timestamp,
+		Tags:      e.Tags,
+	})
+	if err == ErrReadOnly || err == ErrVaultLocked {
+		apiError(w, http.StatusForbidden, err.Error())
+		return
+	} else if err != nil {
+		apiError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	api.sync()
+	entry, err := api.fs.GetEntry(documentName, e.Entry)
+	if err != nil {
+		apiError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	apiJSON(w, status, entry)
+}
+
+// pull adds what other devices pushed before a change, so it is not
+// replaced by the upload. Without a connection the change is kept locally.
+func (api *API) pull(w http.ResponseWriter) bool {
+	err := api.fs.Pull()
+	if err == ErrPasswordChanged {
+		apiError(w, http.StatusServiceUnavailable, err.Error())
+		return false
+	} else if err != nil {
+		logger.Debug("Could not pull: %s", err.Error())
+	}
+	return true
+}
+
+// sync uploads the changes, which are kept locally if that fails or if
+// another device pushed since the pull
+func (api *API) sync() {
+	if err := api.fs.Close(); err != nil {
+		logger.Debug("%s", err.Error())
+	}
+}
+
+func readAPIEntry(w http.ResponseWriter, r *http.Request) (apiEntry, bool) {
+	var e apiEntry
+	if err := json.NewDecoder(r.Body).Decode(&e); err != nil {
+		apiError(w, http.StatusBadRequest, "The body must be an entry in JSON: "+err.Error())
+		return e, false
+	}
+	e.Text = strings.TrimSpace(e.Text)
+	if len(e.Text) == 0 {
+		apiError(w, http.StatusBadRequest, "The text of the entry can not be empty")
+		return e, false
+	}
+	if strings.Contains(e.Entry, "/") {
+		apiError(w, http.StatusBadRequest, "The name of the entry can not have a '/'")
+		return e, false
+	}
+	return e, true
+}
+
+// allowMethods answers 405 to other methods
+func allowMethods(w http.ResponseWriter, r *http.Request, methods ...string) bool {
+	for _, method := range methods {
+		if r.Method == method {
+			return true
+		}
+	}
+	w.Header().Set("Allow", strings.Join(methods, ", "))
+	apiError(w, http.StatusMethodNotAllowed, r.Method+" is not allowed")
+	return false
+}
+
+func apiJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func apiError(w http.ResponseWriter, status int, message string) {
+	apiJSON(w, status, map[string]string{"error": message})
+}
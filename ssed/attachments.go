@@ -0,0 +1,130 @@
+package ssed
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/schollz/bol/utils"
+)
+
+// attachmentExtension is the extension of the encrypted attachment files
+// kept in the repo next to the entries
+const attachmentExtension = ".attachment"
+
+// Attachment is a file that is stored encrypted alongside the entry that
+// references it
+type Attachment struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+}
+
+// Attach encrypts the file into the repo and adds it to a new version of
+// the entry
+func (ssed *Fs) Attach(documentName, entryName, fileName string) (Attachment, error) {
+	var attachment Attachment
+	e, err := ssed.GetEntry(documentName, entryName)
+	if err != nil {
+		return attachment, err
+	}
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return attachment, err
+	}
+
+	attachment.ID, err = utils.NewULID()
+	if err != nil {
+		return attachment, err
+	}
+	attachment.Name = filepath.Base(fileName)
+	attachment.ContentType = http.DetectContentType(data)
+	attachment.Size = int64(len(data))
+	fileName, password := ssed.attachmentPath(attachment), ssed.password
+	if s, ok := ssed.shares[documentName]; ok {
+		if !s.Writable {
+			return attachment, ErrReadOnly
+		}
+		fileName, password = path.Join(s.folder(), path.Base(fileName)), s.Key
+	} else if v := ssed.vaultOf(documentName); v != nil {
+		if len(v.key) == 0 {
+			return attachment, ErrVaultLocked
+		}
+		fileName, password = v.attachmentPath(ssed.pathToLocalRepo, attachment), v.key
+	}
+	err = utils.EncryptToFile(data, password, fileName)
+	if err != nil {
+		return attachment, err
+	}
+	logger.Debug("Attached %s to %s/%s as %s", attachment.Name, documentName, entryName, attachment.ID)
+
+	e.Attachments = append(append([]Attachment{}, e.Attachments...), attachment)
+	err = ssed.writeEntry(e)
+	if err != nil {
+		// nothing references the attachment, so don't keep it
+		os.Remove(fileName)
+	}
+	return attachment, err
+}
+
+// ReadAttachment returns the decrypted contents of an attachment, from the
+// repo, from a shared document or from an unlocked vault
+func (ssed *Fs) ReadAttachment(attachment Attachment) ([]byte, error) {
+	if utils.Exists(ssed.attachmentPath(attachment)) {
+		return utils.DecryptFromFile(ssed.password, ssed.attachmentPath(attachment))
+	}
+	for _, s := range ssed.shares {
+		fileName := path.Join(s.folder(), path.Base(ssed.attachmentPath(attachment)))
+		if utils.Exists(fileName) {
+			return utils.DecryptFromFile(s.Key, fileName)
+		}
+	}
+	for _, v := range ssed.vaults {
+		fileName := v.attachmentPath(ssed.pathToLocalRepo, attachment)
+		if len(v.key) > 0 && utils.Exists(fileName) {
+			return utils.DecryptFromFile(v.key, fileName)
+		}
+	}
+	return nil, errors.New("Attachment " + attachment.Name + " not found")
+}
+
+// Extract decrypts all the attachments of an entry into the folder and
+// returns the names of the files that were written. Existing files are
+// not overwritten.
+func (ssed *Fs) Extract(documentName, entryName, folder string) ([]string, error) {
+	e, err := ssed.GetEntry(documentName, entryName)
+	if err != nil {
+		return nil, err
+	}
+	fileNames := []string{}
+	for _, attachment := range e.Attachments {
+		data, err := ssed.ReadAttachment(attachment)
+		if err != nil {
+			return fileNames, err
+		}
+		// the names come from other devices and shared documents, so they
+		// can not point out of the folder
+		name := filepath.Base(attachment.Name)
+		if name == "." || name == ".." || name == string(filepath.Separator) {
+			return fileNames, errors.New("Attachment " + attachment.ID + " does not have a valid name")
+		}
+		fileName := path.Join(folder, name)
+		if utils.Exists(fileName) {
+			fileName = path.Join(folder, filepath.Base(attachment.ID)+"-"+name)
+		}
+		err = ioutil.WriteFile(fileName, data, 0644)
+		if err != nil {
+			return fileNames, err
+		}
+		fileNames = append(fileNames, fileName)
+	}
+	return fileNames, nil
+}
+
+func (ssed *Fs) attachmentPath(attachment Attachment) string {
+	return path.Join(ssed.pathToLocalRepo, filepath.Base(attachment.ID)+attachmentExtension)
+}
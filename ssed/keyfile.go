@@ -0,0 +1,122 @@
+package ssed
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+
+	"github.com/schollz/bol/utils"
+)
+
+const (
+	unlockExtension = ".unlock"
+	unlockFileName  = "repository" + unlockExtension
+)
+
+// Errors from Open when the password and key file do not match how the
+// repository is unlocked
+var (
+	ErrNeedKeyFile   = errors.New("This repository needs a key file, use --keyfile")
+	ErrUnusedKeyFile = errors.New("This repository does not use a key file, open it without --keyfile")
+	ErrNeedPassword  = errors.New("This repository needs the password together with the key file")
+	ErrKeyFileOnly   = errors.New("This repository is opened with the key file alone, leave the password empty")
+)
+
+// unlockMode is how the repository is unlocked. It is stored unencrypted
+// in the repository, so it is known before the repository is opened. A
+// repository without it is unlocked with the password alone.
+type unlockMode struct {
+	Password bool `json:"password"`
+	KeyFile  bool `json:"key_file"`
+}
+
+// UseKeyFile makes Open combine the password with the contents of a key
+// file, or use the key file alone. Whether the password is needed is
+// decided by the first Open of a new repository.
+func (ssed *Fs) UseKeyFile(fileName string) error {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return fmt.Errorf("Could not read key file: %s", err.Error())
+	}
+	if len(b) < 32 {
+		return errors.New("Key file is too short, it should have at least 32 random bytes")
+	}
+	ssed.keyFileHash = utils.HashAndHex(string(b))
+	return nil
+}
+
+func (ssed *Fs) readUnlockMode() unlockMode {
+	mode := unlockMode{Password: true}
+	b, err := ioutil.ReadFile(path.Join(ssed.pathToLocalRepo, unlockFileName))
+	if err == nil {
+		json.Unmarshal(b, &mode)
+	}
+	return mode
+}
+
+// NeedsPassword returns whether the repository is opened with a password,
+// which is false when it is opened with a key file alone
+func (ssed *Fs) NeedsPassword() bool {
+	ssed.wg.Wait()
+	return ssed.readUnlockMode().Password
+}
+
+// passwordOptional returns whether Open can go on without a password,
+// which is when the repository is opened with the key file alone, or when
+// a new repository will be
+func (ssed *Fs) passwordOptional() bool {
+	if len(ssed.keyFileHash) == 0 {
+		return false
+	}
+	if !utils.Exists(path.Join(ssed.pathToLocalRepo, unlockFileName)) {
+		return true
+	}
+	return !ssed.readUnlockMode().Password
+}
+
+// secret returns what encrypts the repository: the password, a hash of
+// the key file, or a hash of both
+func (ssed *Fs) secret(password string) (string, error) {
+	unlockFile := path.Join(ssed.pathToLocalRepo, unlockFileName)
+	if len(ssed.keyFileHash) > 0 && !utils.Exists(unlockFile) {
+		files, _ := filepath.Glob(path.Join(ssed.pathToLocalRepo, "*.json"))
+		if len(files) == 0 {
+			// a new repository
+			b, _ := json.Marshal(unlockMode{Password: len(password) > 0, KeyFile: true})
+			if err := ioutil.WriteFile(unlockFile, b, 0644); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return ssed.secretForMode(ssed.readUnlockMode(), password)
+}
+
+// secretForMode returns the secret of a repository that is unlocked with
+// the mode
+func (ssed *Fs) secretForMode(mode unlockMode, password string) (string, error) {
+	switch {
+	case mode.KeyFile && len(ssed.keyFileHash) == 0:
+		return "", ErrNeedKeyFile
+	case !mode.KeyFile && len(ssed.keyFileHash) > 0:
+		return "", ErrUnusedKeyFile
+	case !mode.KeyFile:
+		return password, nil
+	case mode.Password && len(password) == 0:
+		return "", ErrNeedPassword
+	case !mode.Password && len(password) > 0:
+		return "", ErrKeyFileOnly
+	case mode.Password:
+		return utils.HashAndHex(password + ssed.keyFileHash), nil
+	}
+	return ssed.keyFileHash, nil
+}
+
+// CreateUser creates the user on the server, with the authentication key
+// of the secret that opens the repository
+func (ssed *Fs) CreateUser() (string, error) {
+	return utils.CreateBolUserWithKey(ssed.username, ssed.authKey, ssed.method)
+}
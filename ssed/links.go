@@ -0,0 +1,66 @@
+package ssed
+
+import (
+	"regexp"
+	"strings"
+)
+
+// wikiLinkRegex matches links to other entries, written as [[Document/Entry]]
+var wikiLinkRegex = regexp.MustCompile(`\[\[([^\[\]\n]+)\]\]`)
+
+// resolveLink returns the document and entry that a link points to. Links
+// without a document, like [[Entry]], point to an entry in documentName.
+func resolveLink(documentName, link string) (string, string) {
+	linkDocument, linkEntry := SplitEntryPath(strings.TrimSpace(link))
+	if len(linkDocument) == 0 {
+		linkDocument = documentName
+	}
+	return linkDocument, strings.TrimSpace(linkEntry)
+}
+
+// ReplaceLinks returns the text of an entry in documentName with every
+// [[Document/Entry]] link replaced by the result of calling replace with the
+// linked document, entry and the original text of the link
+func ReplaceLinks(text, documentName string, replace func(document, entry, link string) string) string {
+	return wikiLinkRegex.ReplaceAllStringFunc(text, func(match string) string {
+		link := wikiLinkRegex.FindStringSubmatch(match)[1]
+		linkDocument, linkEntry := resolveLink(documentName, link)
+		return replace(linkDocument, linkEntry, link)
+	})
+}
+
+// indexLinks builds the graph of links between the current version of every
+// entry, skipping ignored documents and entries
+func (ssed *Fs) indexLinks() {
+	ssed.backlinks = make(map[string][]string)
+	for document := range ssed.ordering {
+		if ssed.documentIgnored(document) {
+			continue
+		}
+		for _, uuid := range ssed.ordering[document] {
+			e := ssed.entries[uuid]
+			if e.Text == "ignore entry" {
+				continue
+			}
+			linked := make(map[string]bool)
+			for _, match := range wikiLinkRegex.FindAllStringSubmatch(e.Text, -1) {
+				linkDocument, linkEntry := resolveLink(e.Document, match[1])
+				target := linkDocument + "/" + linkEntry
+				if linked[target] || (linkDocument == e.Document && linkEntry == e.Entry) {
+					continue
+				}
+				linked[target] = true
+				ssed.backlinks[target] = append(ssed.backlinks[target], uuid)
+			}
+		}
+	}
+}
+
+// Backlinks returns the entries that link to the specified entry, sorted by
+// their creation time
+func (ssed *Fs) Backlinks(documentName, entryName string) []Entry {
+	if !ssed.parsed {
+		ssed.parseArchive()
+	}
+	return ssed.chronologicalEntries(ssed.backlinks[documentName+"/"+entryName])
+}
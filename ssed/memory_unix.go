@@ -0,0 +1,11 @@
+//go:build !windows
+// +build !windows
+
+package ssed
+
+import "syscall"
+
+// lockMemory keeps b in memory, so it is never written to swap
+func lockMemory(b []byte) error {
+	return syscall.Mlock(b)
+}
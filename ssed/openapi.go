@@ -0,0 +1,159 @@
+package ssed
+
+// openAPI describes the API, served at /api/v1/openapi.json
+const openAPI = `{
+  "openapi": "3.0.0",
+  "info": {
+    "title": "bol",
+    "version": "1",
+    "description": "Documents and entries of a bol repository, served by bol api where the repository is decrypted."
+  },
+  "servers": [{"url": "/api/v1"}],
+  "security": [{"token": []}],
+  "paths": {
+    "/documents": {
+      "get": {
+        "summary": "List the documents",
+        "responses": {
+          "200": {"description": "The documents", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Document"}}}}},
+          "401": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/documents/{document}": {
+      "parameters": [{"$ref": "#/components/parameters/document"}],
+      "get": {
+        "summary": "Get a document",
+        "responses": {
+          "200": {"description": "The document", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Document"}}}},
+          "404": {"$ref": "#/components/responses/Error"}
+        }
+      },
+      "delete": {
+        "summary": "Delete a document",
+        "responses": {
+          "204": {"description": "Deleted"},
+          "404": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/documents/{document}/entries": {
+      "parameters": [{"$ref": "#/components/parameters/document"}],
+      "get": {
+        "summary": "List the entries of a document, oldest first",
+        "responses": {
+          "200": {"description": "The entries", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Entry"}}}}},
+          "404": {"$ref": "#/components/responses/Error"}
+        }
+      },
+      "post": {
+        "summary": "Add an entry, named with a new ULID unless the entry is given",
+        "requestBody": {"$ref": "#/components/requestBodies/Entry"},
+        "responses": {
+          "201": {"description": "The entry", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Entry"}}}},
+          "400": {"$ref": "#/components/responses/Error"},
+          "403": {"$ref": "#/components/responses/Error"},
+          "409": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/documents/{document}/entries/{entry}": {
+      "parameters": [{"$ref": "#/components/parameters/document"}, {"$ref": "#/components/parameters/entry"}],
+      "get": {
+        "summary": "Get an entry",
+        "responses": {
+          "200": {"description": "The entry", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Entry"}}}},
+          "404": {"$ref": "#/components/responses/Error"}
+        }
+      },
+      "put": {
+        "summary": "Write a new version of an entry, or add it, keeping its tags unless they are given",
+        "requestBody": {"$ref": "#/components/requestBodies/Entry"},
+        "responses": {
+          "200": {"description": "The entry", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Entry"}}}},
+          "201": {"description": "The new entry", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Entry"}}}},
+          "400": {"$ref": "#/components/responses/Error"},
+          "403": {"$ref": "#/components/responses/Error"}
+        }
+      },
+      "delete": {
+        "summary": "Delete an entry",
+        "responses": {
+          "204": {"description": "Deleted"},
+          "404": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/documents/{document}/entries/{entry}/history": {
+      "parameters": [{"$ref": "#/components/parameters/document"}, {"$ref": "#/components/parameters/entry"}],
+      "get": {
+        "summary": "List every version of an entry, oldest first",
+        "responses": {
+          "200": {"description": "The versions", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Entry"}}}}},
+          "404": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    }
+  },
+  "components": {
+    "securitySchemes": {
+      "token": {"type": "http", "scheme": "bearer", "description": "The token given to bol api"}
+    },
+    "parameters": {
+      "document": {"name": "document", "in": "path", "required": true, "description": "A '/' in the name is escaped as %2F", "schema": {"type": "string"}},
+      "entry": {"name": "entry", "in": "path", "required": true, "schema": {"type": "string"}}
+    },
+    "requestBodies": {
+      "Entry": {
+        "required": true,
+        "content": {"application/json": {"schema": {
+          "type": "object",
+          "required": ["text"],
+          "properties": {
+            "entry": {"type": "string", "description": "Only for POST"},
+            "text": {"type": "string"},
+            "timestamp": {"type": "string", "description": "Creation time, kept by PUT when it is not given"},
+            "tags": {"type": "array", "items": {"type": "string"}}
+          }
+        }}}
+      }
+    },
+    "responses": {
+      "Error": {
+        "description": "An error",
+        "content": {"application/json": {"schema": {"type": "object", "properties": {"error": {"type": "string"}}}}}
+      }
+    },
+    "schemas": {
+      "Document": {
+        "type": "object",
+        "properties": {
+          "document": {"type": "string"},
+          "entries": {"type": "integer"}
+        }
+      },
+      "Entry": {
+        "type": "object",
+        "properties": {
+          "text": {"type": "string"},
+          "timestamp": {"type": "string", "example": "2017-03-20 12:00:00"},
+          "modified_timestamp": {"type": "string"},
+          "document": {"type": "string"},
+          "entry": {"type": "string"},
+          "tags": {"type": "array", "items": {"type": "string"}},
+          "attachments": {"type": "array", "items": {"$ref": "#/components/schemas/Attachment"}}
+        }
+      },
+      "Attachment": {
+        "type": "object",
+        "properties": {
+          "id": {"type": "string"},
+          "name": {"type": "string"},
+          "content_type": {"type": "string"},
+          "size": {"type": "integer"}
+        }
+      }
+    }
+  }
+}
+`
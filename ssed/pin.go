@@ -0,0 +1,294 @@
+package ssed
+
+import (
+	crand "crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/schollz/bol/utils"
+	"github.com/schollz/pwdhash"
+)
+
+const (
+	deviceExtension  = ".device"
+	revokedExtension = ".revoked"
+)
+
+// DefaultPinAttempts is the number of wrong PINs after which the PIN of a
+// device is removed
+const DefaultPinAttempts = 5
+
+// ErrDeviceRevoked is returned by GetPasswordFromPin when the device was
+// revoked from another device
+var ErrDeviceRevoked = errors.New("PIN was revoked for this device, use the password")
+
+// pinHashDuration is how long it should take to derive the key from a PIN
+var pinHashDuration = 500 * time.Millisecond
+
+// maxPinBackoff is the longest wait after wrong PINs
+const maxPinBackoff = 24 * time.Hour
+
+// pinFile lets a device open the repository with a PIN instead of the
+// password. It is kept in the config folder and never synchronized.
+type pinFile struct {
+	Device      string    `json:"device"`
+	Salt        string    `json:"salt"`        // random for each device
+	WorkFactor  int       `json:"work_factor"` // iterations of the PIN hash
+	Password    string    `json:"password"`    // encrypted with the hashed PIN
+	MaxAttempts int       `json:"max_attempts"`
+	Failures    int       `json:"failures"`
+	NextAttempt time.Time `json:"next_attempt"`
+}
+
+// Device is a device that can open the repository with a PIN. Devices are
+// stored encrypted in the repository as ID.device, and revoked devices
+// have an ID.revoked file, which can be read before the repository is
+// opened.
+type Device struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Created string `json:"created"`
+	Revoked bool   `json:"revoked"`
+	Current bool   `json:"current"`
+}
+
+func (ssed *Fs) pinFileName() string {
+	return path.Join(pathToConfigFolder, ssed.username+".pin")
+}
+
+func (ssed *Fs) readPinFile() (pinFile, error) {
+	var pf pinFile
+	b, err := ioutil.ReadFile(ssed.pinFileName())
+	if err != nil {
+		return pf, errors.New("Key not set")
+	}
+	err = json.Unmarshal(b, &pf)
+	return pf, err
+}
+
+func (ssed *Fs) writePinFile(pf pinFile) error {
+	b, err := json.MarshalIndent(pf, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(ssed.pinFileName(), b, 0600)
+}
+
+// HasPinFile returns whether this device can be opened with a PIN
+func (ssed *Fs) HasPinFile() bool {
+	return utils.Exists(ssed.pinFileName())
+}
+
+// GetPasswordFromPin allows to use a pin. After a wrong PIN, the next
+// attempt has to wait for an increasing time, and after the maximum
+// number of attempts the PIN is removed from the device.
+func (ssed *Fs) GetPasswordFromPin(pin string) (string, error) {
+	pf, err := ssed.readPinFile()
+	if err != nil {
+		return "", err
+	}
+
+	// the revoked devices are known once the repository is downloaded
+	ssed.wg.Wait()
+	if utils.Exists(path.Join(ssed.pathToLocalRepo, pf.Device+revokedExtension)) {
+		os.Remove(ssed.pinFileName())
+		return "", ErrDeviceRevoked
+	}
+	if wait := pf.NextAttempt.Sub(time.Now()); wait > 0 {
+		return "", fmt.Errorf("Too many wrong PINs, try again in %d seconds", int(wait.Seconds())+1)
+	}
+
+	salt, err := hex.DecodeString(pf.Salt)
+	if err != nil {
+		return "", err
+	}
+	hashPin, err := HashPasswordSlow(pin, salt, pf.WorkFactor)
+	if err != nil {
+		return "", err
+	}
+	bPassword, err := utils.DecryptFromHex(pf.Password, hashPin)
+	if err != nil {
+		pf.Failures++
+		if pf.Failures >= pf.MaxAttempts {
+			os.Remove(ssed.pinFileName())
+			// so the device is listed as revoked
+			ioutil.WriteFile(path.Join(ssed.pathToLocalRepo, pf.Device+revokedExtension), []byte(utils.GetCurrentDate()), 0644)
+			return "", fmt.Errorf("Wrong PIN, the PIN was removed after %d attempts, use the password", pf.Failures)
+		}
+		pf.NextAttempt = time.Now().Add(pinBackoff(pf.Failures))
+		ssed.writePinFile(pf)
+		return "", fmt.Errorf("Wrong PIN, %d attempts left", pf.MaxAttempts-pf.Failures)
+	}
+	if pf.Failures > 0 {
+		pf.Failures = 0
+		pf.NextAttempt = time.Time{}
+		ssed.writePinFile(pf)
+	}
+	return string(bPassword), nil
+}
+
+// pinBackoff doubles the wait after each wrong PIN, starting at 5 seconds,
+// up to maxPinBackoff
+func pinBackoff(failures int) time.Duration {
+	wait := 5 * time.Second
+	for i := 1; i < failures && wait < maxPinBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxPinBackoff {
+		return maxPinBackoff
+	}
+	return wait
+}
+
+// SetPinFromPassword allows to use a pin on this device, which is removed
+// after maxAttempts wrong PINs. The device is added to the repository, so
+// it can be listed and revoked from other devices.
+func (ssed *Fs) SetPinFromPassword(pin string, maxAttempts int) error {
+	if len(ssed.typedPassword) == 0 {
+		return errors.New("The PIN needs the password, which the agent does not keep")
+	}
+	if maxAttempts < 1 {
+		maxAttempts = DefaultPinAttempts
+	}
+	device, err := utils.NewULID()
+	if err != nil {
+		return err
+	}
+	salt := make([]byte, 32)
+	if _, err = crand.Read(salt); err != nil {
+		return err
+	}
+	workFactor, err := calibratePinHash()
+	if err != nil {
+		return err
+	}
+	hashPin, err := HashPasswordSlow(pin, salt, workFactor)
+	if err != nil {
+		return err
+	}
+	// the PIN gives the password, so a key file is still needed
+	encrypted, err := utils.EncryptToHex([]byte(ssed.typedPassword), hashPin)
+	if err != nil {
+		return err
+	}
+
+	name, _ := os.Hostname()
+	b, err := json.Marshal(Device{ID: device, Name: name, Created: utils.GetCurrentDate()})
+	if err != nil {
+		return err
+	}
+	err = utils.EncryptToFile(b, ssed.password, path.Join(ssed.pathToLocalRepo, device+deviceExtension))
+	if err != nil {
+		return err
+	}
+
+	// replaces the PIN of an earlier version, which was derived from the
+	// hostname, OS and CPU model
+	os.Remove(path.Join(pathToConfigFolder, ssed.username+".key"))
+	return ssed.writePinFile(pinFile{
+		Device:      device,
+		Salt:        hex.EncodeToString(salt),
+		WorkFactor:  workFactor,
+		Password:    encrypted,
+		MaxAttempts: maxAttempts,
+	})
+}
+
+// HashPasswordSlow generates a PBKDF2 hash of the password with the salt,
+// using workFactor iterations
+func HashPasswordSlow(password string, salt []byte, workFactor int) (string, error) {
+	p, err := pwdhash.GenerateFromPassword([]byte(password), salt, workFactor, 64, "sha512")
+	if err != nil {
+		return "", err
+	}
+	return string(p), nil
+}
+
+// calibratePinHash returns the work factor that makes HashPasswordSlow
+// take pinHashDuration on this device
+func calibratePinHash() (int, error) {
+	workFactor := 65536
+	for {
+		t := time.Now()
+		if _, err := HashPasswordSlow("calibrate", []byte("calibrate"), workFactor); err != nil {
+			return 0, err
+		}
+		if time.Since(t) > pinHashDuration {
+			return workFactor, nil
+		}
+		workFactor = workFactor * 2
+	}
+}
+
+// ListDevices returns the devices that can open the repository with a
+// PIN, including revoked devices, in the order they were added
+func (ssed *Fs) ListDevices() ([]Device, error) {
+	current, _ := ssed.readPinFile()
+	files, _ := filepath.Glob(path.Join(ssed.pathToLocalRepo, "*"+deviceExtension))
+	devices := []Device{}
+	for _, file := range files {
+		b, err := utils.DecryptFromFile(ssed.password, file)
+		if err != nil {
+			return devices, err
+		}
+		var device Device
+		if err = json.Unmarshal(b, &device); err != nil {
+			return devices, err
+		}
+		device.Revoked = utils.Exists(path.Join(ssed.pathToLocalRepo, device.ID+revokedExtension))
+		device.Current = device.ID == current.Device
+		devices = append(devices, device)
+	}
+	sort.Sort(deviceSlice(devices))
+	return devices, nil
+}
+
+// deviceSlice sorts devices by their ID, which is a ULID that sorts by the
+// time it was made
+type deviceSlice []Device
+
+func (p deviceSlice) Len() int {
+	return len(p)
+}
+
+func (p deviceSlice) Less(i, j int) bool {
+	return p[i].ID < p[j].ID
+}
+
+func (p deviceSlice) Swap(i, j int) {
+	p[i], p[j] = p[j], p[i]
+}
+
+// RemovePin removes the PIN of this device, for when the password it keeps
+// no longer opens the repository. The device is listed as revoked.
+func (ssed *Fs) RemovePin() error {
+	pf, err := ssed.readPinFile()
+	if err != nil {
+		return err
+	}
+	if err = os.Remove(ssed.pinFileName()); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path.Join(ssed.pathToLocalRepo, pf.Device+revokedExtension), []byte(utils.GetCurrentDate()), 0644)
+}
+
+// RevokeDevice stops a device from opening the repository with its PIN,
+// once the device downloads the repository. This is only advisory, as the
+// device keeps the password, which ChangePassword replaces.
+func (ssed *Fs) RevokeDevice(id string) error {
+	if !utils.Exists(path.Join(ssed.pathToLocalRepo, id+deviceExtension)) {
+		return errors.New("No device " + id)
+	}
+	if current, err := ssed.readPinFile(); err == nil && current.Device == id {
+		os.Remove(ssed.pinFileName())
+	}
+	return ioutil.WriteFile(path.Join(ssed.pathToLocalRepo, id+revokedExtension), []byte(utils.GetCurrentDate()), 0644)
+}
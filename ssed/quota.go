@@ -0,0 +1,69 @@
+package ssed
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+)
+
+// quotaWarning is the part of the quota above which Close warns
+const quotaWarning = 0.9
+
+// ErrQuotaExceeded is returned by Close when the archive is larger than the
+// quota of the user, before it is pushed or when the server refuses it
+var ErrQuotaExceeded = errors.New("The repository is over your quota on the server, local changes saved.")
+
+// readQuota keeps the quota the server tells about
+func (ssed *Fs) readQuota(header http.Header) {
+	if quota, err := strconv.ParseInt(header.Get("Bol-Quota"), 10, 64); err == nil {
+		ssed.quota = quota
+	}
+	if used, err := strconv.ParseInt(header.Get("Bol-Quota-Used"), 10, 64); err == nil {
+		ssed.quotaUsed = used
+	}
+	if shares, err := strconv.ParseInt(header.Get("Bol-Quota-Shares"), 10, 64); err == nil {
+		ssed.quotaShares = shares
+	}
+}
+
+// Quota returns the size of the archive and of the shared documents of the
+// user on the server, and the quota of the user, which is 0 when the server
+// did not say
+func (ssed *Fs) Quota() (used, quota int64) {
+	return ssed.quotaUsed, ssed.quota
+}
+
+// checkQuota returns ErrQuotaExceeded when the archive is too large for the
+// server, and warns when it almost is, before it is pushed. The shared
+// documents the user owns take up part of the quota.
+func (ssed *Fs) checkQuota() error {
+	if ssed.quota <= 0 {
+		return nil
+	}
+	fi, err := os.Stat(path.Join(pathToLocalFolder, ssed.archiveName))
+	if err != nil {
+		return nil
+	}
+	quota, left := ssed.quota-ssed.quotaShares, ""
+	if ssed.quotaShares > 0 {
+		left = fmt.Sprintf(" (%s after your shared documents)", formatBytes(quota))
+	}
+	if fi.Size() > quota {
+		logger.Warn("The repository is %s, over your quota of %s on the server%s", formatBytes(fi.Size()), formatBytes(ssed.quota), left)
+		return ErrQuotaExceeded
+	}
+	if float64(fi.Size()) > quotaWarning*float64(quota) {
+		logger.Warn("The repository is %s, almost your quota of %s on the server%s", formatBytes(fi.Size()), formatBytes(ssed.quota), left)
+	}
+	return nil
+}
+
+func formatBytes(b int64) string {
+	if b < 1000000 {
+		return fmt.Sprintf("%.1f kB", float64(b)/1000)
+	}
+	return fmt.Sprintf("%.1f MB", float64(b)/1000000)
+}
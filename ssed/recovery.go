@@ -0,0 +1,354 @@
+package ssed
+
+import (
+	crand "crypto/rand"
+	"encoding/base32"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/schollz/bol/utils"
+)
+
+const (
+	recoveryExtension = ".recovery"
+	recoveryFileName  = "repository" + recoveryExtension
+	rekeyExtension    = ".rekey"
+)
+
+// DefaultRecoveryCodes is the number of recovery codes that are made
+const DefaultRecoveryCodes = 5
+
+// ErrNoRecoveryCodes is returned when the repository has no recovery codes
+var ErrNoRecoveryCodes = errors.New("This repository has no recovery codes, they are made with bol -recovery")
+
+// recoveryFile lets recovery codes open the repository when the password is
+// lost. The secret is encrypted with a random recovery key, which is split
+// into shares that are each encrypted with a recovery code, so that any
+// quorum of the codes give the recovery key. It is kept in the repository,
+// so every device has it.
+type recoveryFile struct {
+	Created string   `json:"created"`
+	Quorum  int      `json:"quorum"`
+	Key     string   `json:"key"`    // the recovery key, encrypted with the key of the repository
+	Secret  string   `json:"secret"` // the secret, encrypted with the recovery key
+	Shares  []string `json:"shares"` // encrypted with the recovery codes
+}
+
+func (ssed *Fs) readRecoveryFile() (recoveryFile, error) {
+	var rf recoveryFile
+	b, err := ioutil.ReadFile(path.Join(ssed.pathToLocalRepo, recoveryFileName))
+	if err != nil {
+		return rf, ErrNoRecoveryCodes
+	}
+	err = json.Unmarshal(b, &rf)
+	return rf, err
+}
+
+func (ssed *Fs) writeRecoveryFile(rf recoveryFile) error {
+	b, err := json.MarshalIndent(rf, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path.Join(ssed.pathToLocalRepo, recoveryFileName), b, 0644)
+}
+
+// NewRecoveryCodes makes count recovery codes, any quorum of which open the
+// repository with OpenWithRecoveryCodes. Earlier recovery codes stop
+// working.
+func (ssed *Fs) NewRecoveryCodes(quorum, count int) ([]string, error) {
+	if len(ssed.keySecret) == 0 {
+		return nil, errors.New("Recovery codes need the password, which the agent does not keep")
+	}
+	key := make([]byte, 32)
+	if _, err := crand.Read(key); err != nil {
+		return nil, err
+	}
+	shares, err := splitSecret(key, quorum, count)
+	if err != nil {
+		return nil, err
+	}
+
+	hexKey := hex.EncodeToString(key)
+	rf := recoveryFile{Created: utils.GetCurrentDate(), Quorum: quorum}
+	if rf.Key, err = utils.EncryptToHex([]byte(hexKey), ssed.password); err != nil {
+		return nil, err
+	}
+	if rf.Secret, err = utils.EncryptToHex([]byte(ssed.keySecret), hexKey); err != nil {
+		return nil, err
+	}
+	codes := make([]string, count)
+	for i, share := range shares {
+		b := make([]byte, 15)
+		if _, err = crand.Read(b); err != nil {
+			return nil, err
+		}
+		codes[i] = formatRecoveryCode(base32.StdEncoding.EncodeToString(b))
+		encrypted, err := utils.EncryptToHex(share, normalizeRecoveryCode(codes[i]))
+		if err != nil {
+			return nil, err
+		}
+		rf.Shares = append(rf.Shares, encrypted)
+	}
+	return codes, ssed.writeRecoveryFile(rf)
+}
+
+// formatRecoveryCode splits a code into groups of four, so it is easier to
+// copy
+func formatRecoveryCode(code string) string {
+	groups := []string{}
+	for len(code) > 4 {
+		groups = append(groups, code[:4])
+		code = code[4:]
+	}
+	return strings.Join(append(groups, code), "-")
+}
+
+// normalizeRecoveryCode allows codes in lower case, without dashes, and
+// with the digits that look like letters of base32
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToUpper(code)
+	code = strings.NewReplacer("-", "", " ", "", "\t", "", "\n", "", "\r", "", "0", "O", "1", "I").Replace(code)
+	return code
+}
+
+// RecoveryQuorum returns how many recovery codes are needed to open the
+// repository
+func (ssed *Fs) RecoveryQuorum() (int, error) {
+	ssed.wg.Wait()
+	rf, err := ssed.readRecoveryFile()
+	return rf.Quorum, err
+}
+
+// OpenWithRecoveryCodes opens the repository with recovery codes instead
+// of the password. ChangePassword should be used next, as the password is
+// not known.
+func (ssed *Fs) OpenWithRecoveryCodes(codes []string) error {
+	ssed.wg.Wait()
+	rf, err := ssed.readRecoveryFile()
+	if err != nil {
+		return err
+	}
+	var shares [][]byte
+	for _, code := range codes {
+		share, err := rf.share(code)
+		if err != nil {
+			return err
+		}
+		// the same code given twice counts once
+		duplicate := false
+		for _, other := range shares {
+			duplicate = duplicate || other[0] == share[0]
+		}
+		if !duplicate {
+			shares = append(shares, share)
+		}
+	}
+	if len(shares) < rf.Quorum {
+		return fmt.Errorf("%d different recovery codes are needed, got %d", rf.Quorum, len(shares))
+	}
+	hexKey, err := combineShares(shares)
+	if err != nil {
+		return err
+	}
+	secret, err := utils.DecryptFromHex(rf.Secret, hex.EncodeToString(hexKey))
+	if err != nil {
+		return errors.New("The recovery codes do not open the repository")
+	}
+
+	key := string(secret)
+	if sf, err := ssed.readSaltFile(); err == nil {
+		if key, err = sf.key(string(secret)); err != nil {
+			return err
+		}
+	}
+	files, _ := filepath.Glob(path.Join(ssed.pathToLocalRepo, "*.json"))
+	if len(files) > 0 {
+		if _, err = utils.DecryptFromFile(key, files[0]); err != nil {
+			return errors.New("The recovery codes are from before the password was changed")
+		}
+	}
+	ssed.password = key
+	ssed.keySecret = string(secret)
+	ssed.authKey = utils.AuthKey(ssed.username, string(secret))
+	ssed.typedPassword = ""
+	return nil
+}
+
+// share returns the share that is encrypted with the code
+func (rf recoveryFile) share(code string) ([]byte, error) {
+	normalized := normalizeRecoveryCode(code)
+	for _, encrypted := range rf.Shares {
+		if share, err := utils.DecryptFromHex(encrypted, normalized); err == nil {
+			return share, nil
+		}
+	}
+	return nil, fmt.Errorf("'%s' is not a recovery code of this repository", strings.TrimSpace(code))
+}
+
+// ChangePassword encrypts the repository with a new password, which is
+// combined with the key file after UseKeyFile. It also changes the password
+// on the server, and revokes the PINs of all devices, as they give the old
+// password. Recovery codes keep working.
+func (ssed *Fs) ChangePassword(newPassword string) error {
+	mode := unlockMode{Password: true}
+	if len(ssed.keyFileHash) > 0 {
+		mode = unlockMode{Password: len(newPassword) > 0, KeyFile: true}
+	} else if len(newPassword) == 0 {
+		return errors.New("The new password can not be empty")
+	}
+	newSecret, err := ssed.secretForMode(mode, newPassword)
+	if err != nil {
+		return err
+	}
+	sf, err := newSaltFile()
+	if err != nil {
+		return err
+	}
+	if err = ssed.rekey(newSecret, sf); err != nil {
+		return err
+	}
+
+	unlockFile := path.Join(ssed.pathToLocalRepo, unlockFileName)
+	if mode.KeyFile {
+		b, _ := json.Marshal(mode)
+		err = ioutil.WriteFile(unlockFile, b, 0644)
+	} else {
+		os.Remove(unlockFile)
+	}
+	if err != nil {
+		return err
+	}
+	devices, _ := filepath.Glob(path.Join(ssed.pathToLocalRepo, "*"+deviceExtension))
+	for _, device := range devices {
+		revoked := strings.TrimSuffix(device, deviceExtension) + revokedExtension
+		if !utils.Exists(revoked) {
+			ioutil.WriteFile(revoked, []byte(utils.GetCurrentDate()), 0644)
+		}
+	}
+	os.Remove(ssed.pinFileName())
+
+	ssed.typedPassword = newPassword
+	if useAgent && len(newPassword) > 0 {
+		ssed.setAgentKeys()
+	}
+	return nil
+}
+
+// rekey encrypts the repository with the key of the secret and the salt
+// file, and changes the secret on the server. The other devices are told
+// to use the files of this one.
+func (ssed *Fs) rekey(newSecret string, sf saltFile) error {
+	newKey, err := sf.key(newSecret)
+	if err != nil {
+		return err
+	}
+	server := strings.Contains(ssed.method, "http")
+	if server && !ssed.successfulPull {
+		return errors.New("Can not change the password without a connection to the server")
+	}
+
+	// encrypt everything into new files first, so nothing changes if one
+	// of them fails
+	var files []string
+	for _, extension := range []string{".json", attachmentExtension, deviceExtension, keyPairExtension, knownKeysExtension, shareExtension, vaultExtension} {
+		matches, _ := filepath.Glob(path.Join(ssed.pathToLocalRepo, "*"+extension))
+		files = append(files, matches...)
+	}
+	removeNewFiles := func() {
+		for _, file := range files {
+			os.Remove(file + ".new")
+		}
+	}
+	for _, file := range files {
+		b, err := utils.DecryptFromFile(ssed.password, file)
+		if err == nil {
+			err = utils.EncryptToFile(b, newKey, file+".new")
+		}
+		if err != nil {
+			removeNewFiles()
+			return err
+		}
+	}
+	rf, errRecovery := ssed.readRecoveryFile()
+	if errRecovery == nil {
+		hexKey, err := utils.DecryptFromHex(rf.Key, ssed.password)
+		if err == nil {
+			rf.Key, err = utils.EncryptToHex(hexKey, newKey)
+		}
+		if err == nil {
+			rf.Secret, err = utils.EncryptToHex([]byte(newSecret), string(hexKey))
+		}
+		if err != nil {
+			removeNewFiles()
+			return err
+		}
+	}
+	newAuthKey := utils.AuthKey(ssed.username, newSecret)
+	if server && newAuthKey != ssed.authKey {
+		if err := utils.ChangeBolAuthKey(ssed.username, ssed.authKey, newAuthKey, ssed.method); err != nil {
+			removeNewFiles()
+			return fmt.Errorf("Could not change the password on the server: %s", err.Error())
+		}
+	}
+
+	for _, file := range files {
+		if err := os.Rename(file+".new", file); err != nil {
+			return err
+		}
+	}
+	if errRecovery == nil {
+		if err := ssed.writeRecoveryFile(rf); err != nil {
+			return err
+		}
+	}
+	if err := ssed.writeSaltFile(sf); err != nil {
+		return err
+	}
+
+	// tells the other devices to use the files of this one
+	id, err := utils.NewULID()
+	if err != nil {
+		return err
+	}
+	if err = ioutil.WriteFile(path.Join(ssed.pathToLocalRepo, id+rekeyExtension), []byte(utils.GetCurrentDate()), 0644); err != nil {
+		return err
+	}
+	ssed.password = newKey
+	ssed.keySecret = newSecret
+	ssed.authKey = newAuthKey
+	return nil
+}
+
+// useRekeyedRemote is called when the remote repository was encrypted with
+// a new password on another device. Its files replace the local ones, and
+// local files that are not in it are moved to another folder, as they are
+// encrypted with the old password.
+func (ssed *Fs) useRekeyedRemote() {
+	remoteFiles := make(map[string]bool)
+	files, _ := filepath.Glob(path.Join(ssed.pathToRemoteRepo, "*"))
+	for _, file := range files {
+		remoteFiles[filepath.Base(file)] = true
+		utils.CopyFile(file, path.Join(ssed.pathToLocalRepo, filepath.Base(file)))
+	}
+
+	oldFolder := path.Join(pathToLocalFolder, ssed.username+"-old-password-"+utils.GetUnixTimestamp())
+	files, _ = filepath.Glob(path.Join(ssed.pathToLocalRepo, "*"))
+	for _, file := range files {
+		if remoteFiles[filepath.Base(file)] {
+			continue
+		}
+		switch filepath.Ext(file) {
+		case ".json", attachmentExtension, deviceExtension, unlockExtension, keyPairExtension, knownKeysExtension, shareExtension, vaultExtension:
+			os.MkdirAll(oldFolder, 0755)
+			os.Rename(file, path.Join(oldFolder, filepath.Base(file)))
+			logger.Warn("Moved %s to %s, it is encrypted with the old password", filepath.Base(file), oldFolder)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package ssed
+
+import (
+	crand "crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"path"
+
+	"github.com/schollz/bol/utils"
+)
+
+const (
+	saltExtension = ".salt"
+	saltFileName  = "repository" + saltExtension
+	saltKDF       = "pbkdf2-sha256"
+)
+
+// saltFile has what derives the key of the repository from its secret
+// (see utils.EncryptionKey), while the server gets the authentication key
+// of the secret. It is not encrypted, and kept in the
+// repository so every device and the website derive the same key.
+// Repositories from before it are encrypted with the secret itself, until
+// they are opened and get one.
+type saltFile struct {
+	KDF        string `json:"kdf"`
+	Salt       string `json:"salt"`
+	Iterations int    `json:"iterations"`
+}
+
+func newSaltFile() (saltFile, error) {
+	salt := make([]byte, 32)
+	if _, err := crand.Read(salt); err != nil {
+		return saltFile{}, err
+	}
+	return saltFile{KDF: saltKDF, Salt: hex.EncodeToString(salt), Iterations: utils.EncryptionKeyIterations}, nil
+}
+
+func (ssed *Fs) readSaltFile() (saltFile, error) {
+	var sf saltFile
+	b, err := ioutil.ReadFile(path.Join(ssed.pathToLocalRepo, saltFileName))
+	if err != nil {
+		return sf, err
+	}
+	err = json.Unmarshal(b, &sf)
+	return sf, err
+}
+
+func (ssed *Fs) writeSaltFile(sf saltFile) error {
+	b, err := json.MarshalIndent(sf, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path.Join(ssed.pathToLocalRepo, saltFileName), b, 0644)
+}
+
+// key derives the key of the repository from the secret
+func (sf saltFile) key(secret string) (string, error) {
+	salt, err := hex.DecodeString(sf.Salt)
+	if err != nil || sf.KDF != saltKDF || sf.Iterations < 1 {
+		return "", errors.New("The salt of the repository can not be read, it may be from a newer version of bol")
+	}
+	return utils.EncryptionKey(secret, salt, sf.Iterations), nil
+}
+
+// addSalt encrypts a repository from before salts with a key derived from
+// its secret, like ChangePassword does with a new password, so other
+// devices get the new files from the server. The secret, and so the
+// authentication with the server, stays the same.
+func (ssed *Fs) addSalt() error {
+	sf, err := newSaltFile()
+	if err != nil {
+		return err
+	}
+	return ssed.rekey(ssed.keySecret, sf)
+}
@@ -0,0 +1,97 @@
+package ssed
+
+import (
+	crand "crypto/rand"
+	"errors"
+)
+
+// Shamir's secret sharing over GF(2^8), one byte of the secret at a time. A
+// share is its x coordinate followed by the y coordinate of every byte.
+
+// gfMul multiplies in GF(2^8), with the polynomial of AES
+func gfMul(a, b byte) byte {
+	var p byte
+	for b > 0 {
+		if b&1 == 1 {
+			p ^= a
+		}
+		carry := a & 0x80
+		a <<= 1
+		if carry != 0 {
+			a ^= 0x1b
+		}
+		b >>= 1
+	}
+	return p
+}
+
+// gfInverse returns a^254, which is the inverse of a in GF(2^8)
+func gfInverse(a byte) byte {
+	inverse := byte(1)
+	for i := 0; i < 254; i++ {
+		inverse = gfMul(inverse, a)
+	}
+	return inverse
+}
+
+// splitSecret splits the secret into count shares, so that any quorum of
+// them give the secret and fewer give nothing about it
+func splitSecret(secret []byte, quorum, count int) ([][]byte, error) {
+	if quorum < 1 || count < quorum || count > 255 {
+		return nil, errors.New("The quorum must be between 1 and the number of shares, which is at most 255")
+	}
+	shares := make([][]byte, count)
+	for i := range shares {
+		shares[i] = make([]byte, len(secret)+1)
+		shares[i][0] = byte(i + 1)
+	}
+	coefficients := make([]byte, quorum)
+	for i, b := range secret {
+		// a random polynomial of degree quorum-1 that is b at x = 0
+		coefficients[0] = b
+		if _, err := crand.Read(coefficients[1:]); err != nil {
+			return nil, err
+		}
+		for _, share := range shares {
+			var y byte
+			for j := quorum - 1; j >= 0; j-- {
+				y = gfMul(y, share[0]) ^ coefficients[j]
+			}
+			share[i+1] = y
+		}
+	}
+	return shares, nil
+}
+
+// combineShares returns the secret from at least the quorum of shares it
+// was split into. Fewer shares give a wrong secret.
+func combineShares(shares [][]byte) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, errors.New("No shares to combine")
+	}
+	length := len(shares[0])
+	for i, share := range shares {
+		if len(share) < 2 || len(share) != length {
+			return nil, errors.New("Shares are of different secrets")
+		}
+		for _, other := range shares[:i] {
+			if other[0] == share[0] {
+				return nil, errors.New("Share is used twice")
+			}
+		}
+	}
+	secret := make([]byte, length-1)
+	for i, share := range shares {
+		// the Lagrange basis polynomial of the share at x = 0
+		basis := byte(1)
+		for j, other := range shares {
+			if i != j {
+				basis = gfMul(basis, gfMul(other[0], gfInverse(other[0]^share[0])))
+			}
+		}
+		for k := range secret {
+			secret[k] ^= gfMul(basis, share[k+1])
+		}
+	}
+	return secret, nil
+}
@@ -0,0 +1,664 @@
+package ssed
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/schollz/archiver"
+	"github.com/schollz/bol/utils"
+	"golang.org/x/crypto/nacl/box"
+)
+
+const (
+	keyPairExtension   = ".keypair"
+	keyPairFileName    = "identity" + keyPairExtension
+	knownKeysExtension = ".known"
+	knownKeysFileName  = "users" + knownKeysExtension
+	shareExtension     = ".share"
+)
+
+// ErrReadOnly is returned when changing a document that was shared
+// read-only
+var ErrReadOnly = errors.New("This document was shared read-only")
+
+// ErrShareChanged is returned when uploading a shared document that another
+// user wrote to since it was downloaded
+var ErrShareChanged = errors.New("The shared document was changed by another user")
+
+// keyPair lets other users share documents with this user. The public key
+// is published on the server, and the key pair is kept encrypted in the
+// repository.
+type keyPair struct {
+	Public    string `json:"public"`
+	Private   string `json:"private"`
+	Published bool   `json:"published"`
+}
+
+// share is a document that is shared between users. Its entries and
+// attachments are encrypted with the key of the share instead of the
+// secret of a user, and they are kept on the server apart from the
+// repositories of the users. Every user that has the document, including
+// its owner, keeps the share encrypted in its repository as ID.share.
+type share struct {
+	ID       string `json:"id"`
+	Document string `json:"document"`
+	Owner    string `json:"owner"`
+	Key      string `json:"key"`
+	Writable bool   `json:"writable"`
+	// Sealed is the sealed key the share was received with, so a new key
+	// is noticed when the owner changes it
+	Sealed  string `json:"sealed,omitempty"`
+	changed bool
+}
+
+// sealedShare is what the owner gives the server for each user, sealed to
+// its public key
+type sealedShare struct {
+	Document string `json:"document"`
+	Key      string `json:"key"`
+}
+
+// name is the document as the user sees it. Documents of other users are
+// named Document@owner, so they do not mix with documents of the same name.
+func (s *share) name(username string) string {
+	if s.Owner == username {
+		return s.Document
+	}
+	return s.Document + "@" + s.Owner
+}
+
+func (s *share) folder() string {
+	return path.Join(pathToCacheFolder, "shared", filepath.Base(s.ID))
+}
+
+// serverRequest sends a request to the server with the user and its
+// authentication key, and returns the body of a successful response
+func (ssed *Fs) serverRequest(method, route string, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequest(method, ssed.method+route, body)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(ssed.username, ssed.authKey)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New(strings.TrimSpace(string(b)))
+	}
+	return b, nil
+}
+
+// loadKeyPair returns the key pair of the user, which is made the first
+// time
+func (ssed *Fs) loadKeyPair() (keyPair, error) {
+	var kp keyPair
+	fileName := path.Join(ssed.pathToLocalRepo, keyPairFileName)
+	if utils.Exists(fileName) {
+		b, err := utils.DecryptFromFile(ssed.password, fileName)
+		if err != nil {
+			return kp, err
+		}
+		err = json.Unmarshal(b, &kp)
+		return kp, err
+	}
+	public, private, err := box.GenerateKey(crand.Reader)
+	if err != nil {
+		return kp, err
+	}
+	kp = keyPair{Public: hex.EncodeToString(public[:]), Private: hex.EncodeToString(private[:])}
+	return kp, ssed.writeKeyPair(kp)
+}
+
+func (ssed *Fs) writeKeyPair(kp keyPair) error {
+	b, err := json.Marshal(kp)
+	if err != nil {
+		return err
+	}
+	return utils.EncryptToFile(b, ssed.password, path.Join(ssed.pathToLocalRepo, keyPairFileName))
+}
+
+// fingerprint is what users compare to know that a public key is theirs
+func fingerprint(publicKey string) string {
+	b, _ := hex.DecodeString(publicKey)
+	sum := sha256.Sum256(b)
+	groups := make([]string, 8)
+	for i := range groups {
+		groups[i] = hex.EncodeToString(sum[2*i : 2*i+2])
+	}
+	return strings.Join(groups, " ")
+}
+
+// Fingerprint returns the fingerprint of the public key of the user, which
+// others are asked to check when they share a document with it
+func (ssed *Fs) Fingerprint() (string, error) {
+	kp, err := ssed.loadKeyPair()
+	if err != nil {
+		return "", err
+	}
+	return fingerprint(kp.Public), nil
+}
+
+// SetKeyPrompt sets the function that asks whether the public key of a
+// user, with its fingerprint, is theirs, the first time a document is
+// shared with them or when their key changed. Without it, the first key
+// is trusted and a changed key is refused.
+func (ssed *Fs) SetKeyPrompt(prompt func(username, fingerprint string, changed bool) bool) {
+	ssed.keyPrompt = prompt
+}
+
+// readKnownKeys returns the public keys of other users that were trusted,
+// which are kept encrypted in the repository
+func (ssed *Fs) readKnownKeys() (map[string]string, error) {
+	known := make(map[string]string)
+	fileName := path.Join(ssed.pathToLocalRepo, knownKeysFileName)
+	if !utils.Exists(fileName) {
+		return known, nil
+	}
+	b, err := utils.DecryptFromFile(ssed.password, fileName)
+	if err != nil {
+		return known, err
+	}
+	err = json.Unmarshal(b, &known)
+	return known, err
+}
+
+func (ssed *Fs) writeKnownKeys(known map[string]string) error {
+	b, err := json.Marshal(known)
+	if err != nil {
+		return err
+	}
+	return utils.EncryptToFile(b, ssed.password, path.Join(ssed.pathToLocalRepo, knownKeysFileName))
+}
+
+// publicKeyOf returns the public key of the user from the server. It is
+// pinned the first time, so the server can not later give a key of its own
+// to read the documents that are shared.
+func (ssed *Fs) publicKeyOf(username string) (string, error) {
+	b, err := ssed.serverRequest("GET", "/key?user="+url.QueryEscape(username), nil)
+	if err != nil {
+		return "", fmt.Errorf("%s can not receive shared documents until they open bol: %s", username, err.Error())
+	}
+	publicKey := strings.TrimSpace(string(b))
+	if decoded, err := hex.DecodeString(publicKey); err != nil || len(decoded) != 32 {
+		return "", errors.New("Malformed public key")
+	}
+	known, err := ssed.readKnownKeys()
+	if err != nil {
+		return "", err
+	}
+	pinned, changed := known[username]
+	if pinned == publicKey {
+		return publicKey, nil
+	}
+	if ssed.keyPrompt == nil {
+		if changed {
+			return "", fmt.Errorf("The public key of %s changed from %s to %s, it may not be theirs", username, fingerprint(pinned), fingerprint(publicKey))
+		}
+	} else if !ssed.keyPrompt(username, fingerprint(publicKey), changed) {
+		return "", fmt.Errorf("The public key of %s was not trusted", username)
+	}
+	known[username] = publicKey
+	return publicKey, ssed.writeKnownKeys(known)
+}
+
+// sealKey encrypts to a public key with a new key pair each time, so only
+// the private key opens it
+func sealKey(message []byte, publicKey string) (string, error) {
+	var recipient [32]byte
+	b, err := hex.DecodeString(strings.TrimSpace(publicKey))
+	if err != nil || len(b) != len(recipient) {
+		return "", errors.New("Malformed public key")
+	}
+	copy(recipient[:], b)
+	ephemeralPublic, ephemeralPrivate, err := box.GenerateKey(crand.Reader)
+	if err != nil {
+		return "", err
+	}
+	var nonce [24]byte
+	if _, err = crand.Read(nonce[:]); err != nil {
+		return "", err
+	}
+	sealed := append(append([]byte{}, ephemeralPublic[:]...), nonce[:]...)
+	sealed = box.Seal(sealed, message, &nonce, &recipient, ephemeralPrivate)
+	return hex.EncodeToString(sealed), nil
+}
+
+// openKey opens what sealKey encrypted to the public key of the key pair
+func openKey(sealed string, kp keyPair) ([]byte, error) {
+	var ephemeralPublic, private [32]byte
+	var nonce [24]byte
+	b, err := hex.DecodeString(sealed)
+	if err != nil || len(b) < len(ephemeralPublic)+len(nonce) {
+		return nil, errors.New("Malformed sealed key")
+	}
+	privateBytes, err := hex.DecodeString(kp.Private)
+	if err != nil || len(privateBytes) != len(private) {
+		return nil, errors.New("Malformed private key")
+	}
+	copy(private[:], privateBytes)
+	copy(ephemeralPublic[:], b)
+	copy(nonce[:], b[len(ephemeralPublic):])
+	message, ok := box.Open(nil, b[len(ephemeralPublic)+len(nonce):], &nonce, &ephemeralPublic, &private)
+	if !ok {
+		return nil, errors.New("Sealed key is not for this user")
+	}
+	return message, nil
+}
+
+// loadShares publishes the public key of the user, receives the documents
+// that were shared with it, and loads all of its shares
+func (ssed *Fs) loadShares() {
+	ssed.shares = make(map[string]*share)
+	if strings.Contains(ssed.method, "http") && ssed.successfulPull {
+		if err := ssed.receiveShares(); err != nil {
+			logger.Warn("Could not receive shared documents: %s", err.Error())
+		}
+	}
+	files, _ := filepath.Glob(path.Join(ssed.pathToLocalRepo, "*"+shareExtension))
+	for _, file := range files {
+		s, err := ssed.readShare(file)
+		if err != nil {
+			logger.Warn("Could not open %s: %s", file, err.Error())
+			continue
+		}
+		ssed.shares[s.name(ssed.username)] = s
+	}
+}
+
+func (ssed *Fs) readShare(fileName string) (*share, error) {
+	b, err := utils.DecryptFromFile(ssed.password, fileName)
+	if err != nil {
+		return nil, err
+	}
+	s := new(share)
+	err = json.Unmarshal(b, s)
+	return s, err
+}
+
+// receiveShares adds the documents that other users shared since the last
+// time, takes the new keys of shares and removes the ones that were
+// unshared. It publishes the public key if it is not yet.
+func (ssed *Fs) receiveShares() error {
+	kp, err := ssed.loadKeyPair()
+	if err != nil {
+		return err
+	}
+	if !kp.Published {
+		if _, err = ssed.serverRequest("PUT", "/key", strings.NewReader(kp.Public)); err != nil {
+			return err
+		}
+		kp.Published = true
+		if err = ssed.writeKeyPair(kp); err != nil {
+			return err
+		}
+	}
+
+	b, err := ssed.serverRequest("GET", "/shares", nil)
+	if err != nil {
+		return err
+	}
+	var received []share
+	if err = json.Unmarshal(b, &received); err != nil {
+		return err
+	}
+	receivedIDs := make(map[string]bool)
+	for _, s := range received {
+		receivedIDs[filepath.Base(s.ID)] = true
+		fileName := path.Join(ssed.pathToLocalRepo, filepath.Base(s.ID)+shareExtension)
+		existing, err := ssed.readShare(fileName)
+		if err == nil && existing.Sealed == s.Key {
+			// the owner can change whether it can be written
+			if existing.Writable != s.Writable {
+				existing.Writable = s.Writable
+				ssed.writeShare(existing)
+			}
+			continue
+		}
+		message, err := openKey(s.Key, kp)
+		if err != nil {
+			logger.Warn("Could not open the document %s shared by %s: %s", s.ID, s.Owner, err.Error())
+			continue
+		}
+		var sealed sealedShare
+		if err = json.Unmarshal(message, &sealed); err != nil {
+			return err
+		}
+		s.Document, s.Sealed, s.Key = sealed.Document, s.Key, sealed.Key
+		logger.Debug("%s shared %s with %s", s.Owner, s.Document, ssed.username)
+		if err = ssed.writeShare(&s); err != nil {
+			return err
+		}
+		ssed.downloadShare(s.ID)
+	}
+
+	// the documents that were unshared
+	files, _ := filepath.Glob(path.Join(ssed.pathToLocalRepo, "*"+shareExtension))
+	for _, file := range files {
+		s, err := ssed.readShare(file)
+		if err != nil || s.Owner == ssed.username || receivedIDs[filepath.Base(s.ID)] {
+			continue
+		}
+		logger.Debug("%s stopped sharing %s with %s", s.Owner, s.Document, ssed.username)
+		os.Remove(file)
+		os.RemoveAll(s.folder())
+		os.Remove(s.folder() + ".md5")
+	}
+	return nil
+}
+
+func (ssed *Fs) writeShare(s *share) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return utils.EncryptToFile(b, ssed.password, path.Join(ssed.pathToLocalRepo, filepath.Base(s.ID)+shareExtension))
+}
+
+// downloadShares downloads the shared documents of the user, whose IDs are
+// known before the repository is opened
+func (ssed *Fs) downloadShares() {
+	if !strings.Contains(ssed.method, "http") {
+		return
+	}
+	files, _ := filepath.Glob(path.Join(ssed.pathToLocalRepo, "*"+shareExtension))
+	for _, file := range files {
+		ssed.downloadShare(strings.TrimSuffix(filepath.Base(file), shareExtension))
+	}
+}
+
+// downloadShare adds the files of a shared document on the server to the
+// ones on this device. The md5 of the archive is kept next to them, as the
+// server only takes an upload made from the latest archive.
+func (ssed *Fs) downloadShare(id string) {
+	folder := path.Join(pathToCacheFolder, "shared", filepath.Base(id))
+	os.MkdirAll(folder, 0755)
+	resp, err := http.Get(ssed.method + "/share?id=" + url.QueryEscape(id))
+	if err != nil {
+		logger.Debug("Could not download shared document %s: %s", id, err.Error())
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNoContent {
+		os.Remove(folder + ".md5")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return
+	}
+	archiveName := folder + ".tar.bz2"
+	outFile, err := os.Create(archiveName)
+	if err != nil {
+		return
+	}
+	_, err = io.Copy(outFile, resp.Body)
+	outFile.Close()
+	if err == nil {
+		archiver.TarBz2.Open(archiveName, folder)
+		md5, _ := utils.ComputeMd5(archiveName)
+		ioutil.WriteFile(folder+".md5", []byte(md5), 0644)
+	}
+	os.Remove(archiveName)
+}
+
+// uploadShares uploads the shared documents that were changed
+func (ssed *Fs) uploadShares() error {
+	for _, s := range ssed.shares {
+		pending := s.folder() + ".pending"
+		if !s.Writable || (!s.changed && !utils.Exists(pending)) {
+			continue
+		}
+		err := ssed.uploadShare(s)
+		if err == ErrShareChanged {
+			// add what the others wrote, so it is uploaded too
+			ssed.downloadShare(s.ID)
+			err = ssed.uploadShare(s)
+		}
+		if err != nil {
+			// tried again next time
+			ioutil.WriteFile(pending, []byte(utils.GetCurrentDate()), 0644)
+			return fmt.Errorf("Could not upload shared document %s: %s", s.name(ssed.username), err.Error())
+		}
+		os.Remove(pending)
+		s.changed = false
+	}
+	return nil
+}
+
+func (ssed *Fs) uploadShare(s *share) error {
+	// like the repository, it is only pushed after it was pulled
+	if !strings.Contains(ssed.method, "http") || !ssed.successfulPull {
+		return errors.New("No server available")
+	}
+	files, _ := filepath.Glob(path.Join(s.folder(), "*"))
+	archiveName := s.folder() + ".tar.bz2"
+	defer os.Remove(archiveName)
+	if err := archiver.TarBz2.Make(archiveName, files); err != nil {
+		return err
+	}
+	md5, _ := utils.ComputeMd5(archiveName)
+	file, err := os.Open(archiveName)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	downloaded, _ := ioutil.ReadFile(s.folder() + ".md5")
+	req, err := http.NewRequest("POST", ssed.method+"/share?id="+url.QueryEscape(s.ID)+"&md5="+url.QueryEscape(string(downloaded)), file)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(ssed.username, ssed.authKey)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusConflict {
+		return ErrShareChanged
+	} else if resp.StatusCode != http.StatusOK {
+		b, _ := ioutil.ReadAll(resp.Body)
+		return errors.New(strings.TrimSpace(string(b)))
+	}
+	return ioutil.WriteFile(s.folder()+".md5", []byte(md5), 0644)
+}
+
+// Share shares a document of the user with another user of the server,
+// who sees it as Document@owner. The document is then kept apart from the
+// repository, encrypted with a key that is given to each user it is
+// shared with.
+func (ssed *Fs) Share(documentName, username string, writable bool) error {
+	if !strings.Contains(ssed.method, "http") {
+		return errors.New("Sharing needs a bol server")
+	}
+	if username == ssed.username {
+		return errors.New("Can not share with yourself")
+	}
+	if !ssed.parsed {
+		ssed.parseArchive()
+	}
+	s, shared := ssed.shares[documentName]
+	if shared && s.Owner != ssed.username {
+		return fmt.Errorf("Only %s can share '%s'", s.Owner, documentName)
+	}
+	if ssed.vaultOf(documentName) != nil {
+		return errors.New("Documents in a vault can not be shared")
+	}
+	if !shared && len(ssed.GetDocument(documentName)) == 0 {
+		return fmt.Errorf("No document named '%s'", documentName)
+	}
+
+	publicKey, err := ssed.publicKeyOf(username)
+	if err != nil {
+		return err
+	}
+	if !shared {
+		if s, err = ssed.newShare(documentName); err != nil {
+			return err
+		}
+	}
+	return ssed.sealShare(s, username, publicKey, writable)
+}
+
+// sealShare gives the key of the share to the user, sealed to its public
+// key
+func (ssed *Fs) sealShare(s *share, username, publicKey string, writable bool) error {
+	message, err := json.Marshal(sealedShare{Document: s.Document, Key: s.Key})
+	if err != nil {
+		return err
+	}
+	sealed, err := sealKey(message, publicKey)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(map[string]interface{}{"user": username, "key": sealed, "writable": writable})
+	if err != nil {
+		return err
+	}
+	_, err = ssed.serverRequest("PUT", "/share?id="+url.QueryEscape(s.ID), bytes.NewReader(b))
+	return err
+}
+
+// Unshare stops sharing a document of the user with another user. The
+// share is encrypted with a new key, which is given to the users that still
+// have it, so the user that was removed can not read later changes. It can
+// be run again when it fails halfway.
+func (ssed *Fs) Unshare(documentName, username string) error {
+	if !strings.Contains(ssed.method, "http") {
+		return errors.New("Sharing needs a bol server")
+	}
+	if !ssed.parsed {
+		ssed.parseArchive()
+	}
+	s, shared := ssed.shares[documentName]
+	if !shared {
+		return fmt.Errorf("'%s' is not shared", documentName)
+	}
+	if s.Owner != ssed.username {
+		return fmt.Errorf("Only %s can unshare '%s'", s.Owner, documentName)
+	}
+	b, err := ssed.serverRequest("DELETE", "/share?id="+url.QueryEscape(s.ID)+"&user="+url.QueryEscape(username), nil)
+	if err != nil {
+		return err
+	}
+	// the users that still have the document, and whether they can write
+	var members map[string]bool
+	if err = json.Unmarshal(b, &members); err != nil {
+		return err
+	}
+
+	// what the others wrote is encrypted with the new key too
+	ssed.downloadShare(s.ID)
+	if err = ssed.rekeyShare(s); err != nil {
+		return err
+	}
+	if err = ssed.uploadShare(s); err != nil {
+		// the members keep the old key until it is uploaded
+		s.changed = true
+		return fmt.Errorf("Could not upload '%s' with its new key: %s", documentName, err.Error())
+	}
+	for member, writable := range members {
+		publicKey, err := ssed.publicKeyOf(member)
+		if err == nil {
+			err = ssed.sealShare(s, member, publicKey, writable)
+		}
+		if err != nil {
+			return fmt.Errorf("Could not give the new key of '%s' to %s: %s", documentName, member, err.Error())
+		}
+	}
+	return nil
+}
+
+// rekeyShare encrypts the files of the share with a new key
+func (ssed *Fs) rekeyShare(s *share) error {
+	key := make([]byte, 32)
+	if _, err := crand.Read(key); err != nil {
+		return err
+	}
+	newKey := hex.EncodeToString(key)
+	files, _ := filepath.Glob(path.Join(s.folder(), "*"))
+	for _, file := range files {
+		b, err := utils.DecryptFromFile(s.Key, file)
+		if err == nil {
+			err = utils.EncryptToFile(b, newKey, file+".new")
+		}
+		if err != nil {
+			for _, file := range files {
+				os.Remove(file + ".new")
+			}
+			return err
+		}
+	}
+	oldKey := s.Key
+	s.Key = newKey
+	if err := ssed.writeShare(s); err != nil {
+		s.Key = oldKey
+		return err
+	}
+	for _, file := range files {
+		os.Rename(file+".new", file)
+	}
+	return nil
+}
+
+// newShare moves every version of the entries of a document, and their
+// attachments, to a new share. The versions in the repository are then
+// ignored.
+func (ssed *Fs) newShare(documentName string) (*share, error) {
+	id, err := utils.NewULID()
+	if err != nil {
+		return nil, err
+	}
+	key := make([]byte, 32)
+	if _, err = crand.Read(key); err != nil {
+		return nil, err
+	}
+	s := &share{ID: id, Document: documentName, Owner: ssed.username, Key: hex.EncodeToString(key), Writable: true, changed: true}
+	os.MkdirAll(s.folder(), 0755)
+
+	reencrypt := func(fileName string) error {
+		b, err := utils.DecryptFromFile(ssed.password, fileName)
+		if err != nil {
+			return err
+		}
+		return utils.EncryptToFile(b, s.Key, path.Join(s.folder(), filepath.Base(fileName)))
+	}
+	for uuid, e := range ssed.entries {
+		if e.Document != documentName {
+			continue
+		}
+		if err = reencrypt(path.Join(ssed.pathToLocalRepo, uuid)); err != nil {
+			return nil, err
+		}
+		for _, attachment := range e.Attachments {
+			fileName := ssed.attachmentPath(attachment)
+			if utils.Exists(fileName) && !utils.Exists(path.Join(s.folder(), filepath.Base(fileName))) {
+				if err = reencrypt(fileName); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	if err = ssed.writeShare(s); err != nil {
+		return nil, err
+	}
+	ssed.shares[documentName] = s
+	ssed.parsed = false
+	return s, nil
+}
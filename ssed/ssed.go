@@ -6,11 +6,11 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
-	"log"
 	"net/http"
 	"os"
 	"path"
 	"path/filepath"
+	"runtime"
 	"sort"
 	"strings"
 	"sync"
@@ -20,9 +20,6 @@ import (
 	"github.com/schollz/archiver"
 	"github.com/schollz/bol/utils"
 	"github.com/schollz/lumber"
-	"github.com/schollz/pwdhash"
-	"github.com/shirou/gopsutil/cpu"
-	"github.com/shirou/gopsutil/host"
 )
 
 // Generic functions
@@ -41,8 +38,22 @@ var pathToRemoteFolder string
 var homePath string
 var logger *lumber.ConsoleLogger
 
-// PathToTempFolder is the path to the temporary folder created by ssed
+// PathToTempFolder is the path to the private folder for decrypted files,
+// which is empty until it is created by TempFolder
 var PathToTempFolder string
+var tempFolderLock sync.Mutex
+
+// sharedMemoryFolder is the in-memory folder of Linux, used when
+// $XDG_RUNTIME_DIR is not
+var sharedMemoryFolder = "/dev/shm"
+
+// diskTempFolder lets TempFolder use the system temp folder, see
+// AllowDiskTempFolder
+var diskTempFolder bool
+
+// ErrNoMemoryFolder is returned by TempFolder when there is no in-memory
+// folder for decrypted files
+var ErrNoMemoryFolder = errors.New("No in-memory folder for decrypted files, use -disk-temp to keep them in the system temp folder")
 var LocalFolder string
 var RemoteFolder string
 
@@ -72,9 +83,6 @@ func createDirs() {
 	if !utils.Exists(path.Join(dir, ".cache", "ssed")) {
 		os.MkdirAll(path.Join(dir, ".cache", "ssed"), 0755)
 	}
-	if !utils.Exists(path.Join(dir, ".cache", "ssed", "temp")) {
-		os.MkdirAll(path.Join(dir, ".cache", "ssed", "temp"), 0755)
-	}
 	if !utils.Exists(path.Join(dir, ".cache", "ssed", "local")) {
 		os.MkdirAll(path.Join(dir, ".cache", "ssed", "local"), 0755)
 	}
@@ -84,7 +92,6 @@ func createDirs() {
 	pathToConfigFile = path.Join(dir, ".config", "ssed", "config.json")
 	pathToConfigFolder = path.Join(dir, ".config", "ssed")
 	pathToCacheFolder = path.Join(dir, ".cache", "ssed")
-	PathToTempFolder = path.Join(dir, ".cache", "ssed", "temp")
 	pathToLocalFolder = path.Join(dir, ".cache", "ssed", "local")
 	LocalFolder = pathToLocalFolder
 	pathToRemoteFolder = path.Join(dir, ".cache", "ssed", "remote")
@@ -93,11 +100,13 @@ func createDirs() {
 
 // Entry is the fundamental unit of an entry in any document
 type Entry struct {
-	Text              string `json:"text"`
-	Timestamp         string `json:"timestamp"`
-	ModifiedTimestamp string `json:"modified_timestamp"`
-	Document          string `json:"document"`
-	Entry             string `json:"entry"`
+	Text              string       `json:"text"`
+	Timestamp         string       `json:"timestamp"`
+	ModifiedTimestamp string       `json:"modified_timestamp"`
+	Document          string       `json:"document"`
+	Entry             string       `json:"entry"`
+	Tags              []string     `json:"tags,omitempty"`
+	Attachments       []Attachment `json:"attachments,omitempty"`
 	datetime          time.Time
 	uuid              string
 }
@@ -118,14 +127,47 @@ type Fs struct {
 	pathToLocalRepo  string
 	pathToRemoteRepo string
 	username         string
-	password         string
+	password         string // what encrypts the repository, see secret
+	typedPassword    string // the password as it was given to Open
+	keySecret        string // what the password is derived from, see saltFile
+	authKey          string // what authenticates with the server, see utils.AuthKey
+	legacyAuth       bool   // the server still has the password instead of authKey
+	quota            int64  // bytes the server keeps for the archive, 0 if unknown
+	quotaUsed        int64  // size of the archive and the owned shares on the server
+	quotaShares      int64  // size of the shares the user owns on the server
+	remoteMD5        string // md5 of the archive on the server, when last asked
+	pulledMD5        string // md5 of the archive on the server, when last pulled or pushed
+	pushed           bool   // the latest archive on the server is the one Close made
+	keyFileHash      string
 	method           string
 	archiveName      string
-	entries          map[string]Entry    // uuid -> entry
-	entryNameToUUID  map[string]string   // entry name -> uuid
-	ordering         map[string][]string // document -> list of entry uuids in order
+	entries          map[string]Entry             // uuid -> entry
+	entryNameToUUID  map[string]map[string]string // document -> entry name -> uuid
+	ordering         map[string][]string          // document -> list of entry uuids in order
+	tags             map[string][]string          // tag -> list of entry uuids
+	backlinks        map[string][]string          // "document/entry" -> list of uuids of entries linking to it
+	shares           map[string]*share            // document -> the share it is kept in
+	vaults           map[string]*vault            // id -> vault
+	vaultPrompt      func(documentName string) string
+	keyPrompt        func(username, fingerprint string, changed bool) bool
 }
 
+// ErrAmbiguousEntry is returned by GetDocumentOrEntry when an entry name
+// without a document matches entries in more than one document
+var ErrAmbiguousEntry = errors.New("Entry name exists in more than one document")
+
+// ErrRemoteChanged is returned by Close when another device pushed since
+// the repository was pulled, so pushing would replace its changes
+var ErrRemoteChanged = errors.New("The repository was changed on another device, local changes saved. They will be uploaded next time.")
+
+// ErrNoChanges is returned by Close when the repository is the same as on
+// the server, so there was nothing to upload
+var ErrNoChanges = errors.New("No changes, not uploading.")
+
+// ErrPasswordChanged is returned by Pull when the password was changed on
+// another device
+var ErrPasswordChanged = errors.New("The password was changed on another device, open the repository again")
+
 // GetBlankEntries returns an empty slice of entries
 func GetBlankEntries() []Entry {
 	return []Entry{}
@@ -149,70 +191,68 @@ func EraseAll() {
 	}
 }
 
-// CleanUp shreds all the temporary files
-func CleanUp() {
-	utils.Shred(path.Join(PathToTempFolder, "temp"))
-}
-
-// HasPinFile
-func (ssed *Fs) HasPinFile() bool {
-	return utils.Exists(path.Join(pathToConfigFolder, ssed.username+".key"))
+// AllowDiskTempFolder lets TempFolder use the system temp folder, which may
+// be on disk, when there is no in-memory folder
+func AllowDiskTempFolder() {
+	diskTempFolder = true
 }
 
-// GetPasswordFromPin allows to use a pin
-func (ssed *Fs) GetPasswordFromPin(pin string) (string, error) {
-	if !utils.Exists(path.Join(pathToConfigFolder, ssed.username+".key")) {
-		return "", errors.New("Key not set")
+// TempFolder returns a private folder (0700) for decrypted files, creating it
+// the first time. The folder is in memory, in $XDG_RUNTIME_DIR or /dev/shm, so
+// that decrypted text is never written to disk. If neither is available it
+// returns ErrNoMemoryFolder, unless AllowDiskTempFolder was called.
+func TempFolder() (string, error) {
+	tempFolderLock.Lock()
+	defer tempFolderLock.Unlock()
+	if len(PathToTempFolder) > 0 && utils.Exists(PathToTempFolder) {
+		return PathToTempFolder, nil
+	}
+	memoryFolders := []string{}
+	if len(os.Getenv("XDG_RUNTIME_DIR")) > 0 {
+		memoryFolders = append(memoryFolders, os.Getenv("XDG_RUNTIME_DIR"))
+	}
+	if runtime.GOOS == "linux" {
+		memoryFolders = append(memoryFolders, sharedMemoryFolder)
+	}
+	for _, memoryFolder := range memoryFolders {
+		folder, err := ioutil.TempDir(memoryFolder, "bol-")
+		if err == nil {
+			PathToTempFolder = folder
+			logger.Debug("Using %s for decrypted files", folder)
+			return folder, nil
+		}
+		logger.Debug("Could not use %s: %s", memoryFolder, err.Error())
 	}
-	hashPin, err := HashPasswordSlow(pin)
-	if err != nil {
-		os.Remove(path.Join(pathToConfigFolder, ssed.username+".key"))
-		return "", err
+	if !diskTempFolder {
+		return "", ErrNoMemoryFolder
 	}
-	bPassword, err := utils.DecryptFromFile(hashPin, path.Join(pathToConfigFolder, ssed.username+".key"))
+	folder, err := ioutil.TempDir("", "bol-")
 	if err != nil {
-		os.Remove(path.Join(pathToConfigFolder, ssed.username+".key"))
 		return "", err
 	}
-	return string(bPassword), nil
+	logger.Warn("No in-memory folder available, using %s for decrypted files", folder)
+	PathToTempFolder = folder
+	return folder, nil
 }
 
-// SetPinFromPassword allows to use a pin
-func (ssed *Fs) SetPinFromPassword(pin string) error {
-	hashPin, err := HashPasswordSlow(pin)
-	if err != nil {
-		return err
-	}
-	return utils.EncryptToFile([]byte(ssed.password), hashPin, path.Join(pathToConfigFolder, ssed.username+".key"))
-}
-
-// HashPasswordSlow generates a bcrypt hash of the password using work factor 1048576.
-func HashPasswordSlow(password string) (string, error) {
-	hostinfo, e := host.Info()
-	if e != nil {
-		return "", e
-	}
-	cpuinfo, e := cpu.Info()
-	if e != nil {
-		return "", e
-	}
-	salt := hostinfo.OS + hostinfo.Hostname + cpuinfo[0].ModelName
-	t := time.Now()
-	var hashedPass string
-	workFactor := 65536
-	for {
-		p, err := pwdhash.GenerateFromPassword([]byte(password), []byte(salt), workFactor, 64, "sha512")
-		if err != nil {
-			return "", err
-		}
-		hashedPass = string(p)
-		if time.Since(t) > 500*time.Millisecond {
-			break
+// CleanUp shreds all the temporary files and removes the temporary folder
+func CleanUp() {
+	tempFolderLock.Lock()
+	defer tempFolderLock.Unlock()
+	if len(PathToTempFolder) > 0 {
+		files, _ := filepath.Glob(path.Join(PathToTempFolder, "*"))
+		hiddenFiles, _ := filepath.Glob(path.Join(PathToTempFolder, ".*"))
+		for _, file := range append(files, hiddenFiles...) {
+			utils.Shred(file)
 		}
-		workFactor = workFactor + 65536
-		t = time.Now()
+		os.RemoveAll(PathToTempFolder)
+		PathToTempFolder = ""
+	}
+	// older versions kept decrypted files in the cache folder
+	if len(pathToCacheFolder) > 0 && utils.Exists(path.Join(pathToCacheFolder, "temp")) {
+		utils.Shred(path.Join(pathToCacheFolder, "temp", "temp"))
+		os.RemoveAll(path.Join(pathToCacheFolder, "temp"))
 	}
-	return hashedPass, nil
 }
 
 // ReturnMethod returns the current method being used
@@ -344,10 +384,34 @@ func (ssed *Fs) downloadAndDecompress() {
 	ssed.decompress()
 	// copy over files
 	ssed.copyOverFiles()
+	ssed.downloadShares()
 	// unlock the to allow it to continue
 	ssed.wg.Done()
 }
 
+// Pull downloads the archive on the server and adds its files to the local
+// repository, like Init, for programs that keep the repository open while
+// other devices push.
+func (ssed *Fs) Pull() error {
+	ssed.wg.Wait()
+	if !strings.Contains(ssed.method, "http") {
+		return nil
+	}
+	if err := ssed.download(); err != nil {
+		return err
+	}
+	ssed.successfulPull = true
+	rekeys, _ := filepath.Glob(path.Join(pathToLocalFolder, ssed.username, "*"+rekeyExtension))
+	ssed.decompress()
+	ssed.copyOverFiles()
+	ssed.downloadShares()
+	ssed.parsed = false
+	if newRekeys, _ := filepath.Glob(path.Join(pathToLocalFolder, ssed.username, "*"+rekeyExtension)); len(newRekeys) != len(rekeys) {
+		return ErrPasswordChanged
+	}
+	return nil
+}
+
 func (ssed *Fs) doesMD5MatchServer() (bool, error) {
 
 	if !strings.Contains(ssed.method, "http") {
@@ -366,10 +430,13 @@ func (ssed *Fs) doesMD5MatchServer() (bool, error) {
 		return false, err
 	}
 	defer resp.Body.Close()
+	ssed.legacyAuth = resp.Header.Get("Bol-Authentication") == "password"
+	ssed.readQuota(resp.Header)
 	htmlData, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return false, err
 	}
+	ssed.remoteMD5 = string(htmlData)
 	currentMD5, err := utils.ComputeMd5(path.Join(pathToLocalFolder, ssed.archiveName))
 	if err != nil {
 		logger.Debug("Problem: %s", err.Error())
@@ -391,8 +458,10 @@ func (ssed *Fs) download() error {
 	}
 	if matching {
 		logger.Debug("Not downloading since MD5 matches")
+		ssed.pulledMD5 = ssed.remoteMD5
 		return nil
 	}
+	pulledMD5 := ssed.remoteMD5
 	defer timeTrack(time.Now(), "download")
 	req, err := http.NewRequest("GET", ssed.method+"/repo", nil)
 	if err != nil {
@@ -415,7 +484,7 @@ func (ssed *Fs) download() error {
 		return err
 	}
 	outFile.Close()
-
+	ssed.pulledMD5 = pulledMD5
 	return nil
 }
 
@@ -454,6 +523,16 @@ func (ssed *Fs) copyOverFiles() {
 		localFiles[filepath.Base(file)] = true
 	}
 
+	// a password change on another device re-encrypted the remote files
+	rekeys, _ := filepath.Glob(path.Join(pathToRemoteFolder, ssed.username, "*"+rekeyExtension))
+	for _, file := range rekeys {
+		if _, ok := localFiles[filepath.Base(file)]; !ok {
+			logger.Debug("Password was changed on another device")
+			ssed.useRekeyedRemote()
+			return
+		}
+	}
+
 	files, _ = filepath.Glob(path.Join(pathToRemoteFolder, ssed.username, "*"))
 	for _, file := range files {
 		if _, ok := localFiles[filepath.Base(file)]; !ok {
@@ -476,54 +555,181 @@ func (ssed *Fs) copyOverFiles() {
 // 	return string(decrypted), err
 // }
 
-// Open attempts to open a ssed repostiroy using the specified password
+// Open attempts to open a ssed repostiroy using the specified password.
+// After UseAgent, the keys of an empty password are asked from the agent.
+// After UseKeyFile, the password is combined with the key file.
 func (ssed *Fs) Open(password string) error {
 	// only continue if the downloading is finished
 	ssed.wg.Wait()
 	logger.Debug("Finished waiting")
 
+	// the keys of an empty password are asked from the agent, unless the
+	// repository is opened with a key file alone
+	var key, secret string
+	var response agentResponse
+	fromAgent, needsSalt := false, false
+	if len(password) == 0 && useAgent {
+		var err error
+		response, err = askAgent(agentRequest{Command: "get", Username: ssed.username})
+		if err == nil {
+			key, fromAgent = string(response.Key), true
+		} else if !ssed.passwordOptional() {
+			return err
+		}
+	}
+	if !fromAgent {
+		var err error
+		if secret, err = ssed.secret(password); err != nil {
+			return err
+		}
+		key = secret
+		sf, errSalt := ssed.readSaltFile()
+		if errSalt == nil {
+			if key, err = sf.key(secret); err != nil {
+				return err
+			}
+		}
+		needsSalt = errSalt != nil
+	}
+
 	// check password against one of the files (if they exist)
-	files, _ := filepath.Glob(path.Join(pathToLocalFolder, ssed.username, "*"))
+	files, _ := filepath.Glob(path.Join(pathToLocalFolder, ssed.username, "*.json"))
 	if len(files) > 0 {
 		logger.Debug("Testing against %s", files[0])
-		_, err := utils.DecryptFromFile(password, files[0])
+		_, err := utils.DecryptFromFile(key, files[0])
 		if err != nil {
+			if len(ssed.keyFileHash) > 0 {
+				return errors.New("Incorrect password or key file")
+			}
 			return err
 		}
 	}
-	ssed.password = password
+	ssed.password = key
+	ssed.keySecret = secret
+	ssed.typedPassword = password
+	if fromAgent {
+		ssed.authKey = string(response.AuthKey)
+	} else {
+		ssed.authKey = utils.AuthKey(ssed.username, secret)
+	}
+	if ssed.legacyAuth && !fromAgent {
+		// the server only gets the password this one time, to replace it
+		// with the authentication key
+		if err := utils.UpgradeBolUser(ssed.username, secret, ssed.method); err != nil {
+			logger.Warn("Could not upgrade the authentication with the server: %s", err.Error())
+		} else {
+			ssed.legacyAuth = false
+		}
+	}
+	if needsSalt {
+		// the repository is still encrypted with the secret, which the
+		// server could guess quickly
+		if err := ssed.addSalt(); err != nil {
+			logger.Debug("Could not add a salt to the repository: %s", err.Error())
+		}
+	}
+	if useAgent && !fromAgent && len(password) > 0 {
+		// keep its keys for the next commands, if an agent is running
+		ssed.setAgentKeys()
+	}
+	ssed.loadShares()
+	ssed.loadVaults()
 	return nil
 }
 
 // Update make a new entry
 // date can be empty, it will fill in the current date if so
 func (ssed *Fs) Update(text, documentName, entryName, timestamp string) error {
-	if len(entryName) == 0 {
-		entryName = utils.RandStringBytesMaskImprSrc(10)
+	e := Entry{
+		Text:      text,
+		Document:  documentName,
+		Entry:     entryName,
+		Timestamp: timestamp,
+	}
+	if current, err := ssed.GetEntry(documentName, entryName); err == nil {
+		if current.Text == text {
+			return nil
+		}
+		e.Tags = current.Tags
 	}
-	fileName := path.Join(ssed.pathToLocalRepo, utils.HashAndHex(text+entryName)+".json")
-	if utils.Exists(fileName) {
-		return nil
+	return ssed.UpdateEntry(e)
+}
+
+// UpdateEntry makes a new version of an entry with the text, tags and
+// timestamp of e, keeping the attachments of the previous version. Nothing
+// is written if none of them changed, and an empty timestamp keeps the
+// previous one.
+func (ssed *Fs) UpdateEntry(e Entry) error {
+	if len(e.Entry) == 0 {
+		var err error
+		e.Entry, err = ssed.NewEntryName()
+		if err != nil {
+			return err
+		}
+	}
+	e.Tags = parseTags("", e.Tags)
+	if current, err := ssed.GetEntry(e.Document, e.Entry); err == nil {
+		if len(e.Timestamp) == 0 {
+			e.Timestamp = current.Timestamp
+		}
+		if current.Text == e.Text && strings.Join(parseTags("", current.Tags), ",") == strings.Join(e.Tags, ",") && utils.ReFormatDate(e.Timestamp) == current.Timestamp {
+			return nil
+		}
+		// keep what is not part of the text from the previous version
+		e.Attachments = current.Attachments
 	}
+	return ssed.writeEntry(e)
+}
 
-	if len(timestamp) == 0 {
-		timestamp = utils.GetCurrentDate()
+// writeEntry encrypts a new version of an entry to the local repo, filling in
+// its timestamps
+func (ssed *Fs) writeEntry(e Entry) error {
+	if len(e.Timestamp) == 0 {
+		e.Timestamp = utils.GetCurrentDate()
 	} else {
-		timestamp = utils.ReFormatDate(timestamp)
+		e.Timestamp = utils.ReFormatDate(e.Timestamp)
 	}
 
-	modifiedTimestamp := timestamp
-	if ssed.entryExists(entryName) {
-		modifiedTimestamp = utils.GetCurrentDate()
+	e.ModifiedTimestamp = e.Timestamp
+	if ssed.entryExists(e.Document, e.Entry) {
+		e.ModifiedTimestamp = utils.GetCurrentDate()
 	}
 
-	e := Entry{
-		Text:              text,
-		Document:          documentName,
-		Entry:             entryName,
-		Timestamp:         timestamp,
-		ModifiedTimestamp: modifiedTimestamp,
+	// entries of shared documents are kept with the share, and entries of
+	// vaults are encrypted with the key of the vault
+	folder, password := ssed.pathToLocalRepo, ssed.password
+	var v *vault
+	if s, ok := ssed.shares[e.Document]; ok {
+		if !s.Writable {
+			return ErrReadOnly
+		}
+		folder, password = s.folder(), s.Key
+		e.Document = s.Document
+		s.changed = true
+	} else if v = ssed.vaultOf(e.Document); v != nil {
+		if len(v.key) == 0 {
+			return ErrVaultLocked
+		}
+		password = v.key
+	}
+
+	// the modified timestamp is part of the name, so a version that goes back
+	// to an earlier text is a new file that other devices pick up
+	content := e.Text + e.Document + "/" + e.Entry + e.Timestamp + e.ModifiedTimestamp
+	if len(e.Tags) > 0 {
+		content += "#" + strings.Join(e.Tags, ",")
+	}
+	for _, attachment := range e.Attachments {
+		content += attachment.ID
 	}
+	fileName := path.Join(folder, utils.HashAndHex(content)+".json")
+	if v != nil {
+		fileName = v.entryPath(folder, utils.HashAndHex(content))
+	}
+	if utils.Exists(fileName) {
+		return nil
+	}
+
 	b, err := json.MarshalIndent(e, "", "  ")
 	if err != nil {
 		return err
@@ -533,7 +739,7 @@ func (ssed *Fs) Update(text, documentName, entryName, timestamp string) error {
 	// encrypted, _ := cryptopasta.Encrypt(b, &key)
 	//
 	// err = ioutil.WriteFile(fileName, []byte(hex.EncodeToString(encrypted)), 0755)
-	err = utils.EncryptToFile(b, ssed.password, fileName)
+	err = utils.EncryptToFile(b, password, fileName)
 
 	ssed.parsed = false
 	if err == nil {
@@ -544,6 +750,31 @@ func (ssed *Fs) Update(text, documentName, entryName, timestamp string) error {
 	return err
 }
 
+// NewEntryName returns a new time-sortable, random entry name (see
+// utils.NewULID) that is not used by any entry in any document
+func (ssed *Fs) NewEntryName() (string, error) {
+	if !ssed.parsed {
+		ssed.parseArchive()
+	}
+	for {
+		entryName, err := utils.NewULID()
+		if err != nil {
+			return "", err
+		}
+		collision := false
+		for document := range ssed.entryNameToUUID {
+			if _, ok := ssed.entryNameToUUID[document][entryName]; ok {
+				collision = true
+				break
+			}
+		}
+		if !collision {
+			return entryName, nil
+		}
+		logger.Debug("Entry name %s already exists, generating another", entryName)
+	}
+}
+
 // DeleteEntry will simply Update("ignore-entry",documentName,entryName,"")
 func (ssed *Fs) DeleteEntry(documentName, entryName string) {
 	ssed.Update("ignore entry", documentName, entryName, "")
@@ -560,10 +791,13 @@ func (ssed *Fs) DeleteDocument(documentName string) {
 func (ssed *Fs) Close() error {
 	var err error
 	defer timeTrack(time.Now(), "Closing archive")
-	defer os.Remove(path.Join(PathToTempFolder, "temp"))
 	wd, _ := os.Getwd()
 	os.Chdir(path.Join(pathToLocalFolder, ssed.username))
 	filesFullPath, _ := filepath.Glob(path.Join(pathToLocalFolder, ssed.username, "*.json"))
+	for _, extension := range []string{attachmentExtension, deviceExtension, revokedExtension, unlockExtension, recoveryExtension, rekeyExtension, saltExtension, keyPairExtension, knownKeysExtension, shareExtension, vaultExtension, vaultEntryExtension, vaultAttachmentExtension} {
+		otherFiles, _ := filepath.Glob(path.Join(pathToLocalFolder, ssed.username, "*"+extension))
+		filesFullPath = append(filesFullPath, otherFiles...)
+	}
 	fileList := make([]string, len(filesFullPath))
 	logger.Debug("archiving %d files", len(filesFullPath))
 	for i, file := range filesFullPath {
@@ -579,19 +813,28 @@ func (ssed *Fs) Close() error {
 	os.Chdir(wd)
 
 	matching, err := ssed.doesMD5MatchServer()
-	if ssed.successfulPull && !matching {
-		err = ssed.upload()
-		if err != nil {
-			err = errors.New("Cannot connect, local changes saved.")
+	if ssed.successfulPull && !matching && err == nil && ssed.remoteMD5 != ssed.pulledMD5 {
+		// pushing would replace what another device pushed since the pull
+		err = ErrRemoteChanged
+	} else if ssed.successfulPull && !matching {
+		if err = ssed.checkQuota(); err == nil {
+			err = ssed.upload()
+			if err != nil && err != ErrQuotaExceeded {
+				err = errors.New("Cannot connect, local changes saved.")
+			}
 		}
 	} else {
 		if !ssed.successfulPull {
 			err = errors.New("No internet, changes will be uploaded next time.")
 		} else {
-			err = errors.New("No changes, not uploading.")
+			err = ErrNoChanges
 
 		}
 	}
+	ssed.pushed = ssed.successfulPull && (matching || err == nil)
+	if errShares := ssed.uploadShares(); errShares != nil {
+		err = errShares
+	}
 	// // shred the data files
 	// if ssed.shredding {
 	// 	files, _ := filepath.Glob(path.Join(PathToTempFolder, "*", "*"))
@@ -621,7 +864,7 @@ func (ssed *Fs) delete() error {
 		if err != nil {
 			return err
 		}
-		req.SetBasicAuth(ssed.username, ssed.password)
+		req.SetBasicAuth(ssed.username, ssed.authKey)
 
 		resp, err := http.DefaultClient.Do(req)
 		if err != nil {
@@ -650,20 +893,26 @@ func (ssed *Fs) upload() error {
 		if err != nil {
 			return err
 		}
-		req.SetBasicAuth(ssed.username, ssed.password)
+		req.SetBasicAuth(ssed.username, ssed.authKey)
 		req.Header.Set("Content-Type", "application/octet-stream")
 
 		resp, err := http.DefaultClient.Do(req)
+		os.Chdir(wd)
 		if err != nil {
 			return err
 		}
+		defer resp.Body.Close()
+		ssed.readQuota(resp.Header)
+		if resp.StatusCode == http.StatusRequestEntityTooLarge {
+			return ErrQuotaExceeded
+		}
+		if resp.StatusCode == http.StatusOK {
+			ssed.pulledMD5, _ = utils.ComputeMd5(path.Join(pathToLocalFolder, ssed.archiveName))
+		}
 		_, err = io.Copy(os.Stdout, resp.Body)
 		if err != nil {
 			return err
 		}
-		defer resp.Body.Close()
-
-		os.Chdir(wd)
 	}
 	return nil
 }
@@ -683,11 +932,24 @@ func (p timeSlice) Swap(i, j int) {
 	p[i], p[j] = p[j], p[i]
 }
 
+// chronologicalEntries returns the entries with the given uuids sorted
+// by their creation time, oldest first
+func (ssed *Fs) chronologicalEntries(uuids []string) []Entry {
+	entries := make(timeSlice, len(uuids))
+	for i, uuid := range uuids {
+		entries[i] = ssed.entries[uuid]
+		entries[i].datetime, _ = utils.ParseDate(entries[i].Timestamp)
+	}
+	// timeSlice sorts newest first, so reverse for chronological order
+	sort.Sort(sort.Reverse(entries))
+	return []Entry(entries)
+}
+
 func (ssed *Fs) parseArchive() {
 	defer timeTrack(time.Now(), "Parsing archive")
-	files, _ := filepath.Glob(path.Join(ssed.pathToLocalRepo, "*"))
+	files, _ := filepath.Glob(path.Join(ssed.pathToLocalRepo, "*.json"))
 	ssed.entries = make(map[string]Entry)
-	ssed.entryNameToUUID = make(map[string]string)
+	ssed.entryNameToUUID = make(map[string]map[string]string)
 	ssed.ordering = make(map[string][]string)
 	var entriesToSortByModified = make(map[string]Entry)
 	for _, file := range files {
@@ -711,6 +973,10 @@ func (ssed *Fs) parseArchive() {
 		if err != nil {
 			panic(err)
 		}
+		if _, ok := ssed.shares[e.Document]; ok || ssed.vaultOf(e.Document) != nil {
+			// the versions from before it was shared or put in a vault
+			continue
+		}
 		e.uuid = filepath.Base(file)
 		e.datetime, _ = utils.ParseDate(e.Timestamp)
 		if len(e.ModifiedTimestamp) > 0 {
@@ -718,7 +984,58 @@ func (ssed *Fs) parseArchive() {
 		}
 		ssed.entries[e.uuid] = e
 		entriesToSortByModified[e.uuid] = e
-		ssed.entryNameToUUID[e.Entry] = e.uuid
+	}
+	for name, s := range ssed.shares {
+		sharedFiles, _ := filepath.Glob(path.Join(s.folder(), "*.json"))
+		for _, file := range sharedFiles {
+			decrypted, err := utils.DecryptFromFile(s.Key, file)
+			if err != nil {
+				logger.Warn("Could not decrypt %s of %s: %s", filepath.Base(file), name, err.Error())
+				continue
+			}
+			var e Entry
+			if err = json.Unmarshal(decrypted, &e); err != nil {
+				logger.Warn("Could not read %s of %s: %s", filepath.Base(file), name, err.Error())
+				continue
+			}
+			e.Document = name
+			e.uuid = filepath.Base(file)
+			e.datetime, _ = utils.ParseDate(e.Timestamp)
+			if len(e.ModifiedTimestamp) > 0 {
+				e.datetime, _ = utils.ParseDate(e.ModifiedTimestamp)
+			}
+			ssed.entries[e.uuid] = e
+			entriesToSortByModified[e.uuid] = e
+		}
+	}
+	for _, v := range ssed.vaults {
+		if len(v.key) == 0 {
+			// hidden until it is unlocked
+			continue
+		}
+		vaultFiles, _ := filepath.Glob(path.Join(ssed.pathToLocalRepo, v.ID+"-*"+vaultEntryExtension))
+		for _, file := range vaultFiles {
+			decrypted, err := utils.DecryptFromFile(v.key, file)
+			if err != nil {
+				logger.Warn("Could not decrypt %s of %s: %s", filepath.Base(file), v.document, err.Error())
+				continue
+			}
+			var e Entry
+			if err = json.Unmarshal(decrypted, &e); err != nil {
+				logger.Warn("Could not read %s of %s: %s", filepath.Base(file), v.document, err.Error())
+				continue
+			}
+			if e.Document != v.document {
+				continue
+			}
+			e.uuid = filepath.Base(file)
+			e.datetime, _ = utils.ParseDate(e.Timestamp)
+			if len(e.ModifiedTimestamp) > 0 {
+				e.datetime, _ = utils.ParseDate(e.ModifiedTimestamp)
+			}
+			ssed.entries[e.uuid] = e
+			entriesToSortByModified[e.uuid] = e
+		}
 	}
 	sortedEntries := make(timeSlice, 0, len(entriesToSortByModified))
 	for _, d := range entriesToSortByModified {
@@ -726,13 +1043,15 @@ func (ssed *Fs) parseArchive() {
 	}
 	sort.Sort(sortedEntries)
 
-	alreadyAddedEntry := make(map[string]bool)
 	var entriesToSortByCreated = make(map[string]Entry)
 	for _, entry := range sortedEntries {
-		if _, ok := alreadyAddedEntry[entry.Entry]; ok {
+		if _, ok := ssed.entryNameToUUID[entry.Document]; !ok {
+			ssed.entryNameToUUID[entry.Document] = make(map[string]string)
+		}
+		if _, ok := ssed.entryNameToUUID[entry.Document][entry.Entry]; ok {
 			continue
 		}
-		alreadyAddedEntry[entry.Entry] = true
+		ssed.entryNameToUUID[entry.Document][entry.Entry] = entry.uuid
 		entry.datetime, _ = utils.ParseDate(entry.Timestamp) // sort by created date now
 		entriesToSortByCreated[entry.uuid] = entry
 	}
@@ -757,20 +1076,24 @@ func (ssed *Fs) parseArchive() {
 			ssed.ordering[key][i], ssed.ordering[key][j] = ssed.ordering[key][j], ssed.ordering[key][i]
 		}
 	}
+	ssed.indexTags()
+	ssed.indexLinks()
 	ssed.parsed = true
 }
 
-// ListEntries returns slice of all the entries in all documents
+// ListEntries returns slice of all the entries in all documents, each
+// given as a "Document/Entry" path
 func (ssed *Fs) ListEntries() []string {
 	if !ssed.parsed {
 		ssed.parseArchive()
 	}
-	entries := make([]string, len(ssed.entryNameToUUID))
-	i := 0
-	for entry := range ssed.entryNameToUUID {
-		entries[i] = entry
-		i++
+	entries := []string{}
+	for document := range ssed.entryNameToUUID {
+		for entry := range ssed.entryNameToUUID[document] {
+			entries = append(entries, document+"/"+entry)
+		}
 	}
+	sort.Strings(entries)
 	return entries
 }
 
@@ -782,14 +1105,7 @@ func (ssed *Fs) ListDocuments() []string {
 	}
 	documents := []string{}
 	for document := range ssed.ordering {
-		ignoring := false
-		for _, uuid := range ssed.ordering[document] {
-			if ssed.entries[uuid].Text == "ignore document" {
-				ignoring = true
-				break
-			}
-		}
-		if !ignoring {
+		if !ssed.documentIgnored(document) {
 			documents = append(documents, document)
 		}
 	}
@@ -797,42 +1113,84 @@ func (ssed *Fs) ListDocuments() []string {
 	return documents
 }
 
-func (ssed *Fs) entryExists(entryName string) bool {
+// documentIgnored returns whether the document has been deleted with an
+// "ignore document" entry
+func (ssed *Fs) documentIgnored(document string) bool {
+	for _, uuid := range ssed.ordering[document] {
+		if ssed.entries[uuid].Text == "ignore document" {
+			return true
+		}
+	}
+	return false
+}
+
+func (ssed *Fs) entryExists(documentName, entryName string) bool {
 	if !ssed.parsed {
 		ssed.parseArchive()
 	}
-	if _, ok := ssed.entryNameToUUID[entryName]; ok {
+	if _, ok := ssed.entryNameToUUID[documentName][entryName]; ok {
 		return true
 	}
 	return false
 }
 
+// SplitEntryPath splits a "Document/Entry" path into its document and entry
+// names. A path without a slash is returned as an entry name with no document.
+func SplitEntryPath(entryPath string) (string, string) {
+	i := strings.LastIndex(entryPath, "/")
+	if i < 0 {
+		return "", entryPath
+	}
+	return entryPath[:i], entryPath[i+1:]
+}
+
 // GetDocumentOrEntry returns a entry slice that is either the entry or all entries in a document
-// this is a useful function if you don't know whether an input is a document or an entry
+// this is a useful function if you don't know whether an input is a document or an entry.
+// Entries can be given as "Document/Entry" or by their bare name. If a bare name
+// is used in more than one document, all the matching entries are returned along
+// with ErrAmbiguousEntry so that the caller can choose between them.
 func (ssed *Fs) GetDocumentOrEntry(ambiguous string) ([]Entry, bool, string, error) {
 	if !ssed.parsed {
 		ssed.parseArchive()
 	}
 	var entries []Entry
 	logger.Debug("Ambiguous: %s", ambiguous)
-	for document := range ssed.ordering {
-		logger.Debug("Possible doc: %s", document)
-		if ambiguous == document {
-			return ssed.GetDocument(ambiguous), true, document, nil
+	if _, ok := ssed.ordering[ambiguous]; ok || ssed.vaultOf(ambiguous) != nil {
+		return ssed.GetDocument(ambiguous), true, ambiguous, nil
+	}
+
+	documentName, entryName := SplitEntryPath(ambiguous)
+	if len(documentName) > 0 {
+		entry, err := ssed.GetEntry(documentName, entryName)
+		if err != nil {
+			return entries, true, ambiguous, err
 		}
-		for _, entryUUID := range ssed.ordering[document] {
-			if ssed.entries[entryUUID].Entry == ambiguous {
-				entries = []Entry{ssed.entries[entryUUID]}
-				return entries, false, ssed.entries[entryUUID].Document, nil
-			}
+		return []Entry{entry}, false, documentName, nil
+	}
+
+	documents := make([]string, 0, len(ssed.entryNameToUUID))
+	for document := range ssed.entryNameToUUID {
+		documents = append(documents, document)
+	}
+	sort.Strings(documents)
+	for _, document := range documents {
+		logger.Debug("Possible doc: %s", document)
+		if entry, err := ssed.GetEntry(document, entryName); err == nil {
+			entries = append(entries, entry)
 		}
 	}
+	if len(entries) == 1 {
+		return entries, false, entries[0].Document, nil
+	} else if len(entries) > 1 {
+		return entries, false, "", ErrAmbiguousEntry
+	}
 	return entries, true, ambiguous, errors.New("Can't find entry or document")
 }
 
 // GetDocument returns a slice of all entries in that document
 func (ssed *Fs) GetDocument(documentName string) []Entry {
 	defer timeTrack(time.Now(), "Getting document "+documentName)
+	ssed.touchVault(documentName)
 	if !ssed.parsed {
 		ssed.parseArchive()
 	}
@@ -856,24 +1214,39 @@ func (ssed *Fs) GetDocument(documentName string) []Entry {
 // GetEntry returns the entry with specified name and document
 func (ssed *Fs) GetEntry(documentName, entryName string) (Entry, error) {
 	defer timeTrack(time.Now(), "Getting entry "+entryName)
+	ssed.touchVault(documentName)
 	if !ssed.parsed {
 		ssed.parseArchive()
 	}
 
 	var e Entry
-	for _, uuid := range ssed.ordering[documentName] {
-		log.Println(entryName, ssed.entries[uuid].Entry)
-		if ssed.entries[uuid].Entry == entryName {
-			if ssed.entries[uuid].Text == "ignore entry" {
-				return e, errors.New("Entry deleted")
-			} else {
-				return ssed.entries[uuid], nil
-			}
+	if uuid, ok := ssed.entryNameToUUID[documentName][entryName]; ok {
+		if ssed.entries[uuid].Text == "ignore entry" {
+			return e, errors.New("Entry deleted")
 		}
+		return ssed.entries[uuid], nil
 	}
 	return e, errors.New("Entry not found")
 }
 
+// GetHistory returns every version of an entry, oldest first, which ends
+// with an "ignore entry" if the entry was deleted
+func (ssed *Fs) GetHistory(documentName, entryName string) []Entry {
+	ssed.touchVault(documentName)
+	if !ssed.parsed {
+		ssed.parseArchive()
+	}
+	versions := timeSlice{}
+	for _, e := range ssed.entries {
+		if e.Document == documentName && e.Entry == entryName {
+			versions = append(versions, e)
+		}
+	}
+	// entries are sorted by when they were modified
+	sort.Sort(sort.Reverse(versions))
+	return []Entry(versions)
+}
+
 // func (ssed *Fs) Dump(filename string) {
 // 	ssed.parseArchive()
 // 	for docName := range ssed.ordering {
@@ -889,35 +1262,21 @@ func (ssed *Fs) GetEntry(documentName, entryName string) (Entry, error) {
 // 	ioutil.WriteFile(filename, bJson, 0644)
 // }
 
-// DumpAll dumps a file with current date and name, encyprted
-func (ssed *Fs) DumpAll() (string, error) {
+// DumpAll dumps a file with current date and name, encyprted. The entries of
+// shared documents and unlocked vaults are in it, and what is left out is
+// returned so that it can be told to the user.
+func (ssed *Fs) DumpAll() (string, []string, error) {
 	filename := ssed.username + "-" + time.Now().Format("2006-01-02") + ".bol"
-	files, _ := filepath.Glob(path.Join(ssed.pathToLocalRepo, "*"))
-
-	ssed.entries = make(map[string]Entry)
-	var entriesToSortByModified = make(map[string]Entry)
-	for _, file := range files {
-		logger.Debug("Parsing %s", file)
-		decrypted, err := utils.DecryptFromFile(ssed.password, file)
-		if err != nil {
-			panic(err)
-		}
-		var e Entry
-		err = json.Unmarshal(decrypted, &e)
-		if err != nil {
-			panic(err)
-		}
-		e.uuid = filepath.Base(file)
-		e.datetime, _ = utils.ParseDate(e.Timestamp)
-		if len(e.ModifiedTimestamp) > 0 {
-			e.datetime, _ = utils.ParseDate(e.ModifiedTimestamp) // sort by modified date
-		}
-		ssed.entries[e.uuid] = e
-		entriesToSortByModified[e.uuid] = e
+	if !ssed.parsed {
+		ssed.parseArchive()
 	}
-	sortedEntries := make(timeSlice, 0, len(entriesToSortByModified))
-	for _, d := range entriesToSortByModified {
-		sortedEntries = append(sortedEntries, d)
+	sortedEntries := make(timeSlice, 0, len(ssed.entries))
+	attachments := make(map[string]struct{})
+	for _, e := range ssed.entries {
+		sortedEntries = append(sortedEntries, e)
+		for _, attachment := range e.Attachments {
+			attachments[attachment.ID] = struct{}{}
+		}
 	}
 	sort.Sort(sortedEntries)
 
@@ -939,9 +1298,28 @@ func (ssed *Fs) DumpAll() (string, error) {
 		documentList[i] = documentMap[doc]
 		i++
 	}
-	bJSON, _ := json.MarshalIndent(documentList, "", " ")
-	utils.EncryptToFile(bJSON, ssed.password, filename)
-	return filename, nil
+	bJSON, err := json.MarshalIndent(documentList, "", " ")
+	if err != nil {
+		return "", nil, err
+	}
+	if err = utils.EncryptToFile(bJSON, ssed.password, filename); err != nil {
+		return "", nil, err
+	}
+
+	leftOut := []string{}
+	if len(attachments) > 0 {
+		leftOut = append(leftOut, fmt.Sprintf("%d attachments, save them with bol -extract", len(attachments)))
+	}
+	locked := 0
+	for _, v := range ssed.vaults {
+		if len(v.key) == 0 {
+			locked++
+		}
+	}
+	if locked > 0 {
+		leftOut = append(leftOut, fmt.Sprintf("the documents of %d locked vaults, open them first", locked))
+	}
+	return filename, leftOut, nil
 }
 
 // Import imports an unencrypted bol file
@@ -1,14 +1,23 @@
 package ssed
 
 import (
+	"bytes"
+	crand "crypto/rand"
+	"encoding/hex"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	homedir "github.com/mitchellh/go-homedir"
 	"github.com/schollz/bol/utils"
+	"golang.org/x/crypto/nacl/box"
 )
 
 func init() {
@@ -138,7 +147,10 @@ notes 2016-11-23 13:00:00 some text3, edited
 		t.Errorf("Problem loading single entry: '%s'", text)
 	}
 
-	filename, _ := fs.DumpAll()
+	filename, leftOut, err := fs.DumpAll()
+	if err != nil || len(leftOut) > 0 {
+		t.Errorf("Problem dumping: %v %v", err, leftOut)
+	}
 	if !utils.Exists(filename) {
 		t.Errorf("%s not created", filename)
 	} else {
@@ -205,3 +217,1192 @@ func TestServer(t *testing.T) {
 		t.Errorf("md5 should be false it was deleted remotely")
 	}
 }
+
+func TestRestore(t *testing.T) {
+	EraseAll()
+	var fs Fs
+	fs.Init("test", "http://localhost:9095")
+	fs.Open("test")
+	// the server keeps the entries of earlier runs
+	name := utils.RandStringBytesMaskImprSrc(6)
+	fs.Update("one", "notes", name, "2016-11-20 13:00:00")
+	// versions are ordered by the second they were made
+	time.Sleep(time.Second)
+	fs.Update("two", "notes", name, "")
+	time.Sleep(time.Second)
+	fs.DeleteEntry("notes", name)
+	time.Sleep(time.Second)
+	files, _ := filepath.Glob(path.Join(fs.pathToLocalRepo, "*.json"))
+	fs.Update("one", "notes", name, "2016-11-20 13:00:00")
+	if restored, _ := filepath.Glob(path.Join(fs.pathToLocalRepo, "*.json")); len(restored) != len(files)+1 {
+		t.Errorf("Going back to the first text should write a new version, got %d files after %d", len(restored), len(files))
+	}
+	if e, err := fs.GetEntry("notes", name); err != nil || e.Text != "one" {
+		t.Errorf("Problem restoring the entry: %+v %v", e, err)
+	}
+	if err := fs.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// another device gets the restored entry
+	os.RemoveAll(pathToLocalFolder)
+	fs.Init("test", "http://localhost:9095")
+	fs.Open("test")
+	if e, err := fs.GetEntry("notes", name); err != nil || e.Text != "one" {
+		t.Errorf("The restore was not synced: %+v %v", e, err)
+	}
+	if versions := fs.GetHistory("notes", name); len(versions) != 4 {
+		t.Errorf("Expected 4 versions, got %d", len(versions))
+	}
+	fs.Close()
+}
+
+func TestQuota(t *testing.T) {
+	EraseAll()
+	var fs Fs
+	fs.Init("test", "http://localhost:9095")
+	fs.Open("test")
+	fs.Update("some text", "notes", "", "")
+	if err := fs.Close(); err != nil {
+		t.Errorf("Could not upload: %s", err.Error())
+	}
+	used, quota := fs.Quota()
+	if quota <= 0 || used <= 0 || used > quota {
+		t.Errorf("Got quota %d, used %d", quota, used)
+	}
+
+	fs.quota = 1
+	if err := fs.checkQuota(); err != ErrQuotaExceeded {
+		t.Errorf("Should not push a repository over the quota: %v", err)
+	}
+	fs.quota = used * 100
+	if err := fs.checkQuota(); err != nil {
+		t.Errorf("Should push a repository under the quota: %s", err.Error())
+	}
+
+	// shared documents take up part of the quota
+	utils.CreateBolUser("test2", "test2", "http://localhost:9095")
+	var fs2 Fs
+	fs2.Init("test2", "http://localhost:9095")
+	fs2.Open("test2")
+	fs2.Close()
+	fs.Init("test", "http://localhost:9095")
+	fs.Open("test")
+	shared := "quota" + utils.RandStringBytesMaskImprSrc(6)
+	fs.Update("some shared text", shared, "", "")
+	if err := fs.Share(shared, "test2", false); err != nil {
+		t.Fatal(err)
+	}
+	fs.Close()
+	fs.doesMD5MatchServer()
+	if fs.quotaShares <= 0 || fs.quotaShares >= fs.quotaUsed {
+		t.Fatalf("Got %d used by shares, %d in all", fs.quotaShares, fs.quotaUsed)
+	}
+	fi, _ := os.Stat(path.Join(pathToLocalFolder, fs.archiveName))
+	fs.quota = fi.Size() + fs.quotaShares - 1
+	if err := fs.checkQuota(); err != ErrQuotaExceeded {
+		t.Errorf("Should not push a repository over what the shares leave of the quota: %v", err)
+	}
+	fs.quota = fi.Size() + fs.quotaShares
+	if err := fs.checkQuota(); err != nil {
+		t.Errorf("Should push a repository that fits next to the shares: %v", err)
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	username := "legacy" + utils.RandStringBytesMaskImprSrc(6)
+	request := func(password string) int {
+		req, _ := http.NewRequest("GET", "http://localhost:9095/shares", nil)
+		req.SetBasicAuth(username, password)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	// older clients gave the server the password
+	req, _ := http.NewRequest("PUT", "http://localhost:9095/repo", nil)
+	req.SetBasicAuth(username, "password")
+	if _, err := http.DefaultClient.Do(req); err != nil {
+		t.Fatal(err)
+	}
+	if request("password") != http.StatusOK {
+		t.Errorf("Older client can not use its password")
+	}
+
+	// the first time the repository is opened, the password is replaced
+	EraseAll()
+	fs := new(Fs)
+	fs.Init(username, "http://localhost:9095")
+	if err := fs.Open("password"); err != nil {
+		t.Fatal(err)
+	}
+	if fs.legacyAuth {
+		t.Errorf("Authentication was not upgraded")
+	}
+	if request("password") != http.StatusUnauthorized {
+		t.Errorf("Server still accepts the password")
+	}
+	if request(utils.AuthKey(username, "password")) != http.StatusOK {
+		t.Errorf("Server does not accept the authentication key")
+	}
+	fs.Close()
+}
+
+func TestSalt(t *testing.T) {
+	EraseAll()
+	fs := new(Fs)
+	fs.Init("test", "")
+	// a repository from before salts is encrypted with the secret
+	fs.password = "test"
+	fs.writeEntry(Entry{Text: "some text", Document: "notes", Entry: "a"})
+	if _, err := fs.readSaltFile(); err == nil {
+		t.Fatalf("Repository should not have a salt yet")
+	}
+
+	fs = new(Fs)
+	fs.Init("test", "")
+	if err := fs.Open("test"); err != nil {
+		t.Fatal(err)
+	}
+	sf, err := fs.readSaltFile()
+	if err != nil {
+		t.Fatalf("Repository did not get a salt: %v", err)
+	}
+	if key, _ := sf.key("test"); fs.password != key || fs.keySecret != "test" {
+		t.Errorf("Key is not derived from the secret and the salt")
+	}
+	files, _ := filepath.Glob(path.Join(fs.pathToLocalRepo, "*.json"))
+	for _, file := range files {
+		if _, err = utils.DecryptFromFile("test", file); err == nil {
+			t.Errorf("%s is still encrypted with the secret", file)
+		}
+	}
+	if entry, err := fs.GetEntry("notes", "a"); err != nil || entry.Text != "some text" {
+		t.Errorf("Problem reading the entry after adding the salt: %v", err)
+	}
+	fs.Close()
+
+	fs = new(Fs)
+	fs.Init("test", "")
+	if err = fs.Open("test2"); err == nil {
+		t.Errorf("Opened with the wrong password")
+	}
+	if err = fs.Open("test"); err != nil {
+		t.Errorf("Problem opening with the salt: %v", err)
+	}
+	fs.Close()
+}
+
+func TestEntriesScopedByDocument(t *testing.T) {
+	var fs Fs
+	EraseAll()
+	fs.Init("test", "")
+	fs.Open("test")
+	fs.Update("notes text", "notes", "shared", "2014-11-20T13:00:00-05:00")
+	fs.Update("journal text", "journal", "shared", "2014-11-21T13:00:00-05:00")
+	fs.Update("only in notes", "notes", "unique", "2014-11-22T13:00:00-05:00")
+
+	entry, err := fs.GetEntry("notes", "shared")
+	if err != nil || entry.Text != "notes text" {
+		t.Errorf("Problem getting notes/shared: '%s' %v", entry.Text, err)
+	}
+	entry, err = fs.GetEntry("journal", "shared")
+	if err != nil || entry.Text != "journal text" {
+		t.Errorf("Problem getting journal/shared: '%s' %v", entry.Text, err)
+	}
+
+	entries, isDocument, documentName, err := fs.GetDocumentOrEntry("journal/shared")
+	if err != nil || isDocument || documentName != "journal" || len(entries) != 1 || entries[0].Text != "journal text" {
+		t.Errorf("Problem getting entry by path: %v %v %s %v", entries, isDocument, documentName, err)
+	}
+
+	entries, _, documentName, err = fs.GetDocumentOrEntry("unique")
+	if err != nil || documentName != "notes" || len(entries) != 1 {
+		t.Errorf("Problem getting unique entry by name: %v %s %v", entries, documentName, err)
+	}
+
+	entries, _, _, err = fs.GetDocumentOrEntry("shared")
+	if err != ErrAmbiguousEntry || len(entries) != 2 {
+		t.Errorf("Ambiguous entry name not detected: %v %v", entries, err)
+	}
+
+	fs.DeleteEntry("notes", "shared")
+	if _, err = fs.GetEntry("journal", "shared"); err != nil {
+		t.Errorf("Deleting notes/shared deleted journal/shared")
+	}
+
+	text := fmt.Sprintln(fs.ListEntries())
+	if text != "[journal/shared notes/shared notes/unique]\n" {
+		t.Errorf("Problem listing entries: %s", text)
+	}
+
+	fs.Update("unnamed", "notes", "", "")
+	entries = fs.GetDocument("notes")
+	entryName := entries[len(entries)-1].Entry
+	if len(entryName) != 26 {
+		t.Errorf("Generated entry name is not a ULID: %s", entryName)
+	}
+	newName, _ := fs.NewEntryName()
+	if newName == entryName {
+		t.Errorf("New entry name %s collides with existing entry", newName)
+	}
+	fs.Close()
+}
+
+func TestTags(t *testing.T) {
+	var fs Fs
+	EraseAll()
+	fs.Init("test", "")
+	fs.Open("test")
+	fs.Update("# Heading\nworking on #Project today, see page#anchor", "notes", "a", "2014-11-20T13:00:00-05:00")
+	fs.Update("more on #project and #bol-2", "journal", "b", "2014-11-19T13:00:00-05:00")
+	fs.Update("#bol-2 was dropped", "journal", "c", "2014-11-21T13:00:00-05:00")
+	fs.DeleteEntry("journal", "c")
+
+	text := fmt.Sprintln(fs.ListTags())
+	if text != "[bol-2 project]\n" {
+		t.Errorf("Problem listing tags: %s", text)
+	}
+
+	text = ""
+	for _, entry := range fs.EntriesWithTag("#project") {
+		text += fmt.Sprintln(entry.Document, entry.Entry)
+	}
+	if text != "journal b\nnotes a\n" {
+		t.Errorf("Problem getting entries with tag: '%s'", text)
+	}
+	if len(fs.EntriesWithTag("heading")) != 0 || len(fs.EntriesWithTag("anchor")) != 0 {
+		t.Errorf("Headings and anchors should not be tags")
+	}
+
+	// explicit tags make a new version that keeps the creation time
+	fs.UpdateEntry(Entry{Text: "more on #project and #bol-2", Document: "journal", Entry: "b", Tags: []string{"#Ideas"}})
+	entry, _ := fs.GetEntry("journal", "b")
+	if fmt.Sprintln(entry.Tags) != "[ideas]\n" || entry.Timestamp != "2014-11-19 13:00:00" {
+		t.Errorf("Problem updating explicit tags: %v", entry)
+	}
+	if len(fs.EntriesWithTag("ideas")) != 1 {
+		t.Errorf("Problem indexing explicit tags: %s", fs.ListTags())
+	}
+	fs.Close()
+}
+
+func TestLinks(t *testing.T) {
+	var fs Fs
+	EraseAll()
+	fs.Init("test", "")
+	fs.Open("test")
+	fs.Update("see [[journal/b]] and [[journal/b]]", "notes", "a", "2014-11-20T13:00:00-05:00")
+	fs.Update("the same as [[b]] and [[notes/a]]", "journal", "c", "2014-11-19T13:00:00-05:00")
+	fs.Update("no links here", "journal", "b", "2014-11-18T13:00:00-05:00")
+
+	text := ""
+	for _, entry := range fs.Backlinks("journal", "b") {
+		text += fmt.Sprintln(entry.Document, entry.Entry)
+	}
+	if text != "journal c\nnotes a\n" {
+		t.Errorf("Problem getting backlinks: '%s'", text)
+	}
+
+	fs.DeleteEntry("journal", "c")
+	if len(fs.Backlinks("notes", "a")) != 0 {
+		t.Errorf("Deleted entries should not be backlinks")
+	}
+
+	text = ReplaceLinks("[[journal/b]] [[c]]", "notes", func(document, entry, link string) string {
+		return document + ":" + entry + ":" + link
+	})
+	if text != "journal:b:journal/b notes:c:c" {
+		t.Errorf("Problem replacing links: '%s'", text)
+	}
+	fs.Close()
+}
+
+func TestAttachments(t *testing.T) {
+	var fs Fs
+	EraseAll()
+	fs.Init("test", "")
+	fs.Open("test")
+	fs.Update("receipt for lunch", "notes", "lunch", "2014-11-20T13:00:00-05:00")
+
+	ioutil.WriteFile("receipt.txt", []byte("total: $12"), 0644)
+	defer os.Remove("receipt.txt")
+	attachment, err := fs.Attach("notes", "lunch", "receipt.txt")
+	if err != nil {
+		t.Errorf("Problem attaching: %s", err.Error())
+	}
+	if attachment.Name != "receipt.txt" || attachment.Size != 10 || !strings.HasPrefix(attachment.ContentType, "text/plain") {
+		t.Errorf("Problem with attachment info: %+v", attachment)
+	}
+
+	// editing the text keeps the attachment
+	fs.Update("receipt for lunch, paid", "notes", "lunch", "2014-11-20T13:00:00-05:00")
+	entry, _ := fs.GetEntry("notes", "lunch")
+	if len(entry.Attachments) != 1 || entry.Attachments[0].ID != attachment.ID {
+		t.Errorf("Attachment lost after editing: %+v", entry)
+	}
+	data, err := fs.ReadAttachment(entry.Attachments[0])
+	if err != nil || string(data) != "total: $12" {
+		t.Errorf("Problem reading attachment: '%s' %v", data, err)
+	}
+	encrypted, _ := ioutil.ReadFile(fs.attachmentPath(attachment))
+	if strings.Contains(string(encrypted), "total") {
+		t.Errorf("Attachment is not encrypted")
+	}
+
+	folder, _ := ioutil.TempDir("", "ssed")
+	defer os.RemoveAll(folder)
+	fileNames, err := fs.Extract("notes", "lunch", folder)
+	if err != nil || len(fileNames) != 1 {
+		t.Errorf("Problem extracting: %v %v", fileNames, err)
+	} else if data, _ := ioutil.ReadFile(fileNames[0]); string(data) != "total: $12" {
+		t.Errorf("Extracted attachment is wrong: '%s'", data)
+	}
+
+	// names from other devices or shared documents stay in the folder
+	sub := path.Join(folder, "sub")
+	os.Mkdir(sub, 0755)
+	extract := func(i int, name string) ([]string, error) {
+		bad := attachment
+		bad.Name = name
+		entryName := fmt.Sprintf("bad%d", i)
+		fs.writeEntry(Entry{Text: "bad name", Document: "notes", Entry: entryName, Attachments: []Attachment{bad}})
+		return fs.Extract("notes", entryName, sub)
+	}
+	for i, name := range []string{"../escape.txt", "/tmp/../../escape.txt"} {
+		fileNames, err = extract(i, name)
+		if err != nil || len(fileNames) != 1 || path.Dir(fileNames[0]) != sub {
+			t.Errorf("Attachment named '%s' was extracted to %v: %v", name, fileNames, err)
+		}
+		if utils.Exists(path.Join(folder, "escape.txt")) {
+			t.Errorf("Attachment named '%s' was extracted out of the folder", name)
+		}
+	}
+	for i, name := range []string{"", ".", ".."} {
+		if _, err = extract(10+i, name); err == nil {
+			t.Errorf("Attachment named '%s' should not be extracted", name)
+		}
+	}
+	fs.Close()
+}
+
+func TestTempFolder(t *testing.T) {
+	folder, err := TempFolder()
+	if err != nil {
+		t.Errorf("Problem creating temp folder: %s", err.Error())
+	}
+	info, err := os.Stat(folder)
+	if err != nil || info.Mode().Perm() != 0700 {
+		t.Errorf("Temp folder %s should only be accessible by the user", folder)
+	}
+	if strings.HasPrefix(folder, pathToCacheFolder) {
+		t.Errorf("Temp folder %s should not be in the cache", folder)
+	}
+	again, _ := TempFolder()
+	if again != folder {
+		t.Errorf("Temp folder changed from %s to %s", folder, again)
+	}
+
+	ioutil.WriteFile(path.Join(folder, "temp"), []byte("decrypted"), 0600)
+	ioutil.WriteFile(path.Join(folder, ".vimrc"), []byte("set nocompatible"), 0600)
+	CleanUp()
+	if utils.Exists(folder) {
+		t.Errorf("Temp folder %s not removed", folder)
+	}
+
+	// without an in-memory folder, the disk is only used when allowed
+	defer func(runtimeDir, shm string) {
+		os.Setenv("XDG_RUNTIME_DIR", runtimeDir)
+		sharedMemoryFolder = shm
+		diskTempFolder = false
+		CleanUp()
+	}(os.Getenv("XDG_RUNTIME_DIR"), sharedMemoryFolder)
+	os.Setenv("XDG_RUNTIME_DIR", "")
+	sharedMemoryFolder = path.Join(pathToCacheFolder, "does-not-exist")
+	if _, err = TempFolder(); err != ErrNoMemoryFolder {
+		t.Errorf("Used a folder on disk without being allowed: %v", err)
+	}
+	AllowDiskTempFolder()
+	if folder, err = TempFolder(); err != nil || !strings.HasPrefix(folder, os.TempDir()) {
+		t.Errorf("Problem using the system temp folder: %s %v", folder, err)
+	}
+}
+
+func TestAgent(t *testing.T) {
+	runtimeDir, _ := ioutil.TempDir("", "agent")
+	defer os.RemoveAll(runtimeDir)
+	defer os.Setenv("XDG_RUNTIME_DIR", os.Getenv("XDG_RUNTIME_DIR"))
+	os.Setenv("XDG_RUNTIME_DIR", runtimeDir)
+	UseAgent()
+	defer func() { useAgent = false }()
+
+	var fs Fs
+	EraseAll()
+	fs.Init("test", "")
+	if err := fs.Open(""); err != ErrNoAgent {
+		t.Errorf("Opened without a password or agent: %v", err)
+	}
+
+	stopped := make(chan error)
+	go func() { stopped <- RunAgent(time.Minute) }()
+	for i := 0; i < 50 && !AgentRunning(); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	info, err := os.Stat(AgentSocket())
+	if err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("Agent socket should only be accessible by the user")
+	}
+	info, err = os.Stat(filepath.Dir(AgentSocket()))
+	if err != nil || info.Mode().Perm() != 0700 {
+		t.Errorf("The folder of the agent socket should only be accessible by the user")
+	}
+	fs.Open("test")
+	fs.Update("some text", "notes", "a", "")
+	fs.Close()
+
+	var fs2 Fs
+	fs2.Init("test", "")
+	if err := fs2.Open(""); err != nil {
+		t.Errorf("Could not open with the agent: %s", err.Error())
+	}
+	if _, err := fs2.GetEntry("notes", "a"); err != nil {
+		t.Errorf("Problem reading with the keys of the agent: %s", err.Error())
+	}
+	// the agent only has the keys that are derived from the password
+	response, err := askAgent(agentRequest{Command: "get", Username: "test"})
+	if err != nil || string(response.Key) != fs.password || string(response.AuthKey) != fs.authKey || fs.password == "test" {
+		t.Errorf("Agent should keep the keys of the repository: %v", err)
+	}
+	if fs2.authKey != fs.authKey || len(fs2.typedPassword) > 0 || len(fs2.keySecret) > 0 {
+		t.Errorf("Agent gave more than the keys")
+	}
+	if err = fs2.SetPinFromPassword("1234", 0); err == nil {
+		t.Errorf("Set a PIN without the password")
+	}
+
+	StopAgent()
+	if err := <-stopped; err != nil {
+		t.Error(err)
+	}
+	if AgentRunning() {
+		t.Errorf("Agent did not stop")
+	}
+
+	// the agent also stops when it is not used
+	go func() { stopped <- RunAgent(50 * time.Millisecond) }()
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Errorf("Agent did not stop after the idle timeout")
+	}
+}
+
+func TestPin(t *testing.T) {
+	defer func(d time.Duration) { pinHashDuration = d }(pinHashDuration)
+	pinHashDuration = time.Millisecond
+	var fs Fs
+	EraseAll()
+	fs.Init("test", "")
+	fs.Open("test")
+	if err := fs.SetPinFromPassword("1234", 2); err != nil {
+		t.Error(err)
+	}
+	if password, err := fs.GetPasswordFromPin("1234"); err != nil || password != "test" {
+		t.Errorf("Problem getting password from pin: %v", err)
+	}
+	if _, err := fs.GetPasswordFromPin("0000"); err == nil || !strings.Contains(err.Error(), "1 attempts left") {
+		t.Errorf("Wrong pin should leave 1 attempt: %v", err)
+	}
+	if _, err := fs.GetPasswordFromPin("1234"); err == nil || !strings.Contains(err.Error(), "try again") {
+		t.Errorf("Pin should wait after a wrong attempt: %v", err)
+	}
+	pf, _ := fs.readPinFile()
+	pf.NextAttempt = time.Now()
+	fs.writePinFile(pf)
+	if _, err := fs.GetPasswordFromPin("0000"); err == nil || fs.HasPinFile() {
+		t.Errorf("Pin should be removed after the last attempt: %v", err)
+	}
+
+	fs.SetPinFromPassword("1234", 0)
+	fs.SetPinFromPassword("5678", 0)
+	devices, err := fs.ListDevices()
+	if err != nil || len(devices) != 3 || !devices[2].Current || devices[0].Current {
+		t.Errorf("Problem listing devices: %v %v", devices, err)
+	}
+	fs.RevokeDevice(devices[0].ID)
+	if !fs.HasPinFile() {
+		t.Errorf("Revoking another device removed the pin of this device")
+	}
+	if err = fs.RevokeDevice("nothing"); err == nil {
+		t.Errorf("Revoked a device that does not exist")
+	}
+
+	// revoked on another device
+	ioutil.WriteFile(path.Join(fs.pathToLocalRepo, devices[2].ID+revokedExtension), []byte{}, 0644)
+	if _, err := fs.GetPasswordFromPin("5678"); err != ErrDeviceRevoked || fs.HasPinFile() {
+		t.Errorf("Revoked device could still use its pin: %v", err)
+	}
+	devices, _ = fs.ListDevices()
+	if !devices[0].Revoked || devices[1].Revoked || !devices[2].Revoked {
+		t.Errorf("Problem listing revoked devices: %v", devices)
+	}
+
+	// the password of the PIN no longer opens the repository
+	fs.SetPinFromPassword("1234", 0)
+	if err := fs.RemovePin(); err != nil || fs.HasPinFile() {
+		t.Errorf("Problem removing the PIN: %v", err)
+	}
+	devices, _ = fs.ListDevices()
+	if len(devices) != 4 || !devices[3].Revoked {
+		t.Errorf("Device with a removed PIN should be revoked: %v", devices)
+	}
+
+	for failures, wait := range map[int]time.Duration{1: 5 * time.Second, 2: 10 * time.Second, 5: 80 * time.Second, 40: maxPinBackoff, 1000: maxPinBackoff} {
+		if pinBackoff(failures) != wait {
+			t.Errorf("Wait after %d wrong PINs should be %s, not %s", failures, wait, pinBackoff(failures))
+		}
+	}
+}
+
+func TestKeyFile(t *testing.T) {
+	folder, _ := ioutil.TempDir("", "keyfile")
+	defer os.RemoveAll(folder)
+	keyFile := path.Join(folder, "bol.key")
+	ioutil.WriteFile(keyFile, []byte(utils.RandStringBytesMaskImprSrc(64)), 0600)
+	otherKeyFile := path.Join(folder, "other.key")
+	ioutil.WriteFile(otherKeyFile, []byte(utils.RandStringBytesMaskImprSrc(64)), 0600)
+	ioutil.WriteFile(path.Join(folder, "short.key"), []byte("short"), 0600)
+
+	open := func(password, keyFile string) (*Fs, error) {
+		fs := new(Fs)
+		fs.Init("test", "")
+		if len(keyFile) > 0 {
+			if err := fs.UseKeyFile(keyFile); err != nil {
+				return fs, err
+			}
+		}
+		return fs, fs.Open(password)
+	}
+
+	// password and key file
+	EraseAll()
+	fs, err := open("test", keyFile)
+	if err != nil {
+		t.Error(err)
+	}
+	fs.Update("some text", "notes", "a", "")
+	fs.Close()
+	if _, err = open("test", ""); err != ErrNeedKeyFile {
+		t.Errorf("Opened without the key file: %v", err)
+	}
+	if _, err = open("", keyFile); err != ErrNeedPassword {
+		t.Errorf("Opened without the password: %v", err)
+	}
+	if _, err = open("test", otherKeyFile); err == nil {
+		t.Errorf("Opened with the wrong key file")
+	}
+	if _, err = open("test", path.Join(folder, "short.key")); err == nil {
+		t.Errorf("Used a key file that is too short")
+	}
+	fs, err = open("test", keyFile)
+	if _, errEntry := fs.GetEntry("notes", "a"); err != nil || errEntry != nil {
+		t.Errorf("Problem opening with password and key file: %v %v", err, errEntry)
+	}
+
+	// key file alone
+	EraseAll()
+	fs, err = open("", keyFile)
+	if err != nil {
+		t.Error(err)
+	}
+	fs.Update("some text", "notes", "a", "")
+	fs.Close()
+	if _, err = open("test", keyFile); err != ErrKeyFileOnly {
+		t.Errorf("Opened a key file repository with a password: %v", err)
+	}
+	fs, err = open("", keyFile)
+	if _, errEntry := fs.GetEntry("notes", "a"); err != nil || errEntry != nil {
+		t.Errorf("Problem opening with the key file alone: %v %v", err, errEntry)
+	}
+
+	// password alone
+	EraseAll()
+	fs, _ = open("test", "")
+	fs.Update("some text", "notes", "a", "")
+	fs.Close()
+	if _, err = open("test", keyFile); err != ErrUnusedKeyFile {
+		t.Errorf("Opened a password repository with a key file: %v", err)
+	}
+}
+
+func TestShamir(t *testing.T) {
+	secret := []byte("the key of the repository")
+	shares, err := splitSecret(secret, 3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 5; i++ {
+		for j := i + 1; j < 5; j++ {
+			for k := j + 1; k < 5; k++ {
+				combined, err := combineShares([][]byte{shares[k], shares[i], shares[j]})
+				if err != nil || string(combined) != string(secret) {
+					t.Errorf("Shares %d, %d and %d give '%s': %v", i, j, k, combined, err)
+				}
+			}
+		}
+	}
+	if combined, _ := combineShares(shares[:2]); string(combined) == string(secret) {
+		t.Errorf("Two shares gave the secret")
+	}
+	if _, err = combineShares([][]byte{shares[0], shares[0], shares[1]}); err == nil {
+		t.Errorf("Combined the same share twice")
+	}
+	if _, err = splitSecret(secret, 4, 3); err == nil {
+		t.Errorf("Split with a quorum larger than the number of shares")
+	}
+}
+
+func TestRecovery(t *testing.T) {
+	defer func(d time.Duration) { pinHashDuration = d }(pinHashDuration)
+	pinHashDuration = time.Millisecond
+	fs := new(Fs)
+	EraseAll()
+	fs.Init("test", "")
+	fs.Open("test")
+	if _, err := fs.RecoveryQuorum(); err != ErrNoRecoveryCodes {
+		t.Errorf("Found recovery codes in a new repository: %v", err)
+	}
+	fs.Update("some text", "notes", "a", "")
+	ioutil.WriteFile("receipt.txt", []byte("total: $12"), 0644)
+	defer os.Remove("receipt.txt")
+	attachment, _ := fs.Attach("notes", "a", "receipt.txt")
+	fs.SetPinFromPassword("1234", 0)
+	codes, err := fs.NewRecoveryCodes(2, 3)
+	if err != nil || len(codes) != 3 {
+		t.Fatalf("Problem making recovery codes: %v %v", codes, err)
+	}
+	fs.Close()
+	oldFiles, _ := ioutil.TempDir("", "recovery")
+	defer os.RemoveAll(oldFiles)
+	copyFolder(fs.pathToLocalRepo, oldFiles)
+
+	fs = new(Fs)
+	fs.Init("test", "")
+	if quorum, err := fs.RecoveryQuorum(); quorum != 2 || err != nil {
+		t.Errorf("Quorum should be 2: %d %v", quorum, err)
+	}
+	if err = fs.OpenWithRecoveryCodes([]string{codes[0]}); err == nil {
+		t.Errorf("Opened with fewer codes than the quorum")
+	}
+	if err = fs.OpenWithRecoveryCodes([]string{codes[0], codes[0]}); err == nil {
+		t.Errorf("Opened with the same code twice")
+	}
+	if err = fs.OpenWithRecoveryCodes([]string{codes[0], "AAAA-BBBB"}); err == nil {
+		t.Errorf("Opened with a code that does not exist")
+	}
+	if err = fs.OpenWithRecoveryCodes([]string{codes[1], strings.ToLower(strings.Replace(codes[2], "-", " ", -1))}); err != nil {
+		t.Fatalf("Problem opening with recovery codes: %v", err)
+	}
+	if err = fs.ChangePassword(""); err == nil {
+		t.Errorf("Changed to an empty password")
+	}
+	if err = fs.ChangePassword("new"); err != nil {
+		t.Fatal(err)
+	}
+	if fs.HasPinFile() {
+		t.Errorf("Pin of the old password was kept")
+	}
+	fs.Close()
+
+	fs = new(Fs)
+	fs.Init("test", "")
+	if err = fs.Open("test"); err == nil {
+		t.Errorf("Opened with the old password")
+	}
+	if err = fs.Open("new"); err != nil {
+		t.Fatal(err)
+	}
+	entry, err := fs.GetEntry("notes", "a")
+	if err != nil || entry.Text != "some text" {
+		t.Errorf("Problem reading the entry after changing the password: %v", err)
+	}
+	if data, err := fs.ReadAttachment(attachment); err != nil || string(data) != "total: $12" {
+		t.Errorf("Problem reading the attachment after changing the password: %v", err)
+	}
+	devices, err := fs.ListDevices()
+	if err != nil || len(devices) != 1 || !devices[0].Revoked {
+		t.Errorf("Device with a pin should be revoked: %v %v", devices, err)
+	}
+	fs2 := new(Fs)
+	fs2.Init("test", "")
+	if err = fs2.OpenWithRecoveryCodes(codes[:2]); err != nil || fs2.keySecret != "new" || fs2.password != fs.password {
+		t.Errorf("Recovery codes should open with the new password: %v", err)
+	}
+
+	// another device has the files of before the password change
+	newFiles, _ := ioutil.TempDir("", "recovery")
+	defer os.RemoveAll(newFiles)
+	copyFolder(fs.pathToLocalRepo, newFiles)
+	os.RemoveAll(fs.pathToLocalRepo)
+	copyFolder(oldFiles, fs.pathToLocalRepo)
+	ioutil.WriteFile(path.Join(fs.pathToLocalRepo, "old.json"), []byte("encrypted with the old password"), 0644)
+	os.RemoveAll(fs.pathToRemoteRepo)
+	copyFolder(newFiles, fs.pathToRemoteRepo)
+	fs.copyOverFiles()
+	if utils.Exists(path.Join(fs.pathToLocalRepo, "old.json")) {
+		t.Errorf("File encrypted with the old password was kept")
+	}
+	fs.parsed = false
+	if err = fs.Open("new"); err != nil {
+		t.Errorf("Problem opening after the password was changed on another device: %v", err)
+	}
+	if entry, err = fs.GetEntry("notes", "a"); err != nil {
+		t.Errorf("Problem reading the entry after the password was changed on another device: %v", err)
+	}
+}
+
+func copyFolder(src, dst string) {
+	os.MkdirAll(dst, 0755)
+	files, _ := filepath.Glob(path.Join(src, "*"))
+	for _, file := range files {
+		utils.CopyFile(file, path.Join(dst, filepath.Base(file)))
+	}
+}
+
+func TestShare(t *testing.T) {
+	utils.CreateBolUser("test2", "test2", "http://localhost:9095")
+	runbook := "runbook" + utils.RandStringBytesMaskImprSrc(6)
+	EraseAll()
+	open := func(username string) *Fs {
+		fs := new(Fs)
+		fs.Init(username, "http://localhost:9095")
+		if err := fs.Open(username); err != nil {
+			t.Fatal(err)
+		}
+		return fs
+	}
+
+	// test2 publishes its public key
+	fs2 := open("test2")
+	fs2.Close()
+
+	fs := open("test")
+	fs.Update("restart the server", runbook, "restart", "2014-11-20T13:00:00-05:00")
+	fs.Update("check the logs", runbook, "logs", "2014-11-20T13:00:00-05:00")
+	ioutil.WriteFile("notes.txt", []byte("tail -f"), 0644)
+	defer os.Remove("notes.txt")
+	attachment, _ := fs.Attach(runbook, "logs", "notes.txt")
+	if err := fs.Share(runbook, "nobody", false); err == nil {
+		t.Errorf("Shared with a user without a public key")
+	}
+	if err := fs.Share("nothing", "test2", false); err == nil {
+		t.Errorf("Shared a document that does not exist")
+	}
+	if err := fs.Share(runbook, "test2", false); err != nil {
+		t.Fatal(err)
+	}
+	if entries := fs.GetDocument(runbook); len(entries) != 2 {
+		t.Errorf("Owner should see the shared document once: %v", entries)
+	}
+	fs.Update("restart the server with systemctl", runbook, "restart", "")
+	fs.Close()
+
+	// read-only
+	fs2 = open("test2")
+	shared := runbook + "@test"
+	entry, err := fs2.GetEntry(shared, "restart")
+	if err != nil || entry.Text != "restart the server with systemctl" || entry.Document != shared {
+		t.Errorf("Problem reading the shared document: %+v %v", entry, err)
+	}
+	if data, err := fs2.ReadAttachment(attachment); err != nil || string(data) != "tail -f" {
+		t.Errorf("Problem reading the attachment of the shared document: %v", err)
+	}
+	if err = fs2.UpdateEntry(Entry{Text: "rm -rf", Document: shared, Entry: "restart"}); err != ErrReadOnly {
+		t.Errorf("Changed a read-only document: %v", err)
+	}
+	if err = fs2.Share(shared, "test", true); err == nil {
+		t.Errorf("Shared a document of another user")
+	}
+	fs2.Close()
+
+	// read-write
+	fs = open("test")
+	if err = fs.Share(runbook, "test2", true); err != nil {
+		t.Fatal(err)
+	}
+	fs.Close()
+	fs2 = open("test2")
+	// versions are ordered by the second they were made
+	time.Sleep(time.Second)
+	if err = fs2.UpdateEntry(Entry{Text: "check the logs in /var/log", Document: shared, Entry: "logs"}); err != nil {
+		t.Errorf("Problem changing a read-write document: %v", err)
+	}
+	fs2.Close()
+	fs = open("test")
+	if entry, err = fs.GetEntry(runbook, "logs"); err != nil || entry.Text != "check the logs in /var/log" || len(entry.Attachments) != 1 {
+		t.Errorf("Owner does not see the change to the shared document: %+v %v", entry, err)
+	}
+	fs.Close()
+
+	// a writer that did not get the latest version merges it before uploading
+	fs = open("test")
+	folder := fs.shares[runbook].folder()
+	before := make(map[string]bool)
+	files, _ := filepath.Glob(path.Join(folder, "*"))
+	for _, file := range files {
+		before[file] = true
+	}
+	downloaded, _ := ioutil.ReadFile(folder + ".md5")
+	fs2 = open("test2")
+	if err = fs2.UpdateEntry(Entry{Text: "written by test2", Document: shared, Entry: "test2"}); err != nil {
+		t.Fatal(err)
+	}
+	fs2.Close()
+	// as if test was on another device, which has not downloaded it
+	files, _ = filepath.Glob(path.Join(folder, "*"))
+	for _, file := range files {
+		if !before[file] {
+			os.Remove(file)
+		}
+	}
+	ioutil.WriteFile(folder+".md5", downloaded, 0644)
+	fs.Update("written by test", runbook, "test", "")
+	if err = fs.Close(); err != nil && err != ErrNoChanges {
+		t.Errorf("Problem uploading after another writer: %v", err)
+	}
+	os.RemoveAll(folder)
+	fs = open("test")
+	for _, name := range []string{"test", "test2"} {
+		if entry, err = fs.GetEntry(runbook, name); err != nil || entry.Text != "written by "+name {
+			t.Errorf("The version written by %s was lost: %+v %v", name, entry, err)
+		}
+	}
+	fs.Close()
+
+	// the key of test2 was pinned, so the server can not swap it
+	fs2 = open("test2")
+	publicKey, _ := fs2.serverRequest("GET", "/key?user=test2", nil)
+	other, _, _ := box.GenerateKey(crand.Reader)
+	fs2.serverRequest("PUT", "/key", strings.NewReader(hex.EncodeToString(other[:])))
+	fs = open("test")
+	if err = fs.Share(runbook, "test2", true); err == nil {
+		t.Errorf("Shared with a key that changed")
+	}
+	fs2.serverRequest("PUT", "/key", bytes.NewReader(publicKey))
+	fs2.Close()
+
+	// a new key is confirmed with its fingerprint
+	member := "test" + utils.RandStringBytesMaskImprSrc(6)
+	utils.CreateBolUser(member, member, "http://localhost:9095")
+	fs3 := open(member)
+	fingerprint3, _ := fs3.Fingerprint()
+	fs3.Close()
+	asked := ""
+	fs.SetKeyPrompt(func(username, fingerprint string, changed bool) bool {
+		asked = fingerprint
+		return false
+	})
+	if err = fs.Share(runbook, member, false); err == nil || asked != fingerprint3 {
+		t.Errorf("Shared with a key that was not trusted: %v, asked for '%s' instead of '%s'", err, asked, fingerprint3)
+	}
+	fs.SetKeyPrompt(func(username, fingerprint string, changed bool) bool { return true })
+	if err = fs.Share(runbook, member, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// unsharing gives the others a new key
+	oldKey := fs.shares[runbook].Key
+	if err = fs.Unshare(runbook, "test2"); err != nil {
+		t.Fatal(err)
+	}
+	if fs.shares[runbook].Key == oldKey {
+		t.Errorf("Unsharing did not change the key of the share")
+	}
+	fs.Close()
+	fs2 = open("test2")
+	if _, err = fs2.GetEntry(shared, "restart"); err == nil {
+		t.Errorf("Unshared document is still there")
+	}
+	fs2.Close()
+	fs3 = open(member)
+	if entry, err = fs3.GetEntry(shared, "logs"); err != nil || entry.Text != "check the logs in /var/log" {
+		t.Errorf("Problem reading the shared document with its new key: %+v %v", entry, err)
+	}
+	fs3.Close()
+}
+
+func TestVault(t *testing.T) {
+	defer func(d time.Duration) { pinHashDuration = d }(pinHashDuration)
+	pinHashDuration = time.Millisecond
+	finance := "finance" + utils.RandStringBytesMaskImprSrc(6)
+	EraseAll()
+	open := func() *Fs {
+		fs := new(Fs)
+		fs.Init("test", "http://localhost:9095")
+		if err := fs.Open("test"); err != nil {
+			t.Fatal(err)
+		}
+		return fs
+	}
+
+	fs := open()
+	fs.Update("salary", finance, "income", "2014-11-20T13:00:00-05:00")
+	fs.Update("groceries", "journal", "shopping", "2014-11-20T13:00:00-05:00")
+	ioutil.WriteFile("statement.txt", []byte("1000"), 0644)
+	defer os.Remove("statement.txt")
+	attachment, _ := fs.Attach(finance, "income", "statement.txt")
+	// another device has the versions from before the vault
+	device := make(map[string][]byte)
+	files, _ := filepath.Glob(path.Join(fs.pathToLocalRepo, "*"))
+	for _, file := range files {
+		device[filepath.Base(file)], _ = ioutil.ReadFile(file)
+	}
+	fs.GetDocument(finance)
+	copies := []string{filepath.Base(fs.attachmentPath(attachment))}
+	for uuid, e := range fs.entries {
+		if e.Document == finance {
+			copies = append(copies, uuid)
+		}
+	}
+	if err := fs.NewVault(finance, ""); err == nil {
+		t.Errorf("Made a vault without a password")
+	}
+	if err := fs.NewVault(finance, "vault"); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.NewVault(finance, "vault"); err == nil {
+		t.Errorf("Made a second vault of the same document")
+	}
+	fs.Update("rent", finance, "expenses", "2014-11-21T13:00:00-05:00")
+	if entries := fs.GetDocument(finance); len(entries) != 2 {
+		t.Errorf("Problem writing to the vault: %v", entries)
+	}
+	if err := fs.PurgeOlderArchives(); err == nil {
+		t.Errorf("Removed the older archives before the vault was uploaded")
+	}
+	fs.Close()
+	if err := fs.PurgeOlderArchives(); err != nil {
+		t.Errorf("Problem removing the older archives on the server: %v", err)
+	}
+
+	// on another device, the vault is locked and the versions from before
+	// are removed
+	EraseAll()
+	fs = new(Fs)
+	fs.Init("test", "http://localhost:9095")
+	for name, data := range device {
+		ioutil.WriteFile(path.Join(fs.pathToLocalRepo, name), data, 0644)
+	}
+	if err := fs.Open("test"); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range copies {
+		if utils.Exists(path.Join(fs.pathToLocalRepo, name)) {
+			t.Errorf("%s is still there after it was put in a vault", name)
+		}
+	}
+	for _, document := range fs.ListDocuments() {
+		if document == finance {
+			t.Errorf("Locked vault is listed")
+		}
+	}
+	if entries := fs.GetDocument(finance); len(entries) != 0 {
+		t.Errorf("Read a locked vault: %v", entries)
+	}
+	if err := fs.UpdateEntry(Entry{Text: "taxes", Document: finance, Entry: "expenses"}); err != ErrVaultLocked {
+		t.Errorf("Changed a locked vault: %v", err)
+	}
+	if err := fs.UnlockVault("journal", "vault"); err != ErrNoVault {
+		t.Errorf("Unlocked a document that is not in a vault: %v", err)
+	}
+	if err := fs.UnlockVault(finance, "wrong"); err == nil {
+		t.Errorf("Unlocked a vault with the wrong password")
+	}
+	// the dump says what it leaves out
+	dump := func() (string, []string) {
+		filename, leftOut, err := fs.DumpAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.Remove(filename)
+		decrypted, err := utils.DecryptFromFile(fs.password, filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(decrypted), leftOut
+	}
+	if contents, leftOut := dump(); strings.Contains(contents, "salary") || !strings.Contains(fmt.Sprint(leftOut), "locked vaults") {
+		t.Errorf("Problem dumping with a locked vault: %v", leftOut)
+	}
+
+	// the password is asked for when the document is touched
+	prompts := []string{}
+	fs.SetVaultPrompt(func(documentName string) string {
+		prompts = append(prompts, documentName)
+		if len(prompts) == 1 {
+			return "wrong"
+		}
+		return "vault"
+	})
+	fs.GetDocument("journal")
+	if len(prompts) != 0 {
+		t.Errorf("Asked for a password outside of the vault: %v", prompts)
+	}
+	entries := fs.GetDocument(finance)
+	if len(prompts) != 2 || len(entries) != 2 || entries[0].Text != "salary" || entries[1].Text != "rent" {
+		t.Errorf("Problem unlocking the vault: %v %v", prompts, entries)
+	}
+	listed := false
+	for _, document := range fs.ListDocuments() {
+		listed = listed || document == finance
+	}
+	if !listed {
+		t.Errorf("Unlocked vault is not listed")
+	}
+	if data, err := fs.ReadAttachment(attachment); err != nil || string(data) != "1000" {
+		t.Errorf("Problem reading the attachment in the vault: %v", err)
+	}
+	if contents, leftOut := dump(); !strings.Contains(contents, "salary") || !strings.Contains(contents, "groceries") || !strings.Contains(fmt.Sprint(leftOut), "attachments") {
+		t.Errorf("Problem dumping with an unlocked vault: %v", leftOut)
+	}
+	if err := fs.Share(finance, "test2", false); err == nil {
+		t.Errorf("Shared a document in a vault")
+	}
+	fs.Close()
+}
+
+func TestAPI(t *testing.T) {
+	var fs Fs
+	EraseAll()
+	fs.Init("test", "")
+	fs.Open("test")
+	fs.Update("first", "notes", "a", "2014-11-20T13:00:00-05:00")
+	api := NewAPI(&fs, "secret")
+	request := func(method, url, token, body string) (int, string) {
+		r := httptest.NewRequest(method, url, strings.NewReader(body))
+		if len(token) > 0 {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		api.ServeHTTP(w, r)
+		return w.Code, w.Body.String()
+	}
+
+	if code, _ := request("GET", "/api/v1/documents", "", ""); code != http.StatusUnauthorized {
+		t.Errorf("Served without a token: %d", code)
+	}
+	if code, _ := request("GET", "/api/v1/documents", "wrong", ""); code != http.StatusUnauthorized {
+		t.Errorf("Served with an incorrect token: %d", code)
+	}
+	if code, body := request("GET", "/api/v1/openapi.json", "", ""); code != http.StatusOK || !strings.Contains(body, `"openapi"`) {
+		t.Errorf("Problem serving the OpenAPI description: %d", code)
+	}
+	if code, body := request("GET", "/api/v1/documents", "secret", ""); code != http.StatusOK || strings.TrimSpace(body) != `[{"document":"notes","entries":1}]` {
+		t.Errorf("Problem listing documents: %d %s", code, body)
+	}
+	if code, body := request("POST", "/api/v1/documents/notes/entries", "secret", `{"entry":"b","text":"second","tags":["work"]}`); code != http.StatusCreated || !strings.Contains(body, `"tags":["work"]`) {
+		t.Errorf("Problem adding an entry: %d %s", code, body)
+	}
+	if code, _ := request("POST", "/api/v1/documents/notes/entries", "secret", `{"entry":"b","text":"again"}`); code != http.StatusConflict {
+		t.Errorf("Added an entry that exists: %d", code)
+	}
+	if code, _ := request("POST", "/api/v1/documents/notes/entries", "secret", `{"text":" "}`); code != http.StatusBadRequest {
+		t.Errorf("Added an empty entry: %d", code)
+	}
+	// versions are ordered by the second they were made
+	time.Sleep(time.Second)
+	if code, body := request("PUT", "/api/v1/documents/notes/entries/b", "secret", `{"text":"changed"}`); code != http.StatusOK || !strings.Contains(body, `"text":"changed"`) || !strings.Contains(body, `"tags":["work"]`) {
+		t.Errorf("Problem changing an entry: %d %s", code, body)
+	}
+	if code, body := request("GET", "/api/v1/documents/notes/entries/b", "secret", ""); code != http.StatusOK || !strings.Contains(body, `"text":"changed"`) {
+		t.Errorf("Problem getting an entry: %d %s", code, body)
+	}
+	if code, body := request("GET", "/api/v1/documents/notes/entries", "secret", ""); code != http.StatusOK || strings.Count(body, `"entry"`) != 2 {
+		t.Errorf("Problem getting the entries: %d %s", code, body)
+	}
+	if code, _ := request("DELETE", "/api/v1/documents/notes/entries/a", "secret", ""); code != http.StatusNoContent {
+		t.Errorf("Problem deleting an entry: %d", code)
+	}
+	if code, _ := request("GET", "/api/v1/documents/notes/entries/a", "secret", ""); code != http.StatusNotFound {
+		t.Errorf("Got a deleted entry: %d", code)
+	}
+	if code, body := request("GET", "/api/v1/documents/notes/entries/a/history", "secret", ""); code != http.StatusOK || strings.Index(body, `"text":"first"`) > strings.Index(body, `"text":"ignore entry"`) {
+		t.Errorf("Problem getting the history: %d %s", code, body)
+	}
+	if code, _ := request("PATCH", "/api/v1/documents/notes/entries/b", "secret", ""); code != http.StatusMethodNotAllowed {
+		t.Errorf("Allowed PATCH: %d", code)
+	}
+	if code, _ := request("GET", "/api/v1/documents/notes/other", "secret", ""); code != http.StatusNotFound {
+		t.Errorf("Served an unknown route: %d", code)
+	}
+	if code, _ := request("POST", "/api/v1/documents/work%2Fnotes/entries", "secret", `{"entry":"c","text":"in a folder"}`); code != http.StatusCreated {
+		t.Errorf("Problem adding an entry to a document with a '/': %d", code)
+	}
+	if code, body := request("GET", "/api/v1/documents/work%2Fnotes/entries/c", "secret", ""); code != http.StatusOK || !strings.Contains(body, `"document":"work/notes"`) {
+		t.Errorf("Problem getting an entry of a document with a '/': %d %s", code, body)
+	}
+	if code, _ := request("GET", "/api/v1/documents/work/notes/entries", "secret", ""); code != http.StatusNotFound {
+		t.Errorf("Served a document with an unescaped '/': %d", code)
+	}
+	if code, _ := request("DELETE", "/api/v1/documents/notes", "secret", ""); code != http.StatusNoContent {
+		t.Errorf("Problem deleting a document: %d", code)
+	}
+	if code, _ := request("GET", "/api/v1/documents/notes", "secret", ""); code != http.StatusNotFound {
+		t.Errorf("Got a deleted document: %d", code)
+	}
+}
+
+func TestAPIPull(t *testing.T) {
+	var fs Fs
+	EraseAll()
+	fs.Init("test", "http://localhost:9095")
+	if err := fs.Open("test"); err != nil {
+		t.Fatal(err)
+	}
+	api := NewAPI(&fs, "secret")
+	request := func(method, url, body string) int {
+		r := httptest.NewRequest(method, url, strings.NewReader(body))
+		r.Header.Set("Authorization", "Bearer secret")
+		w := httptest.NewRecorder()
+		api.ServeHTTP(w, r)
+		return w.Code
+	}
+	if code := request("POST", "/api/v1/documents/pulled/entries", `{"entry":"a","text":"from the api"}`); code != http.StatusCreated {
+		t.Fatalf("Problem adding an entry: %d", code)
+	}
+
+	// another device pushes, which this one does not know about
+	before, _ := ioutil.TempDir("", "pull")
+	defer os.RemoveAll(before)
+	copyFolder(fs.pathToLocalRepo, before)
+	utils.CopyFile(path.Join(pathToLocalFolder, fs.archiveName), path.Join(before, "archive"))
+	pulledMD5 := fs.pulledMD5
+	fs.Update("from another device", "pulled", "b", "")
+	if err := fs.Close(); err != nil {
+		t.Fatal(err)
+	}
+	os.RemoveAll(fs.pathToLocalRepo)
+	copyFolder(before, fs.pathToLocalRepo)
+	os.Rename(path.Join(fs.pathToLocalRepo, "archive"), path.Join(pathToLocalFolder, fs.archiveName))
+	fs.pulledMD5 = pulledMD5
+	fs.parsed = false
+	if _, err := fs.GetEntry("pulled", "b"); err == nil {
+		t.Fatalf("Entry of the other device should not be here yet")
+	}
+
+	fs.Update("not pulled", "pulled", "c", "")
+	if err := fs.Close(); err != ErrRemoteChanged {
+		t.Errorf("Pushed over the changes of another device: %v", err)
+	}
+	if code := request("PUT", "/api/v1/documents/pulled/entries/d", `{"text":"after the pull"}`); code != http.StatusCreated {
+		t.Fatalf("Problem adding an entry: %d", code)
+	}
+	if _, err := fs.GetEntry("pulled", "b"); err != nil {
+		t.Errorf("Entry of the other device was not pulled: %v", err)
+	}
+	if matching, err := fs.doesMD5MatchServer(); !matching || err != nil {
+		t.Errorf("Changes were not pushed after the pull: %v", err)
+	}
+}
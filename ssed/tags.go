@@ -0,0 +1,75 @@
+package ssed
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// hashtagRegex matches words like #project, but not markdown headers ("# Title")
+// or anchors inside of words ("page#section")
+var hashtagRegex = regexp.MustCompile(`(?:^|\s)#([\p{L}\p{N}_-]+)`)
+
+// parseTags returns the lowercased, de-duplicated hashtags in the text
+// together with any tags given explicitly
+func parseTags(text string, explicitTags []string) []string {
+	found := make(map[string]bool)
+	tags := []string{}
+	add := func(tag string) {
+		tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+		if len(tag) == 0 || found[tag] {
+			return
+		}
+		found[tag] = true
+		tags = append(tags, tag)
+	}
+	for _, tag := range explicitTags {
+		add(tag)
+	}
+	for _, match := range hashtagRegex.FindAllStringSubmatch(text, -1) {
+		add(match[1])
+	}
+	return tags
+}
+
+// indexTags builds the tag index from the current version of every entry,
+// skipping ignored documents and entries
+func (ssed *Fs) indexTags() {
+	ssed.tags = make(map[string][]string)
+	for document := range ssed.ordering {
+		if ssed.documentIgnored(document) {
+			continue
+		}
+		for _, uuid := range ssed.ordering[document] {
+			if ssed.entries[uuid].Text == "ignore entry" {
+				continue
+			}
+			for _, tag := range parseTags(ssed.entries[uuid].Text, ssed.entries[uuid].Tags) {
+				ssed.tags[tag] = append(ssed.tags[tag], uuid)
+			}
+		}
+	}
+}
+
+// ListTags returns all the tags used by entries, sorted alphabetically
+func (ssed *Fs) ListTags() []string {
+	if !ssed.parsed {
+		ssed.parseArchive()
+	}
+	tags := make([]string, 0, len(ssed.tags))
+	for tag := range ssed.tags {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// EntriesWithTag returns all the entries, in any document, that are
+// tagged with tag. The entries are sorted by their creation time.
+func (ssed *Fs) EntriesWithTag(tag string) []Entry {
+	if !ssed.parsed {
+		ssed.parseArchive()
+	}
+	tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+	return ssed.chronologicalEntries(ssed.tags[tag])
+}
@@ -0,0 +1,287 @@
+package ssed
+
+import (
+	crand "crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/schollz/bol/utils"
+)
+
+const (
+	vaultExtension           = ".vault"
+	vaultEntryExtension      = ".vaultentry"
+	vaultAttachmentExtension = ".vaultattachment"
+)
+
+// maxVaultAttempts is how many times the password of a vault is asked for
+// when a document touches it
+const maxVaultAttempts = 3
+
+// ErrVaultLocked is returned when changing a document of a vault that was
+// not unlocked
+var ErrVaultLocked = errors.New("This document is in a locked vault")
+
+// ErrNoVault is returned by UnlockVault when the document is not in a vault
+var ErrNoVault = errors.New("This document is not in a vault")
+
+// vault is a document that is encrypted with a password of its own. Its
+// entries are kept in the repository as ID-HASH.vaultentry and its
+// attachments as ID-ATTACHMENT.vaultattachment, encrypted with a key
+// derived from the password of the vault, so they are synchronized like
+// any other entries. The vault itself is kept encrypted in the repository
+// as ID.vault, and only has a hash of the name of the document, so the
+// document is not known until the vault is unlocked.
+type vault struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`        // hash of the ID and the document
+	Salt       string `json:"salt"`        // random for each vault
+	WorkFactor int    `json:"work_factor"` // iterations of the password hash
+	Check      string `json:"check"`       // the ID, encrypted with the key
+	document   string
+	key        string
+}
+
+// vaultName hashes the document with the ID of the vault, so the same
+// document has another name in every vault
+func vaultName(id, documentName string) string {
+	return utils.HashAndHex(id + "/" + documentName)
+}
+
+// vaultKey derives the key of the vault from its password
+func (v *vault) vaultKey(password string) (string, error) {
+	salt, err := hex.DecodeString(v.Salt)
+	if err != nil {
+		return "", err
+	}
+	key, err := HashPasswordSlow(password, salt, v.WorkFactor)
+	return hex.EncodeToString([]byte(key)), err
+}
+
+// loadVaults reads the vaults of the repository, which stay locked until
+// UnlockVault
+func (ssed *Fs) loadVaults() {
+	ssed.vaults = make(map[string]*vault)
+	files, _ := filepath.Glob(path.Join(ssed.pathToLocalRepo, "*"+vaultExtension))
+	for _, file := range files {
+		b, err := utils.DecryptFromFile(ssed.password, file)
+		if err != nil {
+			logger.Warn("Could not open %s: %s", file, err.Error())
+			continue
+		}
+		v := new(vault)
+		if err = json.Unmarshal(b, v); err != nil {
+			logger.Warn("Could not read %s: %s", file, err.Error())
+			continue
+		}
+		ssed.vaults[v.ID] = v
+		ssed.removeCopies(v)
+	}
+}
+
+// removeCopies removes the versions of the entries and attachments of the
+// vault from before it was made, which are encrypted with the password of
+// the user. They stay on devices that had them until they are opened.
+func (ssed *Fs) removeCopies(v *vault) {
+	for extension, copyExtension := range map[string]string{vaultEntryExtension: ".json", vaultAttachmentExtension: attachmentExtension} {
+		files, _ := filepath.Glob(path.Join(ssed.pathToLocalRepo, v.ID+"-*"+extension))
+		for _, file := range files {
+			name := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(file), v.ID+"-"), extension)
+			fileName := path.Join(ssed.pathToLocalRepo, name+copyExtension)
+			if utils.Exists(fileName) {
+				logger.Debug("Removing %s, it is in a vault", filepath.Base(fileName))
+				os.Remove(fileName)
+			}
+		}
+	}
+}
+
+// PurgeOlderArchives removes the archives on the server other than the
+// latest one, like the ones that still have the versions of a document from
+// before it was put in a vault. It is only done when the latest archive is
+// the one of this device, once Close uploaded it.
+func (ssed *Fs) PurgeOlderArchives() error {
+	if !ssed.pushed {
+		return errors.New("The repository is not uploaded yet")
+	}
+	// another device may have pushed since
+	if matching, err := ssed.doesMD5MatchServer(); err != nil {
+		return err
+	} else if !matching {
+		return errors.New("The repository was changed on another device")
+	}
+	_, err := ssed.serverRequest("DELETE", "/repo?keep=latest", nil)
+	return err
+}
+
+func (ssed *Fs) writeVault(v *vault) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return utils.EncryptToFile(b, ssed.password, path.Join(ssed.pathToLocalRepo, filepath.Base(v.ID)+vaultExtension))
+}
+
+// vaultOf returns the vault of the document, locked or not, or nil if the
+// document is not in a vault
+func (ssed *Fs) vaultOf(documentName string) *vault {
+	for _, v := range ssed.vaults {
+		if v.document == documentName || v.Name == vaultName(v.ID, documentName) {
+			return v
+		}
+	}
+	return nil
+}
+
+// SetVaultPrompt sets the function that asks for the password of a vault
+// when GetDocument or GetEntry touch a document in a locked vault. Without
+// it, the documents of locked vaults are left out.
+func (ssed *Fs) SetVaultPrompt(prompt func(documentName string) string) {
+	ssed.vaultPrompt = prompt
+}
+
+// touchVault asks for the password of the vault of the document, if it is
+// locked
+func (ssed *Fs) touchVault(documentName string) {
+	v := ssed.vaultOf(documentName)
+	if v == nil || len(v.key) > 0 || ssed.vaultPrompt == nil {
+		return
+	}
+	for i := 0; i < maxVaultAttempts; i++ {
+		password := ssed.vaultPrompt(documentName)
+		if len(password) == 0 {
+			return
+		}
+		err := ssed.UnlockVault(documentName, password)
+		if err == nil {
+			return
+		}
+		logger.Warn("%s", err.Error())
+	}
+}
+
+// NewVault encrypts the document with a password of its own. Every version
+// of its entries, and their attachments, are moved to the vault. Other
+// devices remove the versions from before when they are opened, but the
+// older archives on the server keep them until PurgeOlderArchives. A document
+// that does not exist yet is made in the vault when it is first written.
+func (ssed *Fs) NewVault(documentName, password string) error {
+	if len(documentName) == 0 || strings.Contains(documentName, "/") {
+		return errors.New("The name of the document can not be empty or have a '/'")
+	}
+	if len(password) == 0 {
+		return errors.New("The password of the vault can not be empty")
+	}
+	if !ssed.parsed {
+		ssed.parseArchive()
+	}
+	if ssed.vaultOf(documentName) != nil {
+		return fmt.Errorf("'%s' is already in a vault", documentName)
+	}
+	if _, ok := ssed.shares[documentName]; ok {
+		return errors.New("Shared documents can not be put in a vault")
+	}
+
+	id, err := utils.NewULID()
+	if err != nil {
+		return err
+	}
+	salt := make([]byte, 32)
+	if _, err = crand.Read(salt); err != nil {
+		return err
+	}
+	workFactor, err := calibratePinHash()
+	if err != nil {
+		return err
+	}
+	v := &vault{ID: id, Name: vaultName(id, documentName), Salt: hex.EncodeToString(salt), WorkFactor: workFactor, document: documentName}
+	if v.key, err = v.vaultKey(password); err != nil {
+		return err
+	}
+	if v.Check, err = utils.EncryptToHex([]byte(id), v.key); err != nil {
+		return err
+	}
+
+	var moved []string
+	reencrypt := func(fileName, newFileName string) error {
+		b, err := utils.DecryptFromFile(ssed.password, fileName)
+		if err != nil {
+			return err
+		}
+		moved = append(moved, fileName)
+		return utils.EncryptToFile(b, v.key, newFileName)
+	}
+	for uuid, e := range ssed.entries {
+		if e.Document != documentName {
+			continue
+		}
+		fileName := path.Join(ssed.pathToLocalRepo, uuid)
+		if err = reencrypt(fileName, v.entryPath(ssed.pathToLocalRepo, strings.TrimSuffix(uuid, ".json"))); err != nil {
+			removeVaultFiles(ssed.pathToLocalRepo, id)
+			return err
+		}
+		for _, attachment := range e.Attachments {
+			fileName = ssed.attachmentPath(attachment)
+			newFileName := v.attachmentPath(ssed.pathToLocalRepo, attachment)
+			if utils.Exists(fileName) && !utils.Exists(newFileName) {
+				if err = reencrypt(fileName, newFileName); err != nil {
+					removeVaultFiles(ssed.pathToLocalRepo, id)
+					return err
+				}
+			}
+		}
+	}
+	if err = ssed.writeVault(v); err != nil {
+		removeVaultFiles(ssed.pathToLocalRepo, id)
+		return err
+	}
+	for _, fileName := range moved {
+		os.Remove(fileName)
+	}
+	ssed.vaults[v.ID] = v
+	ssed.parsed = false
+	return nil
+}
+
+// UnlockVault unlocks the vault of the document with its password, until
+// the repository is closed
+func (ssed *Fs) UnlockVault(documentName, password string) error {
+	v := ssed.vaultOf(documentName)
+	if v == nil {
+		return ErrNoVault
+	}
+	key, err := v.vaultKey(password)
+	if err != nil {
+		return err
+	}
+	if id, err := utils.DecryptFromHex(v.Check, key); err != nil || string(id) != v.ID {
+		return fmt.Errorf("Incorrect password for the vault of '%s'", documentName)
+	}
+	v.document, v.key = documentName, key
+	ssed.parsed = false
+	return nil
+}
+
+func (v *vault) entryPath(folder, hash string) string {
+	return path.Join(folder, v.ID+"-"+hash+vaultEntryExtension)
+}
+
+func (v *vault) attachmentPath(folder string, attachment Attachment) string {
+	return path.Join(folder, v.ID+"-"+filepath.Base(attachment.ID)+vaultAttachmentExtension)
+}
+
+// removeVaultFiles removes the files of a vault that could not be made
+func removeVaultFiles(folder, id string) {
+	for _, extension := range []string{vaultEntryExtension, vaultAttachmentExtension} {
+		files, _ := filepath.Glob(path.Join(folder, id+"-*"+extension))
+		for _, file := range files {
+			os.Remove(file)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"crypto/md5"
+	crand "crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
@@ -16,6 +17,8 @@ import (
 	"strings"
 	"time"
 
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/pbkdf2"
 	"golang.org/x/crypto/ssh/terminal"
 
 	"github.com/schollz/cryptopasta"
@@ -34,26 +37,92 @@ func GetPassword(passwordType string) string {
 }
 
 func EncryptToFile(toEncrypt []byte, password string, filename string) error {
-	key := sha256.Sum256([]byte(password))
-	encrypted, err := cryptopasta.Encrypt(toEncrypt, &key)
+	encrypted, err := EncryptToHex(toEncrypt, password)
 	if err != nil {
 		return err
 	}
 
-	return ioutil.WriteFile(filename, []byte(hex.EncodeToString(encrypted)), 0755)
+	return ioutil.WriteFile(filename, []byte(encrypted), 0755)
 }
 
 func DecryptFromFile(password string, filename string) ([]byte, error) {
-	key := sha256.Sum256([]byte(password))
 	content, err := ioutil.ReadFile(filename)
 	if err != nil {
 		return []byte{}, err
 	}
-	contentData, err := hex.DecodeString(string(content))
+	return DecryptFromHex(string(content), password)
+}
+
+// Ciphers of EncryptToHex. AES-256-GCM is written without a header, as it
+// was before the cipher could be chosen, and the others are written after
+// their name and a colon.
+const (
+	CipherAES256GCM         = "aes256gcm"
+	CipherXChaCha20Poly1305 = "xchacha20poly1305"
+)
+
+var encryptionCipher = CipherAES256GCM
+
+// SetCipher chooses the cipher of EncryptToHex. DecryptFromHex reads the
+// cipher from the header, so it decrypts any of them.
+func SetCipher(cipher string) error {
+	switch cipher {
+	case CipherAES256GCM, CipherXChaCha20Poly1305:
+		encryptionCipher = cipher
+		return nil
+	}
+	return fmt.Errorf("Unknown cipher '%s', use %s or %s", cipher, CipherAES256GCM, CipherXChaCha20Poly1305)
+}
+
+// EncryptToHex encrypts like EncryptToFile, returning the hex string that
+// would be written to the file
+func EncryptToHex(toEncrypt []byte, password string) (string, error) {
+	key := sha256.Sum256([]byte(password))
+	if encryptionCipher == CipherXChaCha20Poly1305 {
+		aead, err := chacha20poly1305.NewX(key[:])
+		if err != nil {
+			return "", err
+		}
+		// the 192-bit random nonces never repeat, however many files there are
+		nonce := make([]byte, aead.NonceSize())
+		if _, err = crand.Read(nonce); err != nil {
+			return "", err
+		}
+		return CipherXChaCha20Poly1305 + ":" + hex.EncodeToString(aead.Seal(nonce, nonce, toEncrypt, nil)), nil
+	}
+	encrypted, err := cryptopasta.Encrypt(toEncrypt, &key)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(encrypted), nil
+}
+
+// DecryptFromHex decrypts a hex string from EncryptToHex, with the cipher
+// in its header
+func DecryptFromHex(encrypted string, password string) ([]byte, error) {
+	key := sha256.Sum256([]byte(password))
+	cipher := CipherAES256GCM
+	if i := strings.Index(encrypted, ":"); i >= 0 {
+		cipher, encrypted = encrypted[:i], encrypted[i+1:]
+	}
+	contentData, err := hex.DecodeString(encrypted)
 	if err != nil {
 		return []byte{}, err
 	}
-	return cryptopasta.Decrypt(contentData, &key)
+	switch cipher {
+	case CipherAES256GCM:
+		return cryptopasta.Decrypt(contentData, &key)
+	case CipherXChaCha20Poly1305:
+		aead, err := chacha20poly1305.NewX(key[:])
+		if err != nil {
+			return []byte{}, err
+		}
+		if len(contentData) < aead.NonceSize() {
+			return []byte{}, errors.New("malformed ciphertext")
+		}
+		return aead.Open(nil, contentData[:aead.NonceSize()], contentData[aead.NonceSize():], nil)
+	}
+	return []byte{}, errors.New("Unknown cipher " + cipher)
 }
 
 func HashAndHex(s string) string {
@@ -61,11 +130,65 @@ func HashAndHex(s string) string {
 	return hex.EncodeToString(b[:])
 }
 
-// GetRandomMD5Hash returns 8 bytes of a random md5 hash
-func GetRandomMD5Hash() string {
-	hasher := md5.New()
-	hasher.Write([]byte(RandStringBytesMaskImprSrc(10)))
-	return hex.EncodeToString(hasher.Sum(nil))[0:8]
+// AuthKeyIterations is the number of PBKDF2 iterations of AuthKey
+const AuthKeyIterations = 100000
+
+// AuthKey derives the key that authenticates the user on a bol server
+// from the password, or what stands for it with a key file. It is salted
+// with the username instead of the salt of EncryptionKey, so the server
+// never learns what decrypts the entries.
+func AuthKey(username, password string) string {
+	key := pbkdf2.Key([]byte(password), []byte("bol authentication "+username), AuthKeyIterations, 32, sha256.New)
+	return hex.EncodeToString(key)
+}
+
+// EncryptionKeyIterations is the number of PBKDF2 iterations of
+// EncryptionKey for new repositories
+const EncryptionKeyIterations = 600000
+
+// EncryptionKey derives the key that encrypts a repository from its
+// password and the random salt of the repository. The server has every
+// encrypted file, so it should be as slow to guess the password from them
+// as from AuthKey.
+func EncryptionKey(password string, salt []byte, iterations int) string {
+	key := pbkdf2.Key([]byte(password), salt, iterations, 32, sha256.New)
+	return hex.EncodeToString(key)
+}
+
+const ulidEncoding = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// NewULID returns a 26 character identifier that sorts by creation time.
+// The first 48 bits are the current time in milliseconds and the
+// remaining 80 bits come from crypto/rand, both encoded in Crockford's base32
+// (see https://github.com/ulid/spec).
+func NewULID() (string, error) {
+	var id [16]byte
+	ms := uint64(time.Now().UnixNano() / int64(time.Millisecond))
+	for i := 0; i < 6; i++ {
+		id[i] = byte(ms >> uint(40-8*i))
+	}
+	if _, err := io.ReadFull(crand.Reader, id[6:]); err != nil {
+		return "", err
+	}
+
+	// 128 bits are written as 26 characters of 5 bits each, with the first
+	// character only holding the top 3 bits
+	b := make([]byte, 26)
+	for i := 25; i >= 0; i-- {
+		var v byte
+		bit := uint(125 - 5*i) // offset of the lowest bit of this character
+		for j := uint(0); j < 5; j++ {
+			pos := bit + j
+			if pos >= 128 {
+				break
+			}
+			if id[15-pos/8]&(1<<(pos%8)) != 0 {
+				v |= 1 << j
+			}
+		}
+		b[i] = ulidEncoding[v]
+	}
+	return string(b), nil
 }
 
 var src = rand.NewSource(time.Now().UnixNano())
@@ -278,12 +401,19 @@ func GetUnixTimestamp() string {
 
 // CreateBolUser creates the specified user on the specified server
 func CreateBolUser(username string, password string, server string) (string, error) {
+	return CreateBolUserWithKey(username, AuthKey(username, password), server)
+}
+
+// CreateBolUserWithKey creates the user on the server with the
+// authentication key, for when the password is not known
+func CreateBolUserWithKey(username, authKey, server string) (string, error) {
 	req, err := http.NewRequest("PUT", server+"/repo", nil)
 	if err != nil {
 		return "Cannot connect to server", err
 	}
-	req.SetBasicAuth(username, password)
+	req.SetBasicAuth(username, authKey)
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Bol-Authentication", "key")
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
@@ -301,6 +431,47 @@ func CreateBolUser(username string, password string, server string) (string, err
 	return "", nil
 }
 
+// ChangeBolPassword changes the password of the user on the server, which
+// only gets the authentication keys of the passwords
+func ChangeBolPassword(username, password, newPassword, server string) error {
+	return patchBolUser(username, AuthKey(username, password), AuthKey(username, newPassword), server)
+}
+
+// ChangeBolAuthKey changes the authentication key of the user on the
+// server, for when the old password is not known
+func ChangeBolAuthKey(username, authKey, newAuthKey, server string) error {
+	return patchBolUser(username, authKey, newAuthKey, server)
+}
+
+// UpgradeBolUser replaces the password that older versions of bol gave the
+// server with the authentication key of the password
+func UpgradeBolUser(username, password, server string) error {
+	return patchBolUser(username, password, AuthKey(username, password), server)
+}
+
+func patchBolUser(username, authorization, newAuthKey, server string) error {
+	req, err := http.NewRequest("PATCH", server+"/repo", strings.NewReader(newAuthKey))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(username, authorization)
+	req.Header.Set("Bol-Authentication", "key")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	htmlData, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(strings.TrimSpace(string(htmlData)))
+	}
+	return nil
+}
+
 // ComputeMd5 returns the md5sum of a file
 // http://dev.pawelsz.eu/2014/11/google-golang-compute-md5-of-file.html
 func ComputeMd5(filePath string) (string, error) {
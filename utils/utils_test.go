@@ -1,6 +1,10 @@
 package utils
 
-import "testing"
+import (
+	"strings"
+	"testing"
+	"time"
+)
 
 func TestParseDate(t *testing.T) {
 	correctDate := "2005-04-07 22:13:13 +0200 +0200"
@@ -34,3 +38,77 @@ func TestFormatDate(t *testing.T) {
 	}
 
 }
+
+func TestNewULID(t *testing.T) {
+	first, err := NewULID()
+	if err != nil {
+		t.Error(err)
+	}
+	if len(first) != 26 || strings.Trim(first, ulidEncoding) != "" {
+		t.Errorf("Malformed ULID: %s", first)
+	}
+	time.Sleep(2 * time.Millisecond)
+	second, _ := NewULID()
+	if second <= first {
+		t.Errorf("ULIDs are not time-sortable: %s <= %s", second, first)
+	}
+	if first[:10] == "0000000000" {
+		t.Errorf("ULID is missing timestamp: %s", first)
+	}
+}
+
+func TestCiphers(t *testing.T) {
+	defer SetCipher(CipherAES256GCM)
+	aes, err := EncryptToHex([]byte("some text"), "password")
+	if err != nil || strings.Contains(aes, ":") {
+		t.Errorf("AES-256-GCM should be written without a header: %s %v", aes, err)
+	}
+	if err = SetCipher("rot13"); err == nil {
+		t.Errorf("Set an unknown cipher")
+	}
+	SetCipher(CipherXChaCha20Poly1305)
+	xchacha, err := EncryptToHex([]byte("some text"), "password")
+	if err != nil || !strings.HasPrefix(xchacha, CipherXChaCha20Poly1305+":") {
+		t.Errorf("XChaCha20-Poly1305 should be written with a header: %s %v", xchacha, err)
+	}
+
+	// both are decrypted, whichever cipher is set
+	for _, cipher := range []string{CipherAES256GCM, CipherXChaCha20Poly1305} {
+		SetCipher(cipher)
+		for _, encrypted := range []string{aes, xchacha} {
+			if decrypted, err := DecryptFromHex(encrypted, "password"); err != nil || string(decrypted) != "some text" {
+				t.Errorf("Problem decrypting %s: %v", encrypted, err)
+			}
+			if _, err := DecryptFromHex(encrypted, "wrong"); err == nil {
+				t.Errorf("Decrypted %s with the wrong password", encrypted)
+			}
+		}
+	}
+	if _, err = DecryptFromHex("rot13:abcd", "password"); err == nil {
+		t.Errorf("Decrypted an unknown cipher")
+	}
+}
+
+func TestAuthKey(t *testing.T) {
+	key := AuthKey("test", "password")
+	if len(key) != 64 || key != AuthKey("test", "password") {
+		t.Errorf("Authentication key should be 32 bytes and the same every time: %s", key)
+	}
+	if key == AuthKey("test2", "password") || key == AuthKey("test", "password2") {
+		t.Errorf("Authentication key should depend on the user and the password")
+	}
+	encrypted, _ := EncryptToHex([]byte("some text"), "password")
+	if _, err := DecryptFromHex(encrypted, key); err == nil {
+		t.Errorf("Authentication key decrypts the entries")
+	}
+}
+
+func TestEncryptionKey(t *testing.T) {
+	// PBKDF2-HMAC-SHA256, as browsers derive it with WebCrypto
+	if key := EncryptionKey("password", []byte("salt"), 1); key != "120fb6cffcf8b32c43e7225256c4f837a86548c92ccc35480805987cb70be17b" {
+		t.Errorf("Encryption key is not PBKDF2-SHA256: %s", key)
+	}
+	if EncryptionKey("password", []byte("salt"), 2) == EncryptionKey("password", []byte("salt2"), 2) {
+		t.Errorf("Encryption key should depend on the salt")
+	}
+}